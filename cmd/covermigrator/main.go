@@ -0,0 +1,110 @@
+// covermigrator переносит обложки, сохраненные под ключами вида title_year,
+// на неизменяемые ключи games/{id}/cover/{upload}.{ext} и создает для них
+// уменьшенные копии.
+// Запуск: go run cmd/covermigrator/main.go --config config/dev.env
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/sariya23/game_service/internal/config"
+	"github.com/sariya23/game_service/internal/interceptors"
+	"github.com/sariya23/game_service/internal/lib/generate"
+	"github.com/sariya23/game_service/internal/lib/imaging"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/storage/db"
+	"github.com/sariya23/game_service/internal/storage/postgresql/gamerepo"
+	minioclient "github.com/sariya23/game_service/internal/storage/s3/minio"
+)
+
+func main() {
+	ctx := context.WithValue(context.Background(), interceptors.RequestIDKey, generate.GenerateRequestID())
+	cfg := config.MustLoad()
+	log := logger.NewLogger(slog.LevelInfo)
+	dbURL := db.GenerateDBUrl(
+		cfg.Postgres.PostgresUsername,
+		cfg.Postgres.PostgresPassword,
+		cfg.Postgres.PostgresHostOuter,
+		cfg.Postgres.PostgresPort,
+		cfg.Postgres.PostgresDBName,
+		cfg.Postgres.SSLMode)
+	conn := db.MustNewConnection(ctx, log, dbURL)
+	defer conn.Close()
+	gameRepo := gamerepo.NewGameRepository(conn, log)
+	s3Client := minioclient.MustPrepareMinio(ctx, log, cfg.Minio, false)
+
+	games, err := gameRepo.GetGamesWithLegacyImageKey(ctx, minioclient.GameKeyPrefix)
+	if err != nil {
+		panic(fmt.Sprintf("cannot get games to migrate: %v", err))
+	}
+	log.Info("games to migrate", slog.Int("count", len(games)))
+	var migrated, skipped, failed int
+	for _, game := range games {
+		gameLog := log.With(slog.Int64("game_id", game.GameID), slog.String("old_key", game.ImageKey))
+		image, err := s3Client.GetObject(ctx, game.ImageKey)
+		if err != nil {
+			gameLog.Error("cannot read old cover", slog.String("error", err.Error()))
+			failed++
+			continue
+		}
+		contentType := http.DetectContentType(image)
+		newKey, err := s3Client.SaveObject(ctx, minioclient.GameCoverKey(game.GameID, contentType), bytes.NewReader(image), contentType)
+		if err != nil {
+			gameLog.Error("cannot save cover under new key", slog.String("error", err.Error()))
+			failed++
+			continue
+		}
+		variantWidths := make([]int, 0, len(minioclient.CoverVariantWidths))
+		for _, width := range minioclient.CoverVariantWidths {
+			variant, variantContentType, err := imaging.ThumbnailBytes(image, width)
+			if err != nil {
+				gameLog.Warn("cannot resize cover", slog.Int("width", width), slog.String("error", err.Error()))
+				break
+			}
+			_, err = s3Client.SaveObject(ctx, minioclient.CoverVariantKey(newKey, width), bytes.NewReader(variant), variantContentType)
+			if err != nil {
+				gameLog.Warn("cannot save cover variant", slog.Int("width", width), slog.String("error", err.Error()))
+				continue
+			}
+			variantWidths = append(variantWidths, width)
+		}
+		// Старый объект удаляется только после того, как в БД записан новый ключ.
+		// Ключ меняется, только если он все еще старый, чтобы не затереть
+		// параллельный SetGameCover.
+		swapped, err := gameRepo.SwapGameImageKey(ctx, game.GameID, game.ImageKey, newKey, variantWidths)
+		if err != nil {
+			gameLog.Error("cannot update image key", slog.String("error", err.Error()))
+			deleteCover(ctx, s3Client, gameLog, newKey, variantWidths)
+			failed++
+			continue
+		}
+		if !swapped {
+			gameLog.Info("cover changed or game deleted during migration, skipped")
+			deleteCover(ctx, s3Client, gameLog, newKey, variantWidths)
+			skipped++
+			continue
+		}
+		if err = s3Client.DeleteObject(ctx, game.ImageKey); err != nil {
+			gameLog.Warn("cover migrated, but old object is not deleted", slog.String("error", err.Error()))
+		}
+		gameLog.Info("cover migrated", slog.String("new_key", newKey))
+		migrated++
+	}
+	log.Info("migration finished", slog.Int("migrated", migrated), slog.Int("skipped", skipped), slog.Int("failed", failed))
+}
+
+// deleteCover удаляет загруженную обложку и ее копии, если ключ не попал в БД.
+func deleteCover(ctx context.Context, s3Client *minioclient.Minio, log *slog.Logger, imageKey string, variantWidths []int) {
+	for _, width := range variantWidths {
+		if err := s3Client.DeleteObject(ctx, minioclient.CoverVariantKey(imageKey, width)); err != nil {
+			log.Warn("cannot delete unused cover variant", slog.Int("width", width), slog.String("error", err.Error()))
+		}
+	}
+	if err := s3Client.DeleteObject(ctx, imageKey); err != nil {
+		log.Warn("cannot delete unused cover", slog.String("new_key", imageKey), slog.String("error", err.Error()))
+	}
+}
@@ -0,0 +1,26 @@
+// statusgraph печатает граф переходов статусов игры.
+// Запуск: go run cmd/statusgraph/main.go --format mermaid
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/sariya23/game_service/internal/lib/statusmachine"
+)
+
+func main() {
+	format := flag.String("format", "dot", "graph format: dot or mermaid")
+	flag.Parse()
+	machine := statusmachine.Default()
+	switch *format {
+	case "dot":
+		fmt.Print(machine.DOT())
+	case "mermaid":
+		fmt.Print(machine.Mermaid())
+	default:
+		fmt.Fprintf(os.Stderr, "unknown format %q, want dot or mermaid\n", *format)
+		os.Exit(2)
+	}
+}
@@ -11,15 +11,35 @@ import (
 	"github.com/sariya23/game_service/internal/app/grcpgatewayapp"
 	"github.com/sariya23/game_service/internal/app/grpcserviceapp"
 	"github.com/sariya23/game_service/internal/config"
+	"github.com/sariya23/game_service/internal/events"
+	"github.com/sariya23/game_service/internal/interceptors"
+	"github.com/sariya23/game_service/internal/lib/generate"
+	"github.com/sariya23/game_service/internal/lib/statusmachine"
+	"github.com/sariya23/game_service/internal/lib/validators"
 	gameservice "github.com/sariya23/game_service/internal/service/game"
+	genreservice "github.com/sariya23/game_service/internal/service/genre"
+	outboxservice "github.com/sariya23/game_service/internal/service/outbox"
+	tagservice "github.com/sariya23/game_service/internal/service/tag"
+	webhookservice "github.com/sariya23/game_service/internal/service/webhook"
 	"github.com/sariya23/game_service/internal/storage/db"
+	gamestatusrepo "github.com/sariya23/game_service/internal/storage/postgresql/game_status_repo"
 	"github.com/sariya23/game_service/internal/storage/postgresql/gamerepo"
 	"github.com/sariya23/game_service/internal/storage/postgresql/genrerepo"
+	outboxrepo "github.com/sariya23/game_service/internal/storage/postgresql/outbox_repo"
 	"github.com/sariya23/game_service/internal/storage/postgresql/tagrepo"
+	"github.com/sariya23/game_service/internal/storage/postgresql/webhookrepo"
 
 	minioclient "github.com/sariya23/game_service/internal/storage/s3/minio"
+	"github.com/sariya23/game_service/internal/webhook"
+	"github.com/sariya23/game_service/internal/worker"
 )
 
+// backgroundWorker - фоновая задача, которая живет столько же, сколько сервер.
+type backgroundWorker interface {
+	Run()
+	Stop()
+}
+
 type App struct {
 	log            *slog.Logger
 	Config         *config.Config
@@ -27,6 +47,11 @@ type App struct {
 	Minio          *minioclient.Minio
 	GrpcApp        *grpcserviceapp.GrpcServer
 	GrpcGateWayApp *grcpgatewayapp.GrpcGatewayApp
+	Workers        []backgroundWorker
+	GameWatcher    *outboxservice.Watcher
+	WebhookService *webhookservice.WebhookService
+	TagService     *tagservice.TagService
+	GenreService   *genreservice.GenreService
 }
 
 func NewApp(ctx context.Context, log *slog.Logger, cfg *config.Config) *App {
@@ -38,14 +63,86 @@ func NewApp(ctx context.Context, log *slog.Logger, cfg *config.Config) *App {
 		cfg.Postgres.PostgresDBName,
 		cfg.Postgres.SSLMode)
 	db := db.MustNewConnection(ctx, log, dbURL)
+	mustValidateStatusMachine(ctx, log, gamestatusrepo.NewGameStatusRepository(db, log))
 	gameRepo := gamerepo.NewGameRepository(db, log)
 	tagRepo := tagrepo.NewTagRepository(db, log)
 	genreRepo := genrerepo.NewGenreRepository(db, log)
 	s3Client := minioclient.MustPrepareMinio(ctx, log, cfg.Minio, false)
 	gameService := gameservice.NewGameService(log, gameRepo, tagRepo, genreRepo, s3Client)
-	grpcApp := grpcserviceapp.NewGrpcServer(log, cfg.Server.GrpcServerPort, cfg.Server.GRPCServerHost, gameService)
+	outboxRepo := outboxrepo.NewOutboxRepository(db, log)
+	gameWatcher := outboxservice.NewWatcher(log, outboxRepo)
+	webhookRepo := webhookrepo.NewWebhookRepository(db, log)
+	webhookGuard := webhook.NewGuard(cfg.Workers.WebhookAllowPrivateNetworks)
+	webhookService := webhookservice.NewWebhookService(log, webhookRepo, webhookGuard)
+	tagService := tagservice.NewTagService(log, tagRepo)
+	genreService := genreservice.NewGenreService(log, genreRepo)
+	grpcApp := grpcserviceapp.NewGrpcServer(
+		log,
+		cfg.Server.GrpcServerPort,
+		cfg.Server.GRPCServerHost,
+		gameService,
+		gameWatcher,
+		webhookService,
+		tagService,
+		genreService,
+		validators.CoverImageLimitsFromConfig(cfg.Minio),
+		[]byte(cfg.Auth.TokenSecret),
+	)
 	gwApp := grcpgatewayapp.NewGrpcGatewayApp(ctx, log, cfg.Server.GrpcServerPort, cfg.Server.HTTPServerPort, cfg.Server.GRPCServerHost, cfg.Server.HTTPServerHost, cfg.Server.AllowedOrigins)
+	publishScheduler := worker.NewPeriodic(
+		log,
+		"publish_scheduler",
+		time.Duration(cfg.Workers.PublishSchedulerIntervalSeconds)*time.Second,
+		func(ctx context.Context) error {
+			_, err := gameService.PublishDueGames(ctx, cfg.Workers.PublishSchedulerBatchSize)
+			return err
+		},
+	)
+	deletedGamesPurger := worker.NewPeriodic(
+		log,
+		"deleted_games_purger",
+		time.Duration(cfg.Workers.PurgeIntervalSeconds)*time.Second,
+		func(ctx context.Context) error {
+			retention := time.Duration(cfg.Workers.DeletedGamesRetentionHours) * time.Hour
+			_, err := gameService.PurgeDeletedGames(ctx, retention, cfg.Workers.PurgeBatchSize)
+			return err
+		},
+	)
+	outboxRelay := outboxservice.NewRelay(
+		log,
+		outboxRepo,
+		events.NewMultiPublisher(events.NewLogPublisher(log), webhookService),
+		cfg.Workers.OutboxRelayBatchSize,
+		cfg.Workers.OutboxMaxAttempts,
+		time.Duration(cfg.Workers.OutboxPublishTimeoutSeconds)*time.Second,
+	)
+	outboxRelayWorker := worker.NewPeriodic(
+		log,
+		"outbox_relay",
+		time.Duration(cfg.Workers.OutboxRelayIntervalSeconds)*time.Second,
+		outboxRelay.RelayOnce,
+	)
+	webhookTimeout := time.Duration(cfg.Workers.WebhookTimeoutSeconds) * time.Second
+	webhookDeliverer := webhookservice.NewDeliverer(
+		log,
+		webhookRepo,
+		webhook.NewSender(webhookTimeout, webhookGuard),
+		cfg.Workers.WebhookDeliveryBatchSize,
+		cfg.Workers.WebhookMaxAttempts,
+		webhookTimeout,
+	)
+	webhookDeliveryWorker := worker.NewPeriodic(
+		log,
+		"webhook_delivery",
+		time.Duration(cfg.Workers.WebhookDeliveryIntervalSeconds)*time.Second,
+		webhookDeliverer.DeliverOnce,
+	)
 	return &App{
+		Workers:        []backgroundWorker{publishScheduler, deletedGamesPurger, outboxRelayWorker, webhookDeliveryWorker, gameWatcher},
+		GameWatcher:    gameWatcher,
+		WebhookService: webhookService,
+		TagService:     tagService,
+		GenreService:   genreService,
 		Config:         cfg,
 		Db:             db,
 		Minio:          s3Client,
@@ -55,6 +152,21 @@ func NewApp(ctx context.Context, log *slog.Logger, cfg *config.Config) *App {
 	}
 }
 
+// mustValidateStatusMachine не дает стартовать, если переходы статусов
+// разошлись со справочником game_status из миграций.
+func mustValidateStatusMachine(ctx context.Context, log *slog.Logger, statusRepo *gamestatusrepo.GameStatusRepository) {
+	const operationPlace = "app.mustValidateStatusMachine"
+	ctx = context.WithValue(ctx, interceptors.RequestIDKey, generate.GenerateRequestID())
+	statuses, err := statusRepo.GetGameStatuses(ctx)
+	if err != nil {
+		panic(fmt.Sprintf("%s: %v", operationPlace, err))
+	}
+	if err = statusmachine.Default().Validate(statuses); err != nil {
+		panic(fmt.Sprintf("%s: status machine does not match game_status table: %v", operationPlace, err))
+	}
+	log.Info("status machine matches game_status table", slog.Int("statuses", len(statuses)))
+}
+
 func (a *App) MustRun() {
 	runActions := []struct {
 		action func()
@@ -63,6 +175,12 @@ func (a *App) MustRun() {
 		{action: a.GrpcApp.MustRun, errMsg: "Error while starting gRPC server"},
 		{action: a.GrpcGateWayApp.MustRun, errMsg: "Error while starting grpc-gateway"},
 	}
+	for _, w := range a.Workers {
+		runActions = append(runActions, struct {
+			action func()
+			errMsg string
+		}{action: w.Run, errMsg: "Error while running worker"})
+	}
 	wg := sync.WaitGroup{}
 	wg.Add(len(runActions))
 
@@ -88,6 +206,10 @@ func (a *App) Stop(ctx context.Context, cancel context.CancelFunc, sigchan <-cha
 	log.Info("Grpc gateway server stopped")
 	a.GrpcApp.Stop()
 	log.Info("GRPC server stopped")
+	for _, w := range a.Workers {
+		w.Stop()
+	}
+	log.Info("Workers stopped")
 	a.Db.Close()
 	log.Info("DB closed")
 }
@@ -22,7 +22,7 @@ type GrpcGatewayApp struct {
 }
 
 func NewGrpcGatewayApp(ctx context.Context, log *slog.Logger, grpcPort, httpPort int, grpcHost, httpHost string, allowedOrigins string) *GrpcGatewayApp {
-	mux := runtime.NewServeMux()
+	mux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher))
 
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
 	err := game.RegisterGameServiceHandlerFromEndpoint(ctx, mux, fmt.Sprintf("%s:%d", grpcHost, grpcPort), opts)
@@ -44,6 +44,17 @@ func NewGrpcGatewayApp(ctx context.Context, log *slog.Logger, grpcPort, httpPort
 	}
 }
 
+// incomingHeaderMatcher передает заголовки как runtime.DefaultHeaderMatcher, но отбрасывает
+// Grpc-Metadata-User-*: вызывающий задается только токеном в Authorization,
+// который gateway всегда передает как метаданные authorization.
+func incomingHeaderMatcher(key string) (string, bool) {
+	lower := strings.ToLower(key)
+	if strings.HasPrefix(lower, "grpc-metadata-user_") || strings.HasPrefix(lower, "grpc-metadata-user-") {
+		return "", false
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
 func setupCORS(next http.Handler, allowedOrigins string, log *slog.Logger) http.Handler {
 	origins := parseOrigins(allowedOrigins)
 	if len(origins) == 0 {
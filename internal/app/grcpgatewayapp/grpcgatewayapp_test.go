@@ -0,0 +1,34 @@
+package grcpgatewayapp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIncomingHeaderMatcher(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		header   string
+		expected string
+		ok       bool
+	}{
+		{header: "Grpc-Metadata-Request_id", expected: "Request_id", ok: true},
+		{header: "grpc-metadata-request_id", expected: "Request_id", ok: true},
+		{header: "Grpc-Metadata-User_role", ok: false},
+		{header: "Grpc-Metadata-User_id", ok: false},
+		{header: "Grpc-Metadata-User-Role", ok: false},
+		{header: "grpc-metadata-user_role", ok: false},
+		{header: "User_role", ok: false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.header, func(t *testing.T) {
+			t.Parallel()
+			key, ok := incomingHeaderMatcher(tc.header)
+			assert.Equal(t, tc.ok, ok)
+			if tc.ok {
+				assert.Equal(t, tc.expected, key)
+			}
+		})
+	}
+}
@@ -7,9 +7,13 @@ import (
 
 	"github.com/sariya23/game_service/internal/grpchandlers"
 	"github.com/sariya23/game_service/internal/interceptors"
+	"github.com/sariya23/game_service/internal/lib/validators"
 	"google.golang.org/grpc"
 )
 
+// maxRecvMsgHeadroom - запас под остальные поля запроса сверх обложки.
+const maxRecvMsgHeadroom = 1 << 20
+
 type GrpcServer struct {
 	port   int
 	host   string
@@ -17,9 +21,34 @@ type GrpcServer struct {
 	log    *slog.Logger
 }
 
-func NewGrpcServer(log *slog.Logger, port int, host string, implementation grpchandlers.GameServicer) *GrpcServer {
-	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(interceptors.RequestIDInterceptor))
-	grpchandlers.RegisterGrpcHandlers(grpcServer, implementation, log)
+func NewGrpcServer(
+	log *slog.Logger,
+	port int,
+	host string,
+	implementation grpchandlers.GameServicer,
+	gameWatcher grpchandlers.GameWatcher,
+	webhookServicer grpchandlers.WebhookServicer,
+	tagServicer grpchandlers.TagServicer,
+	genreServicer grpchandlers.GenreServicer,
+	coverImageLimits validators.CoverImageLimits,
+	authTokenSecret []byte,
+) *GrpcServer {
+	serverOptions := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(
+			interceptors.RequestIDInterceptor,
+			interceptors.NewCallerInterceptor(authTokenSecret),
+		),
+		grpc.ChainStreamInterceptor(
+			interceptors.RequestIDStreamInterceptor,
+			interceptors.NewCallerStreamInterceptor(authTokenSecret),
+		),
+	}
+	// Без этого gRPC отклонит обложку больше 4 МиБ с ResourceExhausted еще до валидатора.
+	if coverImageLimits.MaxBytes > 0 {
+		serverOptions = append(serverOptions, grpc.MaxRecvMsgSize(int(coverImageLimits.MaxBytes)+maxRecvMsgHeadroom))
+	}
+	grpcServer := grpc.NewServer(serverOptions...)
+	grpchandlers.RegisterGrpcHandlers(grpcServer, implementation, gameWatcher, webhookServicer, tagServicer, genreServicer, log, coverImageLimits)
 	return &GrpcServer{
 		port:   port,
 		host:   host,
@@ -17,12 +17,39 @@ type Config struct {
 	Postgres *Postgres
 	Minio    *Minio
 	Env      *Env
+	Workers  *Workers
+	Auth     *Auth
 }
 
 type Env struct {
 	EnvType string `env:"ENV_TYPE"`
 }
 
+// Workers - настройки фоновых задач сервиса.
+type Workers struct {
+	PublishSchedulerIntervalSeconds int    `env:"PUBLISH_SCHEDULER_INTERVAL_SECONDS" env-default:"30"`
+	PublishSchedulerBatchSize       uint32 `env:"PUBLISH_SCHEDULER_BATCH_SIZE" env-default:"50"`
+	PurgeIntervalSeconds            int    `env:"PURGE_INTERVAL_SECONDS" env-default:"3600"`
+	PurgeBatchSize                  uint32 `env:"PURGE_BATCH_SIZE" env-default:"100"`
+	DeletedGamesRetentionHours      int    `env:"DELETED_GAMES_RETENTION_HOURS" env-default:"720"`
+	OutboxRelayIntervalSeconds      int    `env:"OUTBOX_RELAY_INTERVAL_SECONDS" env-default:"1"`
+	OutboxRelayBatchSize            uint32 `env:"OUTBOX_RELAY_BATCH_SIZE" env-default:"100"`
+	OutboxMaxAttempts               int    `env:"OUTBOX_MAX_ATTEMPTS" env-default:"20"`
+	OutboxPublishTimeoutSeconds     int    `env:"OUTBOX_PUBLISH_TIMEOUT_SECONDS" env-default:"10"`
+	WebhookDeliveryIntervalSeconds  int    `env:"WEBHOOK_DELIVERY_INTERVAL_SECONDS" env-default:"5"`
+	WebhookDeliveryBatchSize        uint32 `env:"WEBHOOK_DELIVERY_BATCH_SIZE" env-default:"20"`
+	WebhookMaxAttempts              int    `env:"WEBHOOK_MAX_ATTEMPTS" env-default:"10"`
+	WebhookTimeoutSeconds           int    `env:"WEBHOOK_TIMEOUT_SECONDS" env-default:"10"`
+	// WebhookAllowPrivateNetworks разрешает webhook во внутреннюю сеть.
+	// Только для локальной разработки и тестов.
+	WebhookAllowPrivateNetworks bool `env:"WEBHOOK_ALLOW_PRIVATE_NETWORKS" env-default:"false"`
+}
+
+// Auth - проверка токенов вызывающих. Секрет общий с сервисом аутентификации.
+type Auth struct {
+	TokenSecret string `env:"AUTH_TOKEN_SECRET" env-required:"true"`
+}
+
 type Server struct {
 	GrpcServerPort       int    `env:"GRPC_SERVER_PORT"`
 	GRPCServerHost       string `env:"GRPC_SERVER_HOST"`
@@ -52,6 +79,9 @@ type Minio struct {
 	ExpiresUrlHours     int    `env:"EXPIRES_URL_HOURS"`
 	PresignedHost       string `env:"PRESIGNED_HOST"`
 	PresignedHostScheme string `env:"PRESIGNED_HOST_SCHEME"`
+	MaxCoverImageBytes  int64  `env:"MAX_COVER_IMAGE_BYTES" env-default:"5242880"`
+	MaxCoverImageWidth  int    `env:"MAX_COVER_IMAGE_WIDTH" env-default:"4096"`
+	MaxCoverImageHeight int    `env:"MAX_COVER_IMAGE_HEIGHT" env-default:"4096"`
 }
 
 // MustLoad - загрузка данных из .env в конфиг.
@@ -64,6 +94,8 @@ func MustLoad() *Config {
 	postgresConfig := Postgres{}
 	minioConfig := Minio{}
 	envConfig := Env{}
+	workersConfig := Workers{}
+	authConfig := Auth{}
 	cfg := Config{}
 	if err := cleanenv.ReadConfig(configPath, &serverConfig); err != nil {
 		panic(fmt.Sprintf("cannot read config from file; err=%s", err.Error()))
@@ -77,10 +109,18 @@ func MustLoad() *Config {
 	if err := cleanenv.ReadConfig(configPath, &envConfig); err != nil {
 		panic(fmt.Sprintf("cannot read config from file; err=%s", err.Error()))
 	}
+	if err := cleanenv.ReadConfig(configPath, &workersConfig); err != nil {
+		panic(fmt.Sprintf("cannot read config from file; err=%s", err.Error()))
+	}
+	if err := cleanenv.ReadConfig(configPath, &authConfig); err != nil {
+		panic(fmt.Sprintf("cannot read config from file; err=%s", err.Error()))
+	}
 	cfg.Server = &serverConfig
 	cfg.Postgres = &postgresConfig
 	cfg.Minio = &minioConfig
 	cfg.Env = &envConfig
+	cfg.Workers = &workersConfig
+	cfg.Auth = &authConfig
 	return &cfg
 }
 
@@ -94,6 +134,8 @@ func MustLoadByPath(configPath string) *Config {
 	postgresConfig := Postgres{}
 	minioConfig := Minio{}
 	envConfig := Env{}
+	workersConfig := Workers{}
+	authConfig := Auth{}
 	cfg := Config{}
 	if err := cleanenv.ReadConfig(configPath, &serverConfig); err != nil {
 		panic(fmt.Sprintf("cannot read config from file; err=%s", err.Error()))
@@ -107,10 +149,18 @@ func MustLoadByPath(configPath string) *Config {
 	if err := cleanenv.ReadConfig(configPath, &envConfig); err != nil {
 		panic(fmt.Sprintf("cannot read config from file; err=%s", err.Error()))
 	}
+	if err := cleanenv.ReadConfig(configPath, &workersConfig); err != nil {
+		panic(fmt.Sprintf("cannot read config from file; err=%s", err.Error()))
+	}
+	if err := cleanenv.ReadConfig(configPath, &authConfig); err != nil {
+		panic(fmt.Sprintf("cannot read config from file; err=%s", err.Error()))
+	}
 	cfg.Server = &serverConfig
 	cfg.Postgres = &postgresConfig
 	cfg.Minio = &minioConfig
 	cfg.Env = &envConfig
+	cfg.Workers = &workersConfig
+	cfg.Auth = &authConfig
 	return &cfg
 }
 
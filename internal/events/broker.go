@@ -0,0 +1,74 @@
+package events
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/sariya23/game_service/internal/model"
+)
+
+// Broker раздает события подписчикам внутри процесса. Publish не блокируется:
+// подписчик, который не успевает читать, отключается, и ему нужно
+// переподписаться с последнего полученного outbox_id.
+type Broker struct {
+	mu   sync.Mutex
+	subs map[*Subscription]struct{}
+}
+
+type Subscription struct {
+	events chan model.OutboxEvent
+	broker *Broker
+	lagged atomic.Bool
+}
+
+func NewBroker() *Broker {
+	return &Broker{subs: make(map[*Subscription]struct{})}
+}
+
+// Subscribe создает подписку с буфером на buffer событий.
+func (b *Broker) Subscribe(buffer int) *Subscription {
+	sub := &Subscription{events: make(chan model.OutboxEvent, buffer), broker: b}
+	b.mu.Lock()
+	b.subs[sub] = struct{}{}
+	b.mu.Unlock()
+	return sub
+}
+
+// Publish отправляет событие всем подписчикам.
+func (b *Broker) Publish(event model.OutboxEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for sub := range b.subs {
+		select {
+		case sub.events <- event:
+		default:
+			sub.lagged.Store(true)
+			b.unsubscribeLocked(sub)
+		}
+	}
+}
+
+func (b *Broker) unsubscribeLocked(sub *Subscription) {
+	if _, ok := b.subs[sub]; !ok {
+		return
+	}
+	delete(b.subs, sub)
+	close(sub.events)
+}
+
+// Events возвращает канал событий. Канал закрывается после Close
+// или если подписчик отстал.
+func (s *Subscription) Events() <-chan model.OutboxEvent {
+	return s.events
+}
+
+// Lagged сообщает, что подписка закрыта из-за переполнения буфера.
+func (s *Subscription) Lagged() bool {
+	return s.lagged.Load()
+}
+
+func (s *Subscription) Close() {
+	s.broker.mu.Lock()
+	defer s.broker.mu.Unlock()
+	s.broker.unsubscribeLocked(s)
+}
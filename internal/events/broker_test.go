@@ -0,0 +1,50 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/sariya23/game_service/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBroker(t *testing.T) {
+	t.Parallel()
+	t.Run("fan out to all subscribers", func(t *testing.T) {
+		t.Parallel()
+		broker := NewBroker()
+		first, second := broker.Subscribe(1), broker.Subscribe(1)
+		defer first.Close()
+		defer second.Close()
+
+		broker.Publish(model.OutboxEvent{OutboxID: 1})
+
+		assert.Equal(t, int64(1), (<-first.Events()).OutboxID)
+		assert.Equal(t, int64(1), (<-second.Events()).OutboxID)
+	})
+	t.Run("slow subscriber is dropped", func(t *testing.T) {
+		t.Parallel()
+		broker := NewBroker()
+		slow := broker.Subscribe(1)
+
+		broker.Publish(model.OutboxEvent{OutboxID: 1})
+		broker.Publish(model.OutboxEvent{OutboxID: 2})
+
+		assert.True(t, slow.Lagged())
+		assert.Equal(t, int64(1), (<-slow.Events()).OutboxID)
+		_, ok := <-slow.Events()
+		assert.False(t, ok)
+		slow.Close()
+	})
+	t.Run("closed subscriber gets nothing", func(t *testing.T) {
+		t.Parallel()
+		broker := NewBroker()
+		sub := broker.Subscribe(1)
+		sub.Close()
+
+		broker.Publish(model.OutboxEvent{OutboxID: 1})
+
+		_, ok := <-sub.Events()
+		assert.False(t, ok)
+		assert.False(t, sub.Lagged())
+	})
+}
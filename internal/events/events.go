@@ -0,0 +1,152 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/model"
+)
+
+// Типы событий, которые сервис отдает остальным сервисам GameHub.
+const (
+	GameCreated       = "GameCreated"
+	GameUpdated       = "GameUpdated"
+	GameDeleted       = "GameDeleted"
+	GameStatusChanged = "GameStatusChanged"
+	GameRestored      = "GameRestored"
+)
+
+// Known сообщает, отдает ли сервис события такого типа.
+func Known(eventType string) bool {
+	switch eventType {
+	case GameCreated, GameUpdated, GameDeleted, GameStatusChanged, GameRestored:
+		return true
+	}
+	return false
+}
+
+type GameCreatedPayload struct {
+	GameID      int64  `json:"game_id"`
+	Title       string `json:"title"`
+	ReleaseDate string `json:"release_date"`
+	Status      string `json:"status"`
+}
+
+type GameUpdatedPayload struct {
+	GameID      int64  `json:"game_id"`
+	Title       string `json:"title"`
+	ReleaseDate string `json:"release_date"`
+	Status      string `json:"status"`
+}
+
+type GameDeletedPayload struct {
+	GameID      int64  `json:"game_id"`
+	Title       string `json:"title"`
+	ReleaseYear uint64 `json:"release_year"`
+	Status      string `json:"status"`
+}
+
+type GameStatusChangedPayload struct {
+	GameID    int64  `json:"game_id"`
+	OldStatus string `json:"old_status"`
+	NewStatus string `json:"new_status"`
+	Actor     string `json:"actor"`
+	RequestID string `json:"request_id"`
+}
+
+type GameRestoredPayload struct {
+	GameID      int64  `json:"game_id"`
+	Title       string `json:"title"`
+	ReleaseDate string `json:"release_date"`
+	Status      string `json:"status"`
+	Actor       string `json:"actor"`
+	RequestID   string `json:"request_id"`
+}
+
+// Publisher доставляет событие во внешнюю систему. Доставка идет
+// минимум один раз, поэтому получатели должны быть идемпотентны по OutboxID.
+type Publisher interface {
+	Publish(ctx context.Context, event model.OutboxEvent) error
+}
+
+func NewGameCreated(gameID int64, title string, releaseDate time.Time, status game.GameStatusType) (model.OutboxEvent, error) {
+	return newEvent(gameID, GameCreated, GameCreatedPayload{
+		GameID:      gameID,
+		Title:       title,
+		ReleaseDate: releaseDate.Format(time.DateOnly),
+		Status:      status.String(),
+	})
+}
+
+func NewGameUpdated(gameID int64, title string, releaseDate time.Time, status game.GameStatusType) (model.OutboxEvent, error) {
+	return newEvent(gameID, GameUpdated, GameUpdatedPayload{
+		GameID:      gameID,
+		Title:       title,
+		ReleaseDate: releaseDate.Format(time.DateOnly),
+		Status:      status.String(),
+	})
+}
+
+func NewGameDeleted(gameID int64, title string, releaseYear uint64, status game.GameStatusType) (model.OutboxEvent, error) {
+	return newEvent(gameID, GameDeleted, GameDeletedPayload{
+		GameID:      gameID,
+		Title:       title,
+		ReleaseYear: releaseYear,
+		Status:      status.String(),
+	})
+}
+
+func NewGameStatusChanged(gameID int64, oldStatus, newStatus game.GameStatusType, actor, requestID string) (model.OutboxEvent, error) {
+	return newEvent(gameID, GameStatusChanged, GameStatusChangedPayload{
+		GameID:    gameID,
+		OldStatus: oldStatus.String(),
+		NewStatus: newStatus.String(),
+		Actor:     actor,
+		RequestID: requestID,
+	})
+}
+
+func NewGameRestored(gameID int64, title string, releaseDate time.Time, status game.GameStatusType, actor, requestID string) (model.OutboxEvent, error) {
+	return newEvent(gameID, GameRestored, GameRestoredPayload{
+		GameID:      gameID,
+		Title:       title,
+		ReleaseDate: releaseDate.Format(time.DateOnly),
+		Status:      status.String(),
+		Actor:       actor,
+		RequestID:   requestID,
+	})
+}
+
+// Public сообщает, можно ли показать событие вызывающему без привилегий:
+// игра была опубликована в момент события. Смена статуса публична, если
+// игра была опубликована до или после нее.
+func Public(event model.OutboxEvent) bool {
+	published := game.GameStatusType_PUBLISH.String()
+	switch event.EventType {
+	case GameStatusChanged:
+		var payload GameStatusChangedPayload
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return false
+		}
+		return payload.OldStatus == published || payload.NewStatus == published
+	case GameCreated, GameUpdated, GameDeleted, GameRestored:
+		var payload struct {
+			Status string `json:"status"`
+		}
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return false
+		}
+		return payload.Status == published
+	}
+	return false
+}
+
+func newEvent(gameID int64, eventType string, payload any) (model.OutboxEvent, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return model.OutboxEvent{}, err
+	}
+	return model.OutboxEvent{GameID: gameID, EventType: eventType, Payload: data}, nil
+}
@@ -0,0 +1,93 @@
+package events
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGameCreated(t *testing.T) {
+	t.Parallel()
+	event, err := NewGameCreated(7, "Hades", time.Date(2020, 9, 17, 0, 0, 0, 0, time.UTC), game.GameStatusType_DRAFT)
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), event.GameID)
+	assert.Equal(t, GameCreated, event.EventType)
+	assert.JSONEq(t, `{"game_id":7,"title":"Hades","release_date":"2020-09-17","status":"DRAFT"}`, string(event.Payload))
+}
+
+func TestNewGameUpdated(t *testing.T) {
+	t.Parallel()
+	event, err := NewGameUpdated(7, "Hades II", time.Date(2025, 9, 25, 0, 0, 0, 0, time.UTC), game.GameStatusType_PUBLISH)
+	require.NoError(t, err)
+	assert.Equal(t, GameUpdated, event.EventType)
+	assert.JSONEq(t, `{"game_id":7,"title":"Hades II","release_date":"2025-09-25","status":"PUBLISH"}`, string(event.Payload))
+}
+
+func TestNewGameDeleted(t *testing.T) {
+	t.Parallel()
+	event, err := NewGameDeleted(7, "Hades", 2020, game.GameStatusType_DRAFT)
+	require.NoError(t, err)
+	assert.Equal(t, GameDeleted, event.EventType)
+	var payload GameDeletedPayload
+	require.NoError(t, json.Unmarshal(event.Payload, &payload))
+	assert.Equal(t, GameDeletedPayload{GameID: 7, Title: "Hades", ReleaseYear: 2020, Status: "DRAFT"}, payload)
+}
+
+func TestNewGameStatusChanged(t *testing.T) {
+	t.Parallel()
+	event, err := NewGameStatusChanged(7, game.GameStatusType_PENDING, game.GameStatusType_PUBLISH, "scheduler", "req-1")
+	require.NoError(t, err)
+	assert.Equal(t, GameStatusChanged, event.EventType)
+	assert.JSONEq(t, `{"game_id":7,"old_status":"PENDING","new_status":"PUBLISH","actor":"scheduler","request_id":"req-1"}`, string(event.Payload))
+}
+
+func TestNewGameRestored(t *testing.T) {
+	t.Parallel()
+	event, err := NewGameRestored(7, "Hades", time.Date(2020, 9, 17, 0, 0, 0, 0, time.UTC), game.GameStatusType_PUBLISH, "moderator-1", "req-1")
+	require.NoError(t, err)
+	assert.Equal(t, GameRestored, event.EventType)
+	assert.True(t, Known(event.EventType))
+	assert.JSONEq(t, `{"game_id":7,"title":"Hades","release_date":"2020-09-17","status":"PUBLISH","actor":"moderator-1","request_id":"req-1"}`, string(event.Payload))
+}
+
+func TestPublic(t *testing.T) {
+	t.Parallel()
+	releaseDate := time.Date(2020, 9, 17, 0, 0, 0, 0, time.UTC)
+	cases := []struct {
+		name   string
+		event  func() (model.OutboxEvent, error)
+		public bool
+	}{
+		{"created draft", func() (model.OutboxEvent, error) {
+			return NewGameCreated(7, "Hades", releaseDate, game.GameStatusType_DRAFT)
+		}, false},
+		{"updated published", func() (model.OutboxEvent, error) {
+			return NewGameUpdated(7, "Hades", releaseDate, game.GameStatusType_PUBLISH)
+		}, true},
+		{"deleted pending", func() (model.OutboxEvent, error) {
+			return NewGameDeleted(7, "Hades", 2020, game.GameStatusType_PENDING)
+		}, false},
+		{"submitted for review", func() (model.OutboxEvent, error) {
+			return NewGameStatusChanged(7, game.GameStatusType_DRAFT, game.GameStatusType_PENDING, "user-1", "req-1")
+		}, false},
+		{"unpublished", func() (model.OutboxEvent, error) {
+			return NewGameStatusChanged(7, game.GameStatusType_PUBLISH, game.GameStatusType_DRAFT, "user-1", "req-1")
+		}, true},
+		{"restored published", func() (model.OutboxEvent, error) {
+			return NewGameRestored(7, "Hades", releaseDate, game.GameStatusType_PUBLISH, "user-1", "req-1")
+		}, true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			event, err := tc.event()
+			require.NoError(t, err)
+			assert.Equal(t, tc.public, Public(event))
+		})
+	}
+	assert.False(t, Public(model.OutboxEvent{EventType: "Unknown", Payload: []byte(`{"status":"PUBLISH"}`)}))
+}
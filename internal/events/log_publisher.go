@@ -0,0 +1,27 @@
+package events
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/sariya23/game_service/internal/model"
+)
+
+// LogPublisher пишет события в лог. Используется, пока нет брокера.
+type LogPublisher struct {
+	log *slog.Logger
+}
+
+func NewLogPublisher(log *slog.Logger) *LogPublisher {
+	return &LogPublisher{log: log}
+}
+
+func (p *LogPublisher) Publish(ctx context.Context, event model.OutboxEvent) error {
+	p.log.Info("event published",
+		slog.Int64("outboxID", event.OutboxID),
+		slog.Int64("gameID", event.GameID),
+		slog.String("eventType", event.EventType),
+		slog.String("payload", string(event.Payload)),
+	)
+	return nil
+}
@@ -0,0 +1,29 @@
+package events
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sariya23/game_service/internal/model"
+)
+
+// MultiPublisher отдает событие всем публикаторам по очереди.
+// Событие считается доставленным, только если его приняли все,
+// поэтому публикаторы должны спокойно переносить повторы.
+type MultiPublisher struct {
+	publishers []Publisher
+}
+
+func NewMultiPublisher(publishers ...Publisher) *MultiPublisher {
+	return &MultiPublisher{publishers: publishers}
+}
+
+func (p *MultiPublisher) Publish(ctx context.Context, event model.OutboxEvent) error {
+	var errs []error
+	for _, publisher := range p.publishers {
+		if err := publisher.Publish(ctx, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,55 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sariya23/game_service/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordPublisher struct {
+	err    error
+	events []model.OutboxEvent
+}
+
+func (p *recordPublisher) Publish(_ context.Context, event model.OutboxEvent) error {
+	p.events = append(p.events, event)
+	return p.err
+}
+
+func TestMultiPublisher(t *testing.T) {
+	t.Parallel()
+	t.Run("Событие уходит во все публикаторы", func(t *testing.T) {
+		t.Parallel()
+		first, second := &recordPublisher{}, &recordPublisher{}
+		event := model.OutboxEvent{OutboxID: 1, EventType: GameCreated}
+
+		err := NewMultiPublisher(first, second).Publish(context.Background(), event)
+
+		require.NoError(t, err)
+		assert.Equal(t, []model.OutboxEvent{event}, first.events)
+		assert.Equal(t, []model.OutboxEvent{event}, second.events)
+	})
+	t.Run("Ошибка одного не мешает остальным", func(t *testing.T) {
+		t.Parallel()
+		errBroken := errors.New("broken")
+		first, second := &recordPublisher{err: errBroken}, &recordPublisher{}
+		event := model.OutboxEvent{OutboxID: 1, EventType: GameCreated}
+
+		err := NewMultiPublisher(first, second).Publish(context.Background(), event)
+
+		require.ErrorIs(t, err, errBroken)
+		assert.Len(t, second.events, 1)
+	})
+}
+
+func TestKnown(t *testing.T) {
+	t.Parallel()
+	assert.True(t, Known(GameCreated))
+	assert.True(t, Known(GameStatusChanged))
+	assert.False(t, Known("GameCreate"))
+	assert.False(t, Known(""))
+}
@@ -27,13 +27,23 @@ func (srvApi *serverAPI) AddGame(
 		log.Warn("invalid request", slog.String("details", msg))
 		return &game.AddGameResponse{}, status.Error(codes.InvalidArgument, msg)
 	}
+	var coverImageContentType string
+	if len(request.Game.CoverImage) != 0 {
+		contentType, valid, msg := validators.CoverImage(request.Game.CoverImage, srvApi.coverImageLimits)
+		if !valid {
+			log.Warn("invalid cover image", slog.String("details", msg))
+			return &game.AddGameResponse{}, status.Error(codes.InvalidArgument, msg)
+		}
+		coverImageContentType = contentType
+	}
 	newGame := dto.AddGameHandler{
-		Title:       request.Game.Title,
-		Genres:      request.Game.Genres,
-		Description: request.Game.Description,
-		ReleaseDate: converters.FromProtoDate(request.Game.ReleaseDate),
-		CoverImage:  request.Game.CoverImage,
-		Tags:        request.Game.Tags,
+		Title:                 request.Game.Title,
+		Genres:                request.Game.Genres,
+		Description:           request.Game.Description,
+		ReleaseDate:           converters.FromProtoDate(request.Game.ReleaseDate),
+		CoverImage:            request.Game.CoverImage,
+		Tags:                  request.Game.Tags,
+		CoverImageContentType: coverImageContentType,
 	}
 	gameID, err := srvApi.gameServicer.AddGame(ctx, newGame)
 	if err != nil {
@@ -0,0 +1,55 @@
+package grpchandlers
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/lib/converters"
+	errorhandler "github.com/sariya23/game_service/internal/lib/errorhandler/handlers"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/outerror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (srvApi *serverAPI) ListDeletedGames(
+	ctx context.Context,
+	request *game.ListDeletedGamesRequest,
+) (*game.ListDeletedGamesResponse, error) {
+	log := logger.EnrichRequestID(ctx, srvApi.log)
+	log.Info("request to handler", slog.String("handler", "ListDeletedGames"), slog.Any("request", request))
+	games, err := srvApi.gameServicer.ListDeletedGames(ctx, request.GetLimit())
+	if err != nil {
+		return &game.ListDeletedGamesResponse{}, errorhandler.DeletedGames(err)
+	}
+	result := make([]*game.ListDeletedGamesResponse_DeletedGame, 0, len(games))
+	for _, g := range games {
+		result = append(result, &game.ListDeletedGamesResponse_DeletedGame{
+			GameId:      g.GameID,
+			Title:       g.Title,
+			ReleaseDate: converters.ToProtoDate(g.ReleaseDate),
+			Status:      g.GameStatus,
+			DeletedAt:   timestamppb.New(g.DeletedAt),
+		})
+	}
+	log.Info("success list deleted games")
+	return &game.ListDeletedGamesResponse{Games: result}, nil
+}
+
+func (srvApi *serverAPI) RestoreGame(
+	ctx context.Context,
+	request *game.RestoreGameRequest,
+) (*game.RestoreGameResponse, error) {
+	log := logger.EnrichRequestID(ctx, srvApi.log)
+	log.Info("request to handler", slog.String("handler", "RestoreGame"), slog.Any("request", request))
+	if request.GetGameId() < 0 {
+		return &game.RestoreGameResponse{}, status.Error(codes.InvalidArgument, outerror.NegativeGameIDMessage)
+	}
+	if err := srvApi.gameServicer.RestoreGame(ctx, request.GetGameId()); err != nil {
+		return &game.RestoreGameResponse{}, errorhandler.DeletedGames(err)
+	}
+	log.Info("game restored")
+	return &game.RestoreGameResponse{}, nil
+}
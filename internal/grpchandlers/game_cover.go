@@ -0,0 +1,61 @@
+package grpchandlers
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/sariya23/api_game_service/gen/game"
+	errorhandler "github.com/sariya23/game_service/internal/lib/errorhandler/handlers"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/lib/validators"
+	"github.com/sariya23/game_service/internal/outerror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (srvApi *serverAPI) SetGameCover(
+	ctx context.Context,
+	request *game.SetGameCoverRequest,
+) (*game.SetGameCoverResponse, error) {
+	log := logger.EnrichRequestID(ctx, srvApi.log)
+	log.Info("request to handler",
+		slog.String("handler", "SetGameCover"),
+		slog.Int64("game_id", request.GetGameId()),
+		slog.Int("cover_image_size", len(request.GetCoverImage())),
+	)
+	if valid, msg := validators.SetGameCover(request.GetGameId(), request.GetCoverImage()); !valid {
+		log.Warn("invalid request", slog.String("details", msg))
+		return &game.SetGameCoverResponse{}, status.Error(codes.InvalidArgument, msg)
+	}
+	contentType, valid, msg := validators.CoverImage(request.GetCoverImage(), srvApi.coverImageLimits)
+	if !valid {
+		log.Warn("invalid cover image", slog.String("details", msg))
+		return &game.SetGameCoverResponse{}, status.Error(codes.InvalidArgument, msg)
+	}
+	err := srvApi.gameServicer.SetGameCover(ctx, request.GetGameId(), request.GetCoverImage(), contentType)
+	if err != nil {
+		return &game.SetGameCoverResponse{}, errorhandler.SetGameCover(err)
+	}
+	log.Info("game cover set successfully")
+	return &game.SetGameCoverResponse{}, nil
+}
+
+func (srvApi *serverAPI) RemoveGameCover(
+	ctx context.Context,
+	request *game.RemoveGameCoverRequest,
+) (*game.RemoveGameCoverResponse, error) {
+	log := logger.EnrichRequestID(ctx, srvApi.log)
+	log.Info("request to handler",
+		slog.String("handler", "RemoveGameCover"),
+		slog.Any("request", request),
+	)
+	if request.GetGameId() < 0 {
+		log.Warn("invalid request, game_id is negative")
+		return &game.RemoveGameCoverResponse{}, status.Error(codes.InvalidArgument, outerror.NegativeGameIDMessage)
+	}
+	if err := srvApi.gameServicer.RemoveGameCover(ctx, request.GetGameId()); err != nil {
+		return &game.RemoveGameCoverResponse{}, errorhandler.RemoveGameCover(err)
+	}
+	log.Info("game cover removed successfully")
+	return &game.RemoveGameCoverResponse{}, nil
+}
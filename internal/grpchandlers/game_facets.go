@@ -0,0 +1,56 @@
+package grpchandlers
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/sariya23/api_game_service/gen/game"
+	errorhandler "github.com/sariya23/game_service/internal/lib/errorhandler/handlers"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/lib/validators"
+	"github.com/sariya23/game_service/internal/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (srvApi *serverAPI) GameFacets(
+	ctx context.Context,
+	request *game.GameFacetsRequest,
+) (*game.GameFacetsResponse, error) {
+	log := logger.EnrichRequestID(ctx, srvApi.log)
+	log.Info("request to handler",
+		slog.String("handler", "GameFacets"),
+		slog.Any("request", request),
+	)
+	filters := gameFiltersFromRequest(request)
+	if valid, msg := validators.GameList(filters); !valid {
+		log.Warn("invalid request", slog.String("details", msg))
+		return &game.GameFacetsResponse{}, status.Error(codes.InvalidArgument, msg)
+	}
+	facets, err := srvApi.gameServicer.GameFacets(ctx, filters)
+	if err != nil {
+		return &game.GameFacetsResponse{}, errorhandler.GameList(err)
+	}
+	log.Info("success get game facets")
+	return &game.GameFacetsResponse{
+		Tags:   toProtoFacetCounts(facets.Tags),
+		Genres: toProtoFacetCounts(facets.Genres),
+		Years:  toProtoYearFacetCounts(facets.Years),
+	}, nil
+}
+
+func toProtoFacetCounts(counts []model.FacetCount) []*game.GameFacetsResponse_FacetCount {
+	result := make([]*game.GameFacetsResponse_FacetCount, 0, len(counts))
+	for _, c := range counts {
+		result = append(result, &game.GameFacetsResponse_FacetCount{Value: c.Value, Count: c.Count})
+	}
+	return result
+}
+
+func toProtoYearFacetCounts(counts []model.YearFacetCount) []*game.GameFacetsResponse_YearFacetCount {
+	result := make([]*game.GameFacetsResponse_YearFacetCount, 0, len(counts))
+	for _, c := range counts {
+		result = append(result, &game.GameFacetsResponse_YearFacetCount{Year: c.Year, Count: c.Count})
+	}
+	return result
+}
@@ -6,9 +6,11 @@ import (
 
 	"github.com/sariya23/api_game_service/gen/game"
 	"github.com/sariya23/game_service/internal/lib/converters"
+	errorhandler "github.com/sariya23/game_service/internal/lib/errorhandler/handlers"
 	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/lib/validators"
 	"github.com/sariya23/game_service/internal/model/dto"
-	"github.com/sariya23/game_service/internal/outerror"
+	"google.golang.org/genproto/googleapis/type/date"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -22,26 +24,60 @@ func (srvApi *serverAPI) GameList(
 		slog.String("handler", "GameList"),
 		slog.Any("request", request),
 	)
-	if request.Year < 0 {
-		log.Warn("negative year")
-		return &game.GameListResponse{}, status.Error(codes.InvalidArgument, outerror.NegativeYearMessage)
+	filters := gameFiltersFromRequest(request)
+	filters.Sort = dto.GameSort(request.GetSort())
+	if valid, msg := validators.GameList(filters); !valid {
+		log.Warn("invalid request", slog.String("details", msg))
+		return &game.GameListResponse{}, status.Error(codes.InvalidArgument, msg)
 	}
-	games, err := srvApi.gameServicer.GameList(
+	games, nextPageToken, err := srvApi.gameServicer.GameList(
 		ctx,
-		dto.GameFilters{
-			ReleaseYear: request.GetYear(),
-			Genres:      request.GetGenres(),
-			Tags:        request.GetTags(),
-		},
+		filters,
 		request.GetLimit(),
+		request.GetPageToken(),
 	)
 	if err != nil {
-		return &game.GameListResponse{}, status.Error(codes.Internal, outerror.InternalMessage)
+		return &game.GameListResponse{}, errorhandler.GameList(err)
 	}
 	result := make([]*game.GameListResponse_ShortGame, 0, len(games))
 	for _, g := range games {
 		result = append(result, converters.ToShortGameResponse(g))
 	}
 	log.Info("success get games")
-	return &game.GameListResponse{Games: result}, nil
+	return &game.GameListResponse{Games: result, NextPageToken: nextPageToken}, nil
+}
+
+// gameFiltersRequest - общие фильтры GameListRequest и GameFacetsRequest.
+type gameFiltersRequest interface {
+	GetYear() int32
+	GetDecade() int32
+	GetReleasedAfter() *date.Date
+	GetReleasedBefore() *date.Date
+	GetUpcomingOnly() bool
+	GetGenres() []string
+	GetTags() []string
+	GetGenresMatch() game.MatchMode
+	GetTagsMatch() game.MatchMode
+	GetExcludeGenres() []string
+	GetExcludeTags() []string
+	GetQuery() string
+	GetStatuses() []game.GameStatusType
+}
+
+func gameFiltersFromRequest(request gameFiltersRequest) dto.GameFilters {
+	return dto.GameFilters{
+		ReleaseYear:    request.GetYear(),
+		Decade:         request.GetDecade(),
+		ReleasedAfter:  converters.FromOptionalProtoDate(request.GetReleasedAfter()),
+		ReleasedBefore: converters.FromOptionalProtoDate(request.GetReleasedBefore()),
+		UpcomingOnly:   request.GetUpcomingOnly(),
+		Genres:         request.GetGenres(),
+		Tags:           request.GetTags(),
+		GenresMatch:    dto.MatchMode(request.GetGenresMatch()),
+		TagsMatch:      dto.MatchMode(request.GetTagsMatch()),
+		ExcludeGenres:  request.GetExcludeGenres(),
+		ExcludeTags:    request.GetExcludeTags(),
+		Query:          request.GetQuery(),
+		Statuses:       request.GetStatuses(),
+	}
 }
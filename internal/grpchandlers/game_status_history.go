@@ -0,0 +1,41 @@
+package grpchandlers
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/sariya23/api_game_service/gen/game"
+	errorhandler "github.com/sariya23/game_service/internal/lib/errorhandler/handlers"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/outerror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (srvApi *serverAPI) GetGameStatusHistory(
+	ctx context.Context,
+	request *game.GetGameStatusHistoryRequest,
+) (*game.GetGameStatusHistoryResponse, error) {
+	log := logger.EnrichRequestID(ctx, srvApi.log)
+	log.Info("request to handler", slog.String("handler", "GetGameStatusHistory"), slog.Any("request", request))
+	if request.GetGameId() < 0 {
+		return &game.GetGameStatusHistoryResponse{}, status.Error(codes.InvalidArgument, outerror.NegativeGameIDMessage)
+	}
+	history, err := srvApi.gameServicer.GetGameStatusHistory(ctx, request.GetGameId())
+	if err != nil {
+		return &game.GetGameStatusHistoryResponse{}, errorhandler.GetGameStatusHistory(err)
+	}
+	changes := make([]*game.GetGameStatusHistoryResponse_StatusChange, 0, len(history))
+	for _, entry := range history {
+		changes = append(changes, &game.GetGameStatusHistoryResponse_StatusChange{
+			OldStatus: entry.OldStatus,
+			NewStatus: entry.NewStatus,
+			Actor:     entry.Actor,
+			RequestId: entry.RequestID,
+			ChangedAt: timestamppb.New(entry.ChangedAt),
+		})
+	}
+	log.Info("success get game status history")
+	return &game.GetGameStatusHistoryResponse{Changes: changes}, nil
+}
@@ -0,0 +1,113 @@
+package grpchandlers
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/sariya23/api_game_service/gen/game"
+	errorhandler "github.com/sariya23/game_service/internal/lib/errorhandler/handlers"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/lib/validators"
+	"github.com/sariya23/game_service/internal/outerror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (srvApi *serverAPI) ListGenres(
+	ctx context.Context,
+	request *game.ListGenresRequest,
+) (*game.ListGenresResponse, error) {
+	log := logger.EnrichRequestID(ctx, srvApi.log)
+	log.Info("request to handler", slog.String("handler", "ListGenres"), slog.Any("request", request))
+	genres, err := srvApi.genreServicer.ListGenres(ctx)
+	if err != nil {
+		return &game.ListGenresResponse{}, errorhandler.Genres(err)
+	}
+	result := make([]*game.Genre, 0, len(genres))
+	for _, genre := range genres {
+		result = append(result, &game.Genre{GenreId: genre.GenreID, Name: genre.GenreName})
+	}
+	log.Info("success list genres")
+	return &game.ListGenresResponse{Genres: result}, nil
+}
+
+func (srvApi *serverAPI) CreateGenre(
+	ctx context.Context,
+	request *game.CreateGenreRequest,
+) (*game.CreateGenreResponse, error) {
+	log := logger.EnrichRequestID(ctx, srvApi.log)
+	log.Info("request to handler", slog.String("handler", "CreateGenre"), slog.Any("request", request))
+	if valid, msg := validators.CreateGenre(request.GetName()); !valid {
+		return &game.CreateGenreResponse{}, status.Error(codes.InvalidArgument, msg)
+	}
+	genre, err := srvApi.genreServicer.CreateGenre(ctx, request.GetName())
+	if err != nil {
+		return &game.CreateGenreResponse{}, errorhandler.Genres(err)
+	}
+	log.Info("genre created", slog.Int64("genreID", genre.GenreID))
+	return &game.CreateGenreResponse{Genre: &game.Genre{GenreId: genre.GenreID, Name: genre.GenreName}}, nil
+}
+
+func (srvApi *serverAPI) RenameGenre(
+	ctx context.Context,
+	request *game.RenameGenreRequest,
+) (*game.RenameGenreResponse, error) {
+	log := logger.EnrichRequestID(ctx, srvApi.log)
+	log.Info("request to handler", slog.String("handler", "RenameGenre"), slog.Any("request", request))
+	if valid, msg := validators.RenameGenre(request.GetGenreId(), request.GetName()); !valid {
+		return &game.RenameGenreResponse{}, status.Error(codes.InvalidArgument, msg)
+	}
+	if err := srvApi.genreServicer.RenameGenre(ctx, request.GetGenreId(), request.GetName()); err != nil {
+		return &game.RenameGenreResponse{}, errorhandler.Genres(err)
+	}
+	log.Info("genre renamed")
+	return &game.RenameGenreResponse{}, nil
+}
+
+func (srvApi *serverAPI) DeleteGenre(
+	ctx context.Context,
+	request *game.DeleteGenreRequest,
+) (*game.DeleteGenreResponse, error) {
+	log := logger.EnrichRequestID(ctx, srvApi.log)
+	log.Info("request to handler", slog.String("handler", "DeleteGenre"), slog.Any("request", request))
+	if request.GetGenreId() < 0 {
+		return &game.DeleteGenreResponse{}, status.Error(codes.InvalidArgument, outerror.NegativeGenreIDMessage)
+	}
+	if err := srvApi.genreServicer.DeleteGenre(ctx, request.GetGenreId(), request.GetForce()); err != nil {
+		return &game.DeleteGenreResponse{}, errorhandler.Genres(err)
+	}
+	log.Info("genre deleted")
+	return &game.DeleteGenreResponse{}, nil
+}
+
+func (srvApi *serverAPI) MergeGenres(
+	ctx context.Context,
+	request *game.MergeGenresRequest,
+) (*game.MergeGenresResponse, error) {
+	log := logger.EnrichRequestID(ctx, srvApi.log)
+	log.Info("request to handler", slog.String("handler", "MergeGenres"), slog.Any("request", request))
+	if valid, msg := validators.MergeGenres(request.GetSourceIds(), request.GetTargetId()); !valid {
+		return &game.MergeGenresResponse{}, status.Error(codes.InvalidArgument, msg)
+	}
+	if err := srvApi.genreServicer.MergeGenres(ctx, request.GetSourceIds(), request.GetTargetId()); err != nil {
+		return &game.MergeGenresResponse{}, errorhandler.Genres(err)
+	}
+	log.Info("genres merged")
+	return &game.MergeGenresResponse{}, nil
+}
+
+func (srvApi *serverAPI) SetGenreParent(
+	ctx context.Context,
+	request *game.SetGenreParentRequest,
+) (*game.SetGenreParentResponse, error) {
+	log := logger.EnrichRequestID(ctx, srvApi.log)
+	log.Info("request to handler", slog.String("handler", "SetGenreParent"), slog.Any("request", request))
+	if valid, msg := validators.SetGenreParent(request.GetGenreId(), request.GetParentId()); !valid {
+		return &game.SetGenreParentResponse{}, status.Error(codes.InvalidArgument, msg)
+	}
+	if err := srvApi.genreServicer.SetGenreParent(ctx, request.GetGenreId(), request.GetParentId()); err != nil {
+		return &game.SetGenreParentResponse{}, errorhandler.Genres(err)
+	}
+	log.Info("genre parent set")
+	return &game.SetGenreParentResponse{}, nil
+}
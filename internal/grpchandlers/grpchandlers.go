@@ -3,8 +3,10 @@ package grpchandlers
 import (
 	"context"
 	"log/slog"
+	"time"
 
 	"github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/lib/validators"
 	"github.com/sariya23/game_service/internal/model"
 	"github.com/sariya23/game_service/internal/model/dto"
 	"google.golang.org/grpc"
@@ -13,17 +15,83 @@ import (
 type GameServicer interface {
 	AddGame(ctx context.Context, game dto.AddGameHandler) (gameID int64, err error)
 	GetGame(ctx context.Context, gameID int64) (game *model.Game, err error)
-	GameList(ctx context.Context, gameFilters dto.GameFilters, limit uint32) (games []model.ShortGame, err error)
+	GameList(ctx context.Context, gameFilters dto.GameFilters, limit uint32, pageToken string) (games []model.ShortGame, nextPageToken string, err error)
 	DeleteGame(ctx context.Context, gameID int64) (deletedGameID int64, err error)
 	UpdateGameStatus(ctx context.Context, gameID int64, newStatus game.GameStatusType) error
+	UpdateGame(ctx context.Context, gameToUpdate dto.UpdateGameHandler) error
+	SetGameCover(ctx context.Context, gameID int64, coverImage []byte, contentType string) error
+	RemoveGameCover(ctx context.Context, gameID int64) error
+	SuggestGames(ctx context.Context, prefix string, limit uint32) ([]model.GameSuggestion, error)
+	GameFacets(ctx context.Context, gameFilters dto.GameFilters) (model.GameFacets, error)
+	SubmitForReview(ctx context.Context, gameID int64) error
+	ApproveGame(ctx context.Context, gameID int64) error
+	RejectGame(ctx context.Context, gameID int64, reason string) error
+	ListPendingGames(ctx context.Context, limit uint32) ([]model.PendingGame, error)
+	GetGameStatusHistory(ctx context.Context, gameID int64) ([]model.GameStatusHistoryEntry, error)
+	SchedulePublish(ctx context.Context, gameID int64, publishAt time.Time) error
+	ListDeletedGames(ctx context.Context, limit uint32) ([]model.DeletedGame, error)
+	RestoreGame(ctx context.Context, gameID int64) error
+}
+
+// GameWatcher отдает события об изменении игр по мере их появления.
+type GameWatcher interface {
+	WatchGames(ctx context.Context, afterSeq int64, send func(model.OutboxEvent) error) error
+}
+
+// WebhookServicer управляет подписками партнеров на события игр.
+type WebhookServicer interface {
+	CreateWebhook(ctx context.Context, url string, secret string, eventTypes []string) (model.Webhook, error)
+	ListWebhooks(ctx context.Context) ([]model.Webhook, error)
+	DeleteWebhook(ctx context.Context, webhookID int64) error
+}
+
+// TagServicer ведет справочник тэгов.
+type TagServicer interface {
+	ListTags(ctx context.Context) ([]model.Tag, error)
+	CreateTag(ctx context.Context, tagName string) (model.Tag, error)
+	RenameTag(ctx context.Context, tagID int64, tagName string) error
+	DeleteTag(ctx context.Context, tagID int64, force bool) error
+	MergeTags(ctx context.Context, sourceIDs []int64, targetID int64) error
+}
+
+// GenreServicer ведет справочник жанров.
+type GenreServicer interface {
+	ListGenres(ctx context.Context) ([]model.Genre, error)
+	CreateGenre(ctx context.Context, genreName string) (model.Genre, error)
+	RenameGenre(ctx context.Context, genreID int64, genreName string) error
+	DeleteGenre(ctx context.Context, genreID int64, force bool) error
+	MergeGenres(ctx context.Context, sourceIDs []int64, targetID int64) error
+	SetGenreParent(ctx context.Context, genreID int64, parentID int64) error
 }
 
 type serverAPI struct {
 	game.UnimplementedGameServiceServer
-	gameServicer GameServicer
-	log          *slog.Logger
+	gameServicer     GameServicer
+	gameWatcher      GameWatcher
+	webhookServicer  WebhookServicer
+	tagServicer      TagServicer
+	genreServicer    GenreServicer
+	log              *slog.Logger
+	coverImageLimits validators.CoverImageLimits
 }
 
-func RegisterGrpcHandlers(grpcServer *grpc.Server, gameServicer GameServicer, log *slog.Logger) {
-	game.RegisterGameServiceServer(grpcServer, &serverAPI{gameServicer: gameServicer, log: log})
+func RegisterGrpcHandlers(
+	grpcServer *grpc.Server,
+	gameServicer GameServicer,
+	gameWatcher GameWatcher,
+	webhookServicer WebhookServicer,
+	tagServicer TagServicer,
+	genreServicer GenreServicer,
+	log *slog.Logger,
+	coverImageLimits validators.CoverImageLimits,
+) {
+	game.RegisterGameServiceServer(grpcServer, &serverAPI{
+		gameServicer:     gameServicer,
+		gameWatcher:      gameWatcher,
+		webhookServicer:  webhookServicer,
+		tagServicer:      tagServicer,
+		genreServicer:    genreServicer,
+		log:              log,
+		coverImageLimits: coverImageLimits,
+	})
 }
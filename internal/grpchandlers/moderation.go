@@ -0,0 +1,97 @@
+package grpchandlers
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/lib/converters"
+	errorhandler "github.com/sariya23/game_service/internal/lib/errorhandler/handlers"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/lib/validators"
+	"github.com/sariya23/game_service/internal/model"
+	"github.com/sariya23/game_service/internal/outerror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (srvApi *serverAPI) SubmitForReview(
+	ctx context.Context,
+	request *game.SubmitForReviewRequest,
+) (*game.SubmitForReviewResponse, error) {
+	log := logger.EnrichRequestID(ctx, srvApi.log)
+	log.Info("request to handler", slog.String("handler", "SubmitForReview"), slog.Any("request", request))
+	if request.GetGameId() < 0 {
+		return &game.SubmitForReviewResponse{}, status.Error(codes.InvalidArgument, outerror.NegativeGameIDMessage)
+	}
+	if err := srvApi.gameServicer.SubmitForReview(ctx, request.GetGameId()); err != nil {
+		return &game.SubmitForReviewResponse{}, errorhandler.Moderation(err)
+	}
+	log.Info("game submitted for review")
+	return &game.SubmitForReviewResponse{}, nil
+}
+
+func (srvApi *serverAPI) ApproveGame(
+	ctx context.Context,
+	request *game.ApproveGameRequest,
+) (*game.ApproveGameResponse, error) {
+	log := logger.EnrichRequestID(ctx, srvApi.log)
+	log.Info("request to handler", slog.String("handler", "ApproveGame"), slog.Any("request", request))
+	if request.GetGameId() < 0 {
+		return &game.ApproveGameResponse{}, status.Error(codes.InvalidArgument, outerror.NegativeGameIDMessage)
+	}
+	if err := srvApi.gameServicer.ApproveGame(ctx, request.GetGameId()); err != nil {
+		return &game.ApproveGameResponse{}, errorhandler.Moderation(err)
+	}
+	log.Info("game approved")
+	return &game.ApproveGameResponse{}, nil
+}
+
+func (srvApi *serverAPI) RejectGame(
+	ctx context.Context,
+	request *game.RejectGameRequest,
+) (*game.RejectGameResponse, error) {
+	log := logger.EnrichRequestID(ctx, srvApi.log)
+	log.Info("request to handler", slog.String("handler", "RejectGame"), slog.Any("request", request))
+	if valid, msg := validators.RejectGame(request.GetGameId(), request.GetReason()); !valid {
+		log.Warn("invalid request", slog.String("details", msg))
+		return &game.RejectGameResponse{}, status.Error(codes.InvalidArgument, msg)
+	}
+	if err := srvApi.gameServicer.RejectGame(ctx, request.GetGameId(), request.GetReason()); err != nil {
+		return &game.RejectGameResponse{}, errorhandler.Moderation(err)
+	}
+	log.Info("game rejected")
+	return &game.RejectGameResponse{}, nil
+}
+
+func (srvApi *serverAPI) ListPendingGames(
+	ctx context.Context,
+	request *game.ListPendingGamesRequest,
+) (*game.ListPendingGamesResponse, error) {
+	log := logger.EnrichRequestID(ctx, srvApi.log)
+	log.Info("request to handler", slog.String("handler", "ListPendingGames"), slog.Any("request", request))
+	games, err := srvApi.gameServicer.ListPendingGames(ctx, request.GetLimit())
+	if err != nil {
+		return &game.ListPendingGamesResponse{}, errorhandler.Moderation(err)
+	}
+	result := make([]*game.ListPendingGamesResponse_PendingGame, 0, len(games))
+	for _, g := range games {
+		result = append(result, toProtoPendingGame(g))
+	}
+	log.Info("success list pending games")
+	return &game.ListPendingGamesResponse{Games: result}, nil
+}
+
+func toProtoPendingGame(g model.PendingGame) *game.ListPendingGamesResponse_PendingGame {
+	pending := &game.ListPendingGamesResponse_PendingGame{
+		GameId:      g.GameID,
+		Title:       g.Title,
+		ReleaseDate: converters.ToProtoDate(g.ReleaseDate),
+		SubmittedBy: g.SubmittedBy,
+	}
+	if !g.SubmittedAt.IsZero() {
+		pending.SubmittedAt = timestamppb.New(g.SubmittedAt)
+	}
+	return pending
+}
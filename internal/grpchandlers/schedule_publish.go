@@ -0,0 +1,34 @@
+package grpchandlers
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/sariya23/api_game_service/gen/game"
+	errorhandler "github.com/sariya23/game_service/internal/lib/errorhandler/handlers"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/outerror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (srvApi *serverAPI) SchedulePublish(
+	ctx context.Context,
+	request *game.SchedulePublishRequest,
+) (*game.SchedulePublishResponse, error) {
+	log := logger.EnrichRequestID(ctx, srvApi.log)
+	log.Info("request to handler", slog.String("handler", "SchedulePublish"), slog.Any("request", request))
+	if request.GetGameId() < 0 {
+		return &game.SchedulePublishResponse{}, status.Error(codes.InvalidArgument, outerror.NegativeGameIDMessage)
+	}
+	var publishAt time.Time
+	if request.GetPublishAt() != nil {
+		publishAt = request.GetPublishAt().AsTime()
+	}
+	if err := srvApi.gameServicer.SchedulePublish(ctx, request.GetGameId(), publishAt); err != nil {
+		return &game.SchedulePublishResponse{}, errorhandler.SchedulePublish(err)
+	}
+	log.Info("publish scheduled")
+	return &game.SchedulePublishResponse{}, nil
+}
@@ -0,0 +1,38 @@
+package grpchandlers
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/lib/validators"
+	"github.com/sariya23/game_service/internal/outerror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (srvApi *serverAPI) SuggestGames(
+	ctx context.Context,
+	request *game.SuggestGamesRequest,
+) (*game.SuggestGamesResponse, error) {
+	log := logger.EnrichRequestID(ctx, srvApi.log)
+	log.Info("request to handler",
+		slog.String("handler", "SuggestGames"),
+		slog.Any("request", request),
+	)
+	if valid, msg := validators.SuggestGames(request.GetPrefix()); !valid {
+		log.Warn("invalid request", slog.String("details", msg))
+		return &game.SuggestGamesResponse{}, status.Error(codes.InvalidArgument, msg)
+	}
+	suggestions, err := srvApi.gameServicer.SuggestGames(ctx, request.GetPrefix(), request.GetLimit())
+	if err != nil {
+		return &game.SuggestGamesResponse{}, status.Error(codes.Internal, outerror.InternalMessage)
+	}
+	result := make([]*game.SuggestGamesResponse_Suggestion, 0, len(suggestions))
+	for _, s := range suggestions {
+		result = append(result, &game.SuggestGamesResponse_Suggestion{GameId: s.GameID, Title: s.Title})
+	}
+	log.Info("success suggest games")
+	return &game.SuggestGamesResponse{Suggestions: result}, nil
+}
@@ -0,0 +1,97 @@
+package grpchandlers
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/sariya23/api_game_service/gen/game"
+	errorhandler "github.com/sariya23/game_service/internal/lib/errorhandler/handlers"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/lib/validators"
+	"github.com/sariya23/game_service/internal/outerror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (srvApi *serverAPI) ListTags(
+	ctx context.Context,
+	request *game.ListTagsRequest,
+) (*game.ListTagsResponse, error) {
+	log := logger.EnrichRequestID(ctx, srvApi.log)
+	log.Info("request to handler", slog.String("handler", "ListTags"), slog.Any("request", request))
+	tags, err := srvApi.tagServicer.ListTags(ctx)
+	if err != nil {
+		return &game.ListTagsResponse{}, errorhandler.Tags(err)
+	}
+	result := make([]*game.Tag, 0, len(tags))
+	for _, tag := range tags {
+		result = append(result, &game.Tag{TagId: tag.TagID, Name: tag.TagName})
+	}
+	log.Info("success list tags")
+	return &game.ListTagsResponse{Tags: result}, nil
+}
+
+func (srvApi *serverAPI) CreateTag(
+	ctx context.Context,
+	request *game.CreateTagRequest,
+) (*game.CreateTagResponse, error) {
+	log := logger.EnrichRequestID(ctx, srvApi.log)
+	log.Info("request to handler", slog.String("handler", "CreateTag"), slog.Any("request", request))
+	if valid, msg := validators.CreateTag(request.GetName()); !valid {
+		return &game.CreateTagResponse{}, status.Error(codes.InvalidArgument, msg)
+	}
+	tag, err := srvApi.tagServicer.CreateTag(ctx, request.GetName())
+	if err != nil {
+		return &game.CreateTagResponse{}, errorhandler.Tags(err)
+	}
+	log.Info("tag created", slog.Int64("tagID", tag.TagID))
+	return &game.CreateTagResponse{Tag: &game.Tag{TagId: tag.TagID, Name: tag.TagName}}, nil
+}
+
+func (srvApi *serverAPI) RenameTag(
+	ctx context.Context,
+	request *game.RenameTagRequest,
+) (*game.RenameTagResponse, error) {
+	log := logger.EnrichRequestID(ctx, srvApi.log)
+	log.Info("request to handler", slog.String("handler", "RenameTag"), slog.Any("request", request))
+	if valid, msg := validators.RenameTag(request.GetTagId(), request.GetName()); !valid {
+		return &game.RenameTagResponse{}, status.Error(codes.InvalidArgument, msg)
+	}
+	if err := srvApi.tagServicer.RenameTag(ctx, request.GetTagId(), request.GetName()); err != nil {
+		return &game.RenameTagResponse{}, errorhandler.Tags(err)
+	}
+	log.Info("tag renamed")
+	return &game.RenameTagResponse{}, nil
+}
+
+func (srvApi *serverAPI) DeleteTag(
+	ctx context.Context,
+	request *game.DeleteTagRequest,
+) (*game.DeleteTagResponse, error) {
+	log := logger.EnrichRequestID(ctx, srvApi.log)
+	log.Info("request to handler", slog.String("handler", "DeleteTag"), slog.Any("request", request))
+	if request.GetTagId() < 0 {
+		return &game.DeleteTagResponse{}, status.Error(codes.InvalidArgument, outerror.NegativeTagIDMessage)
+	}
+	if err := srvApi.tagServicer.DeleteTag(ctx, request.GetTagId(), request.GetForce()); err != nil {
+		return &game.DeleteTagResponse{}, errorhandler.Tags(err)
+	}
+	log.Info("tag deleted")
+	return &game.DeleteTagResponse{}, nil
+}
+
+func (srvApi *serverAPI) MergeTags(
+	ctx context.Context,
+	request *game.MergeTagsRequest,
+) (*game.MergeTagsResponse, error) {
+	log := logger.EnrichRequestID(ctx, srvApi.log)
+	log.Info("request to handler", slog.String("handler", "MergeTags"), slog.Any("request", request))
+	if valid, msg := validators.MergeTags(request.GetSourceIds(), request.GetTargetId()); !valid {
+		return &game.MergeTagsResponse{}, status.Error(codes.InvalidArgument, msg)
+	}
+	if err := srvApi.tagServicer.MergeTags(ctx, request.GetSourceIds(), request.GetTargetId()); err != nil {
+		return &game.MergeTagsResponse{}, errorhandler.Tags(err)
+	}
+	log.Info("tags merged")
+	return &game.MergeTagsResponse{}, nil
+}
@@ -0,0 +1,46 @@
+package grpchandlers
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/lib/converters"
+	errorhandler "github.com/sariya23/game_service/internal/lib/errorhandler/handlers"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/lib/validators"
+	"github.com/sariya23/game_service/internal/model/dto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (srvApi *serverAPI) UpdateGame(
+	ctx context.Context,
+	request *game.UpdateGameRequest,
+) (*game.UpdateGameResponse, error) {
+	log := logger.EnrichRequestID(ctx, srvApi.log)
+	log.Info("request to handler",
+		slog.String("handler", "UpdateGame"),
+		slog.Any("request", request),
+	)
+	gameToUpdate := dto.UpdateGameHandler{
+		GameID:      request.GetGameId(),
+		Title:       request.GetGame().GetTitle(),
+		Genres:      request.GetGame().GetGenres(),
+		Description: request.GetGame().GetDescription(),
+		Tags:        request.GetGame().GetTags(),
+		UpdateMask:  request.GetUpdateMask().GetPaths(),
+	}
+	if releaseDate := request.GetGame().GetReleaseDate(); releaseDate.GetYear() != 0 && releaseDate.GetMonth() != 0 && releaseDate.GetDay() != 0 {
+		gameToUpdate.ReleaseDate = converters.FromProtoDate(releaseDate)
+	}
+	if valid, msg := validators.UpdateGame(gameToUpdate); !valid {
+		log.Warn("invalid request", slog.String("details", msg))
+		return &game.UpdateGameResponse{}, status.Error(codes.InvalidArgument, msg)
+	}
+	if err := srvApi.gameServicer.UpdateGame(ctx, gameToUpdate); err != nil {
+		return &game.UpdateGameResponse{}, errorhandler.UpdateGame(err)
+	}
+	log.Info("game updated successfully")
+	return &game.UpdateGameResponse{}, nil
+}
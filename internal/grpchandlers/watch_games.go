@@ -0,0 +1,38 @@
+package grpchandlers
+
+import (
+	"log/slog"
+
+	"github.com/sariya23/api_game_service/gen/game"
+	errorhandler "github.com/sariya23/game_service/internal/lib/errorhandler/handlers"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/model"
+	"github.com/sariya23/game_service/internal/outerror"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (srvApi *serverAPI) WatchGames(
+	request *game.WatchGamesRequest,
+	stream grpc.ServerStreamingServer[game.GameEvent],
+) error {
+	ctx := stream.Context()
+	log := logger.EnrichRequestID(ctx, srvApi.log)
+	log.Info("request to handler", slog.String("handler", "WatchGames"), slog.Any("request", request))
+	if request.GetAfterSeq() < 0 {
+		return status.Error(codes.InvalidArgument, outerror.NegativeAfterSeqMessage)
+	}
+	err := srvApi.gameWatcher.WatchGames(ctx, request.GetAfterSeq(), func(event model.OutboxEvent) error {
+		return stream.Send(&game.GameEvent{
+			Seq:       event.OutboxID,
+			GameId:    event.GameID,
+			EventType: event.EventType,
+			Payload:   string(event.Payload),
+			CreatedAt: timestamppb.New(event.CreatedAt),
+		})
+	})
+	log.Info("watch games finished", slog.String("reason", err.Error()))
+	return errorhandler.WatchGames(err)
+}
@@ -0,0 +1,85 @@
+package grpchandlers
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/sariya23/api_game_service/gen/game"
+	errorhandler "github.com/sariya23/game_service/internal/lib/errorhandler/handlers"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/lib/validators"
+	"github.com/sariya23/game_service/internal/model"
+	"github.com/sariya23/game_service/internal/outerror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (srvApi *serverAPI) CreateWebhook(
+	ctx context.Context,
+	request *game.CreateWebhookRequest,
+) (*game.CreateWebhookResponse, error) {
+	log := logger.EnrichRequestID(ctx, srvApi.log)
+	// Запрос целиком не пишется в лог: в нем секрет подписи.
+	log.Info("request to handler",
+		slog.String("handler", "CreateWebhook"),
+		slog.String("url", request.GetUrl()),
+		slog.Any("eventTypes", request.GetEventTypes()),
+	)
+	if valid, msg := validators.CreateWebhook(request.GetUrl(), request.GetEventTypes()); !valid {
+		return &game.CreateWebhookResponse{}, status.Error(codes.InvalidArgument, msg)
+	}
+	webhook, err := srvApi.webhookServicer.CreateWebhook(ctx, request.GetUrl(), request.GetSecret(), request.GetEventTypes())
+	if err != nil {
+		return &game.CreateWebhookResponse{}, errorhandler.Webhook(err)
+	}
+	response := toProtoWebhook(webhook)
+	response.Secret = webhook.Secret
+	log.Info("webhook created", slog.Int64("webhookID", webhook.WebhookID))
+	return &game.CreateWebhookResponse{Webhook: response}, nil
+}
+
+func (srvApi *serverAPI) ListWebhooks(
+	ctx context.Context,
+	request *game.ListWebhooksRequest,
+) (*game.ListWebhooksResponse, error) {
+	log := logger.EnrichRequestID(ctx, srvApi.log)
+	log.Info("request to handler", slog.String("handler", "ListWebhooks"), slog.Any("request", request))
+	webhooks, err := srvApi.webhookServicer.ListWebhooks(ctx)
+	if err != nil {
+		return &game.ListWebhooksResponse{}, errorhandler.Webhook(err)
+	}
+	result := make([]*game.Webhook, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		result = append(result, toProtoWebhook(webhook))
+	}
+	log.Info("success list webhooks")
+	return &game.ListWebhooksResponse{Webhooks: result}, nil
+}
+
+func (srvApi *serverAPI) DeleteWebhook(
+	ctx context.Context,
+	request *game.DeleteWebhookRequest,
+) (*game.DeleteWebhookResponse, error) {
+	log := logger.EnrichRequestID(ctx, srvApi.log)
+	log.Info("request to handler", slog.String("handler", "DeleteWebhook"), slog.Any("request", request))
+	if request.GetWebhookId() < 0 {
+		return &game.DeleteWebhookResponse{}, status.Error(codes.InvalidArgument, outerror.NegativeWebhookIDMessage)
+	}
+	if err := srvApi.webhookServicer.DeleteWebhook(ctx, request.GetWebhookId()); err != nil {
+		return &game.DeleteWebhookResponse{}, errorhandler.Webhook(err)
+	}
+	log.Info("webhook deleted")
+	return &game.DeleteWebhookResponse{}, nil
+}
+
+// toProtoWebhook переводит подписку в ответ без секрета.
+func toProtoWebhook(webhook model.Webhook) *game.Webhook {
+	return &game.Webhook{
+		WebhookId:  webhook.WebhookID,
+		Url:        webhook.URL,
+		EventTypes: webhook.EventTypes,
+		CreatedBy:  webhook.CreatedBy,
+		CreatedAt:  timestamppb.New(webhook.CreatedAt),
+	}
+}
@@ -0,0 +1,67 @@
+package interceptors
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/sariya23/game_service/internal/lib/authtoken"
+	"github.com/sariya23/game_service/internal/outerror"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	CallerIDKey   contextKey = "caller_id"
+	CallerRoleKey contextKey = "caller_role"
+)
+
+// NewCallerInterceptor кладет в контекст id и роль вызывающего из токена
+// в метаданных authorization ("Bearer <token>"), подписанного secret.
+// Запрос без токена анонимный. Метаданные user_id и user_role отклоняются:
+// их может выставить любой клиент, поэтому доверять им нельзя.
+func NewCallerInterceptor(secret []byte) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := callerContext(ctx, secret)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// NewCallerStreamInterceptor - то же, что NewCallerInterceptor, для потоковых RPC.
+func NewCallerStreamInterceptor(secret []byte) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := callerContext(stream.Context(), secret)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextServerStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+func callerContext(ctx context.Context, secret []byte) (context.Context, error) {
+	var claims authtoken.Claims
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if len(md.Get("user_id")) > 0 || len(md.Get("user_role")) > 0 {
+			return nil, status.Error(codes.Unauthenticated, outerror.CallerMetadataForbiddenMessage)
+		}
+		if values := md.Get("authorization"); len(values) > 0 {
+			token, ok := strings.CutPrefix(values[0], "Bearer ")
+			if !ok {
+				return nil, status.Error(codes.Unauthenticated, outerror.InvalidAuthTokenMessage)
+			}
+			var err error
+			claims, err = authtoken.Verify(secret, token, time.Now())
+			if err != nil {
+				return nil, status.Error(codes.Unauthenticated, outerror.InvalidAuthTokenMessage)
+			}
+		}
+	}
+	ctx = context.WithValue(ctx, CallerIDKey, claims.Subject)
+	ctx = context.WithValue(ctx, CallerRoleKey, claims.Role)
+	return ctx, nil
+}
@@ -0,0 +1,88 @@
+package interceptors
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sariya23/game_service/internal/lib/authtoken"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestCallerInterceptor(t *testing.T) {
+	t.Parallel()
+	secret := []byte("secret")
+	token := authtoken.Sign(secret, authtoken.Claims{Subject: "42", Role: "moderator", ExpiresAt: time.Now().Add(time.Minute).Unix()})
+	interceptor := NewCallerInterceptor(secret)
+	cases := []struct {
+		name         string
+		md           metadata.MD
+		expectedCode codes.Code
+		expectedID   string
+		expectedRole string
+	}{
+		{name: "anonymous", md: metadata.MD{}, expectedCode: codes.OK},
+		{name: "valid token", md: metadata.Pairs("authorization", "Bearer "+token), expectedCode: codes.OK, expectedID: "42", expectedRole: "moderator"},
+		{name: "token without bearer", md: metadata.Pairs("authorization", token), expectedCode: codes.Unauthenticated},
+		{name: "token of other secret", md: metadata.Pairs("authorization", "Bearer "+authtoken.Sign([]byte("other"), authtoken.Claims{Role: "admin", ExpiresAt: time.Now().Add(time.Minute).Unix()})), expectedCode: codes.Unauthenticated},
+		{name: "raw role", md: metadata.Pairs("user_role", "admin"), expectedCode: codes.Unauthenticated},
+		{name: "raw id next to token", md: metadata.Pairs("authorization", "Bearer "+token, "user_id", "1"), expectedCode: codes.Unauthenticated},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			var gotID, gotRole string
+			handler := func(ctx context.Context, _ any) (any, error) {
+				gotID, _ = ctx.Value(CallerIDKey).(string)
+				gotRole, _ = ctx.Value(CallerRoleKey).(string)
+				return nil, nil
+			}
+			ctx := metadata.NewIncomingContext(context.Background(), tc.md)
+
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+
+			require.Equal(t, tc.expectedCode, status.Code(err))
+			assert.Equal(t, tc.expectedID, gotID)
+			assert.Equal(t, tc.expectedRole, gotRole)
+		})
+	}
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestCallerStreamInterceptor(t *testing.T) {
+	t.Parallel()
+	secret := []byte("secret")
+	token := authtoken.Sign(secret, authtoken.Claims{Subject: "42", Role: "admin", ExpiresAt: time.Now().Add(time.Minute).Unix()})
+	interceptor := NewCallerStreamInterceptor(secret)
+	var gotID, gotRole string
+	handler := func(_ any, stream grpc.ServerStream) error {
+		gotID, _ = stream.Context().Value(CallerIDKey).(string)
+		gotRole, _ = stream.Context().Value(CallerRoleKey).(string)
+		return nil
+	}
+
+	stream := &fakeServerStream{ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))}
+	err := interceptor(nil, stream, &grpc.StreamServerInfo{}, handler)
+
+	require.NoError(t, err)
+	assert.Equal(t, "42", gotID)
+	assert.Equal(t, "admin", gotRole)
+
+	stream = &fakeServerStream{ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs("user_role", "admin"))}
+	err = interceptor(nil, stream, &grpc.StreamServerInfo{}, handler)
+
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
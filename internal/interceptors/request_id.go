@@ -15,6 +15,15 @@ const (
 )
 
 func RequestIDInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	resp, err := handler(requestIDContext(ctx), req)
+	return resp, err
+}
+
+func RequestIDStreamInterceptor(srv any, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &contextServerStream{ServerStream: stream, ctx: requestIDContext(stream.Context())})
+}
+
+func requestIDContext(ctx context.Context) context.Context {
 	var requestID string
 	if md, ok := metadata.FromIncomingContext(ctx); ok {
 		if ids := md.Get("request_id"); len(ids) > 0 {
@@ -24,8 +33,16 @@ func RequestIDInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo,
 	if requestID == "" {
 		requestID = generate.GenerateRequestID()
 	}
+	return context.WithValue(ctx, RequestIDKey, requestID)
+}
 
-	ctx = context.WithValue(ctx, RequestIDKey, requestID)
-	resp, err := handler(ctx, req)
-	return resp, err
+// contextServerStream подменяет контекст потока, чтобы обработчик видел
+// значения, положенные потоковыми перехватчиками.
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextServerStream) Context() context.Context {
+	return s.ctx
 }
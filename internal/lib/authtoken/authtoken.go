@@ -0,0 +1,82 @@
+package authtoken
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sariya23/game_service/internal/outerror"
+)
+
+// header - единственный принимаемый заголовок JWT: HS256 с общим секретом.
+const header = `{"alg":"HS256","typ":"JWT"}`
+
+// Claims - данные о вызывающем, которые подписывает сервис аутентификации.
+type Claims struct {
+	Subject   string `json:"sub"`
+	Role      string `json:"role"`
+	ExpiresAt int64  `json:"exp"`
+	NotBefore int64  `json:"nbf,omitempty"`
+}
+
+// Sign выпускает токен с подписью HMAC-SHA256.
+func Sign(secret []byte, claims Claims) string {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		// структура claims всегда сериализуется
+		panic(fmt.Sprintf("authtoken.Sign: %v", err))
+	}
+	unsigned := base64.RawURLEncoding.EncodeToString([]byte(header)) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(sign(secret, unsigned))
+}
+
+// Verify проверяет подпись, алгоритм и срок действия (exp, nbf) токена
+// и возвращает его claims.
+func Verify(secret []byte, token string, now time.Time) (Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return Claims{}, fmt.Errorf("%w: malformed token", outerror.ErrInvalidAuthToken)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return Claims{}, fmt.Errorf("%w: %v", outerror.ErrInvalidAuthToken, err)
+	}
+	if !hmac.Equal(signature, sign(secret, parts[0]+"."+parts[1])) {
+		return Claims{}, fmt.Errorf("%w: bad signature", outerror.ErrInvalidAuthToken)
+	}
+	rawHeader, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return Claims{}, fmt.Errorf("%w: %v", outerror.ErrInvalidAuthToken, err)
+	}
+	var h struct {
+		Alg string `json:"alg"`
+	}
+	if err := json.Unmarshal(rawHeader, &h); err != nil || h.Alg != "HS256" {
+		return Claims{}, fmt.Errorf("%w: unsupported alg", outerror.ErrInvalidAuthToken)
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return Claims{}, fmt.Errorf("%w: %v", outerror.ErrInvalidAuthToken, err)
+	}
+	var claims Claims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return Claims{}, fmt.Errorf("%w: %v", outerror.ErrInvalidAuthToken, err)
+	}
+	if claims.ExpiresAt == 0 || !now.Before(time.Unix(claims.ExpiresAt, 0)) {
+		return Claims{}, fmt.Errorf("%w: token expired", outerror.ErrInvalidAuthToken)
+	}
+	if claims.NotBefore != 0 && now.Before(time.Unix(claims.NotBefore, 0)) {
+		return Claims{}, fmt.Errorf("%w: token not valid yet", outerror.ErrInvalidAuthToken)
+	}
+	return claims, nil
+}
+
+func sign(secret []byte, unsigned string) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(unsigned))
+	return mac.Sum(nil)
+}
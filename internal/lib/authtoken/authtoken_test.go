@@ -0,0 +1,83 @@
+package authtoken
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sariya23/game_service/internal/outerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignVerify(t *testing.T) {
+	t.Parallel()
+	secret := []byte("secret")
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	claims := Claims{Subject: "42", Role: "moderator", ExpiresAt: now.Add(time.Minute).Unix()}
+
+	verified, err := Verify(secret, Sign(secret, claims), now)
+
+	require.NoError(t, err)
+	assert.Equal(t, claims, verified)
+}
+
+func TestVerify_NotBefore(t *testing.T) {
+	t.Parallel()
+	secret := []byte("secret")
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	claims := Claims{Subject: "42", Role: "user", ExpiresAt: now.Add(time.Minute).Unix(), NotBefore: now.Unix()}
+
+	verified, err := Verify(secret, Sign(secret, claims), now)
+
+	require.NoError(t, err)
+	assert.Equal(t, claims, verified)
+}
+
+func TestVerify_InvalidToken(t *testing.T) {
+	t.Parallel()
+	secret := []byte("secret")
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	valid := Sign(secret, Claims{Subject: "42", Role: "user", ExpiresAt: now.Add(time.Minute).Unix()})
+	parts := strings.Split(valid, ".")
+	forgedPayload := base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"42","role":"admin","exp":4102444800}`))
+	noneHeader := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`))
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	require.NoError(t, err)
+	withHeader := func(rawHeader string) string {
+		unsigned := base64.RawURLEncoding.EncodeToString([]byte(rawHeader)) + "." + parts[1]
+		return unsigned + "." + base64.RawURLEncoding.EncodeToString(sign(secret, unsigned))
+	}
+	withSignature := func(sig []byte) string {
+		return parts[0] + "." + parts[1] + "." + base64.RawURLEncoding.EncodeToString(sig)
+	}
+	cases := []struct {
+		name  string
+		token string
+	}{
+		{name: "empty", token: ""},
+		{name: "not three parts", token: "a.b"},
+		{name: "other secret", token: Sign([]byte("other"), Claims{Subject: "42", Role: "admin", ExpiresAt: now.Add(time.Minute).Unix()})},
+		{name: "forged payload", token: parts[0] + "." + forgedPayload + "." + parts[2]},
+		{name: "alg none", token: noneHeader + "." + parts[1] + "."},
+		{name: "signed alg none", token: withHeader(`{"alg":"none","typ":"JWT"}`)},
+		{name: "signed alg RS256", token: withHeader(`{"alg":"RS256","typ":"JWT"}`)},
+		{name: "signed without alg", token: withHeader(`{"typ":"JWT"}`)},
+		{name: "empty signature", token: parts[0] + "." + parts[1] + "."},
+		{name: "truncated signature", token: withSignature(signature[:len(signature)-1])},
+		{name: "too long signature", token: withSignature(append(signature[:len(signature):len(signature)], 0))},
+		{name: "signature not base64", token: parts[0] + "." + parts[1] + ".!!"},
+		{name: "expired", token: Sign(secret, Claims{Subject: "42", Role: "admin", ExpiresAt: now.Add(-time.Second).Unix()})},
+		{name: "expires now", token: Sign(secret, Claims{Subject: "42", Role: "admin", ExpiresAt: now.Unix()})},
+		{name: "without exp", token: Sign(secret, Claims{Subject: "42", Role: "admin"})},
+		{name: "not valid yet", token: Sign(secret, Claims{Subject: "42", Role: "admin", ExpiresAt: now.Add(time.Minute).Unix(), NotBefore: now.Add(time.Second).Unix()})},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := Verify(secret, tc.token, now)
+			assert.ErrorIs(t, err, outerror.ErrInvalidAuthToken)
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package backoff
+
+import "time"
+
+// Exponential возвращает задержку перед попыткой attempt (с единицы):
+// base, 2*base, 4*base, ... но не больше max.
+func Exponential(attempt int, base, max time.Duration) time.Duration {
+	if attempt <= 1 {
+		return min(base, max)
+	}
+	delay := base
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if delay >= max || delay <= 0 {
+			return max
+		}
+	}
+	return delay
+}
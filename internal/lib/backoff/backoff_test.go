@@ -0,0 +1,30 @@
+package backoff
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExponential(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name     string
+		attempt  int
+		expected time.Duration
+	}{
+		{name: "zero attempt", attempt: 0, expected: time.Second},
+		{name: "first attempt", attempt: 1, expected: time.Second},
+		{name: "second attempt", attempt: 2, expected: 2 * time.Second},
+		{name: "fourth attempt", attempt: 4, expected: 8 * time.Second},
+		{name: "capped", attempt: 10, expected: time.Minute},
+		{name: "overflow", attempt: 200, expected: time.Minute},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, Exponential(tc.attempt, time.Second, time.Minute))
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package caller
+
+import (
+	"context"
+
+	"github.com/sariya23/game_service/internal/interceptors"
+)
+
+const (
+	RoleModerator = "moderator"
+	RoleAdmin     = "admin"
+)
+
+// ID возвращает id вызывающего или пустую строку для анонимного запроса.
+func ID(ctx context.Context) string {
+	id, _ := ctx.Value(interceptors.CallerIDKey).(string)
+	return id
+}
+
+// Role возвращает роль вызывающего или пустую строку.
+func Role(ctx context.Context) string {
+	role, _ := ctx.Value(interceptors.CallerRoleKey).(string)
+	return role
+}
+
+// IsPrivileged сообщает, видит ли вызывающий черновики и игры на модерации.
+func IsPrivileged(ctx context.Context) bool {
+	switch Role(ctx) {
+	case RoleModerator, RoleAdmin:
+		return true
+	default:
+		return false
+	}
+}
+
+// RequestID возвращает id запроса, в котором действует вызывающий.
+func RequestID(ctx context.Context) string {
+	requestID, _ := ctx.Value(interceptors.RequestIDKey).(string)
+	return requestID
+}
@@ -0,0 +1,36 @@
+package caller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sariya23/game_service/internal/interceptors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsPrivileged(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name     string
+		ctx      context.Context
+		expected bool
+	}{
+		{name: "no role in context", ctx: context.Background(), expected: false},
+		{name: "empty role", ctx: context.WithValue(context.Background(), interceptors.CallerRoleKey, ""), expected: false},
+		{name: "user", ctx: context.WithValue(context.Background(), interceptors.CallerRoleKey, "user"), expected: false},
+		{name: "moderator", ctx: context.WithValue(context.Background(), interceptors.CallerRoleKey, RoleModerator), expected: true},
+		{name: "admin", ctx: context.WithValue(context.Background(), interceptors.CallerRoleKey, RoleAdmin), expected: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, IsPrivileged(tc.ctx))
+		})
+	}
+}
+
+func TestID(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "", ID(context.Background()))
+	assert.Equal(t, "42", ID(context.WithValue(context.Background(), interceptors.CallerIDKey, "42")))
+}
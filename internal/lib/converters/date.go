@@ -17,3 +17,12 @@ func ToProtoDate(t time.Time) *date.Date {
 func FromProtoDate(t *date.Date) time.Time {
 	return time.Date(int(t.Year), time.Month(t.Month), int(t.Day), 0, 0, 0, 0, time.UTC)
 }
+
+// FromOptionalProtoDate - как FromProtoDate, но для незаданной
+// или неполной даты возвращает нулевое время.
+func FromOptionalProtoDate(t *date.Date) time.Time {
+	if t.GetYear() == 0 || t.GetMonth() == 0 || t.GetDay() == 0 {
+		return time.Time{}
+	}
+	return FromProtoDate(t)
+}
@@ -19,6 +19,18 @@ func ToProtoGame(modelGame *model.Game) *game_api.DomainGame {
 		game.Genres = genres
 	}
 
+	if len(modelGame.GenrePaths) > 0 {
+		paths := make([]*game_api.GenrePath, 0, len(modelGame.GenrePaths))
+		for _, p := range modelGame.GenrePaths {
+			path := &game_api.GenrePath{Genres: make([]*game_api.Genre, 0, len(p))}
+			for _, g := range p {
+				path.Genres = append(path.Genres, &game_api.Genre{GenreId: g.GenreID, Name: g.GenreName})
+			}
+			paths = append(paths, path)
+		}
+		game.GenrePaths = paths
+	}
+
 	if len(modelGame.Tags) > 0 {
 		tags := make([]string, 0, len(modelGame.Genres))
 		for _, t := range modelGame.Tags {
@@ -26,6 +38,13 @@ func ToProtoGame(modelGame *model.Game) *game_api.DomainGame {
 		}
 		game.Tags = tags
 	}
+	if len(modelGame.ImageVariantURLs) > 0 {
+		variantURLs := make(map[int32]string, len(modelGame.ImageVariantURLs))
+		for width, url := range modelGame.ImageVariantURLs {
+			variantURLs[int32(width)] = url
+		}
+		game.CoverImageVariantUrls = variantURLs
+	}
 	game.ReleaseDate = ToProtoDate(modelGame.ReleaseDate)
 	return &game
 }
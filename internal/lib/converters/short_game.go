@@ -11,6 +11,7 @@ func ToShortGameResponse(game model.ShortGame) *game_api.GameListResponse_ShortG
 		Title:         game.Title,
 		Description:   game.Description,
 		CoverImageUrl: game.ImageURL,
+		ThumbnailUrl:  game.ThumbnailURL,
 		ReleaseDate:   ToProtoDate(game.ReleaseDate),
 	}
 }
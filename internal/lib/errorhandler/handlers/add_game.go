@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	"github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/model/dto"
 	"github.com/sariya23/game_service/internal/outerror"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -11,14 +12,16 @@ import (
 
 func AddGame(err error, gameID int64) (*game.AddGameResponse, error) {
 	switch {
+	case errors.Is(err, outerror.ErrCallerRequired):
+		return &game.AddGameResponse{}, status.Error(codes.Unauthenticated, outerror.CallerRequiredMessage)
 	case errors.Is(err, outerror.ErrGameAlreadyExist):
 		return &game.AddGameResponse{}, status.Error(codes.AlreadyExists, outerror.GameAlreadyExistMessage)
 	case errors.Is(err, outerror.ErrCannotSaveGameImage):
 		return &game.AddGameResponse{GameId: gameID}, nil
 	case errors.Is(err, outerror.ErrGenreNotFound):
-		return &game.AddGameResponse{}, status.Error(codes.InvalidArgument, outerror.GenreNotFoundMessage)
+		return &game.AddGameResponse{}, unknownNamesError(err, outerror.GenreNotFoundMessage, dto.UpdateGameFieldGenres)
 	case errors.Is(err, outerror.ErrTagNotFound):
-		return &game.AddGameResponse{}, status.Error(codes.InvalidArgument, outerror.TagNotFoundMessage)
+		return &game.AddGameResponse{}, unknownNamesError(err, outerror.TagNotFoundMessage, dto.UpdateGameFieldTags)
 	default:
 		return &game.AddGameResponse{}, status.Error(codes.Internal, outerror.InternalMessage)
 	}
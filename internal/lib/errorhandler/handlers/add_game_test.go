@@ -21,6 +21,13 @@ func TestAddGame_errorhandler(t *testing.T) {
 		expectedError    error
 		expectedResponse *game.AddGameResponse
 	}{
+		{
+			name:             "CallerRequired",
+			err:              fmt.Errorf("%s: %w", "qweo", outerror.ErrCallerRequired),
+			gameID:           0,
+			expectedError:    status.Error(codes.Unauthenticated, outerror.CallerRequiredMessage),
+			expectedResponse: &game.AddGameResponse{},
+		},
 		{
 			name:             "GameAlreadyExist",
 			err:              fmt.Errorf("%s: %w", "qweo", outerror.ErrGameAlreadyExist),
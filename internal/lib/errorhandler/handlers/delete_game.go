@@ -10,8 +10,14 @@ import (
 )
 
 func DeleteGame(err error) (*game.DeleteGameResponse, error) {
-	if errors.Is(err, outerror.ErrGameNotFound) {
+	switch {
+	case errors.Is(err, outerror.ErrCallerRequired):
+		return &game.DeleteGameResponse{}, status.Error(codes.Unauthenticated, outerror.CallerRequiredMessage)
+	case errors.Is(err, outerror.ErrModerationForbidden):
+		return &game.DeleteGameResponse{}, status.Error(codes.PermissionDenied, outerror.ModerationForbiddenMessage)
+	case errors.Is(err, outerror.ErrGameNotFound):
 		return &game.DeleteGameResponse{}, status.Error(codes.NotFound, outerror.GameNotFoundMessage)
+	default:
+		return &game.DeleteGameResponse{}, status.Error(codes.Internal, outerror.InternalMessage)
 	}
-	return &game.DeleteGameResponse{}, status.Error(codes.Internal, outerror.InternalMessage)
 }
@@ -20,6 +20,18 @@ func TestDeleteGame_errorhandler(t *testing.T) {
 		expectedResponse *game.DeleteGameResponse
 		expectedError    error
 	}{
+		{
+			name:             "CallerRequired",
+			err:              fmt.Errorf("%s: %w", "qwe", outerror.ErrCallerRequired),
+			expectedResponse: &game.DeleteGameResponse{},
+			expectedError:    status.Error(codes.Unauthenticated, outerror.CallerRequiredMessage),
+		},
+		{
+			name:             "ModerationForbidden",
+			err:              fmt.Errorf("%s: %w", "qwe", outerror.ErrModerationForbidden),
+			expectedResponse: &game.DeleteGameResponse{},
+			expectedError:    status.Error(codes.PermissionDenied, outerror.ModerationForbiddenMessage),
+		},
 		{
 			name:             "GameNotFound",
 			err:              fmt.Errorf("%s: %w", "qwe", outerror.ErrGameNotFound),
@@ -0,0 +1,23 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/sariya23/game_service/internal/outerror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DeletedGames переводит ошибку ListDeletedGames и RestoreGame в gRPC статус.
+func DeletedGames(err error) error {
+	switch {
+	case errors.Is(err, outerror.ErrGameNotFound):
+		return status.Error(codes.NotFound, outerror.GameNotFoundMessage)
+	case errors.Is(err, outerror.ErrGameAlreadyExist):
+		return status.Error(codes.AlreadyExists, outerror.GameAlreadyExistMessage)
+	case errors.Is(err, outerror.ErrModerationForbidden):
+		return status.Error(codes.PermissionDenied, outerror.ModerationForbiddenMessage)
+	default:
+		return status.Error(codes.Internal, outerror.InternalMessage)
+	}
+}
@@ -0,0 +1,33 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/sariya23/game_service/internal/outerror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func SetGameCover(err error) error {
+	switch {
+	case errors.Is(err, outerror.ErrCallerRequired):
+		return status.Error(codes.Unauthenticated, outerror.CallerRequiredMessage)
+	case errors.Is(err, outerror.ErrGameNotFound):
+		return status.Error(codes.NotFound, outerror.GameNotFoundMessage)
+	case errors.Is(err, outerror.ErrCannotSaveGameImage):
+		return status.Error(codes.Unavailable, outerror.CannotSaveGameImageMessage)
+	default:
+		return status.Error(codes.Internal, outerror.InternalMessage)
+	}
+}
+
+func RemoveGameCover(err error) error {
+	switch {
+	case errors.Is(err, outerror.ErrCallerRequired):
+		return status.Error(codes.Unauthenticated, outerror.CallerRequiredMessage)
+	case errors.Is(err, outerror.ErrGameNotFound):
+		return status.Error(codes.NotFound, outerror.GameNotFoundMessage)
+	default:
+		return status.Error(codes.Internal, outerror.InternalMessage)
+	}
+}
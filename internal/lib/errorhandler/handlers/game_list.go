@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/sariya23/game_service/internal/outerror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GameList переводит ошибку сервиса в gRPC статус.
+func GameList(err error) error {
+	switch {
+	case errors.Is(err, outerror.ErrInvalidPageToken):
+		return status.Error(codes.InvalidArgument, outerror.InvalidPageTokenMessage)
+	case errors.Is(err, outerror.ErrUnknownGameSort):
+		return status.Error(codes.InvalidArgument, outerror.UnknownGameSortMessage)
+	case errors.Is(err, outerror.ErrRelevanceSortWithoutQuery):
+		return status.Error(codes.InvalidArgument, outerror.RelevanceSortWithoutQueryMessage)
+	case errors.Is(err, outerror.ErrUnknownMatchMode):
+		return status.Error(codes.InvalidArgument, outerror.UnknownMatchModeMessage)
+	case errors.Is(err, outerror.ErrUnknownGameStatus):
+		return status.Error(codes.InvalidArgument, outerror.UnknownGameStatusMessage)
+	case errors.Is(err, outerror.ErrStatusFilterForbidden):
+		return status.Error(codes.PermissionDenied, outerror.StatusFilterForbiddenMessage)
+	default:
+		return status.Error(codes.Internal, outerror.InternalMessage)
+	}
+}
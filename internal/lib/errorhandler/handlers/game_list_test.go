@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/sariya23/game_service/internal/outerror"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGameList_errorhandler(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name        string
+		err         error
+		expectedErr error
+	}{
+		{
+			name:        "InvalidPageToken",
+			err:         fmt.Errorf("%s: %w", "qwe", outerror.ErrInvalidPageToken),
+			expectedErr: status.Error(codes.InvalidArgument, outerror.InvalidPageTokenMessage),
+		},
+		{
+			name:        "UnknownGameSort",
+			err:         fmt.Errorf("%s: %w", "qwe", outerror.ErrUnknownGameSort),
+			expectedErr: status.Error(codes.InvalidArgument, outerror.UnknownGameSortMessage),
+		},
+		{
+			name:        "RelevanceSortWithoutQuery",
+			err:         fmt.Errorf("%s: %w", "qwe", outerror.ErrRelevanceSortWithoutQuery),
+			expectedErr: status.Error(codes.InvalidArgument, outerror.RelevanceSortWithoutQueryMessage),
+		},
+		{
+			name:        "UnknownMatchMode",
+			err:         fmt.Errorf("%s: %w", "qwe", outerror.ErrUnknownMatchMode),
+			expectedErr: status.Error(codes.InvalidArgument, outerror.UnknownMatchModeMessage),
+		},
+		{
+			name:        "UnknownGameStatus",
+			err:         fmt.Errorf("%s: %w", "qwe", outerror.ErrUnknownGameStatus),
+			expectedErr: status.Error(codes.InvalidArgument, outerror.UnknownGameStatusMessage),
+		},
+		{
+			name:        "StatusFilterForbidden",
+			err:         fmt.Errorf("%s: %w", "qwe", outerror.ErrStatusFilterForbidden),
+			expectedErr: status.Error(codes.PermissionDenied, outerror.StatusFilterForbiddenMessage),
+		},
+		{
+			name:        "SomeErr",
+			err:         errors.New("some error"),
+			expectedErr: status.Error(codes.Internal, outerror.InternalMessage),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expectedErr, GameList(tc.err))
+		})
+	}
+}
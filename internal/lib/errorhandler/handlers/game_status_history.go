@@ -0,0 +1,21 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/sariya23/game_service/internal/outerror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetGameStatusHistory переводит ошибку сервиса в gRPC статус.
+func GetGameStatusHistory(err error) error {
+	switch {
+	case errors.Is(err, outerror.ErrGameNotFound):
+		return status.Error(codes.NotFound, outerror.GameNotFoundMessage)
+	case errors.Is(err, outerror.ErrModerationForbidden):
+		return status.Error(codes.PermissionDenied, outerror.ModerationForbiddenMessage)
+	default:
+		return status.Error(codes.Internal, outerror.InternalMessage)
+	}
+}
@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/sariya23/game_service/internal/outerror"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetGameStatusHistory_errorhandler(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name        string
+		err         error
+		expectedErr error
+	}{
+		{
+			name:        "GameNotFound",
+			err:         fmt.Errorf("%s: %w", "qwe", outerror.ErrGameNotFound),
+			expectedErr: status.Error(codes.NotFound, outerror.GameNotFoundMessage),
+		},
+		{
+			name:        "ModerationForbidden",
+			err:         fmt.Errorf("%s: %w", "qwe", outerror.ErrModerationForbidden),
+			expectedErr: status.Error(codes.PermissionDenied, outerror.ModerationForbiddenMessage),
+		},
+		{
+			name:        "SomeErr",
+			err:         errors.New("some error"),
+			expectedErr: status.Error(codes.Internal, outerror.InternalMessage),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expectedErr, GetGameStatusHistory(tc.err))
+		})
+	}
+}
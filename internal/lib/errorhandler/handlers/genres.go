@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/sariya23/game_service/internal/outerror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Genres переводит ошибку CreateGenre, RenameGenre, DeleteGenre, MergeGenres и SetGenreParent в gRPC статус.
+func Genres(err error) error {
+	switch {
+	case errors.Is(err, outerror.ErrGenreNotFound):
+		return status.Error(codes.NotFound, outerror.GenreNotFoundMessage)
+	case errors.Is(err, outerror.ErrGenreAlreadyExist):
+		return status.Error(codes.AlreadyExists, outerror.GenreAlreadyExistMessage)
+	case errors.Is(err, outerror.ErrGenreInUse):
+		return status.Error(codes.FailedPrecondition, outerror.GenreInUseMessage)
+	case errors.Is(err, outerror.ErrGenreCycle):
+		return status.Error(codes.FailedPrecondition, outerror.GenreCycleMessage)
+	case errors.Is(err, outerror.ErrMergeIntoItself):
+		return status.Error(codes.InvalidArgument, outerror.MergeIntoItselfMessage)
+	case errors.Is(err, outerror.ErrModerationForbidden):
+		return status.Error(codes.PermissionDenied, outerror.ModerationForbiddenMessage)
+	default:
+		return status.Error(codes.Internal, outerror.InternalMessage)
+	}
+}
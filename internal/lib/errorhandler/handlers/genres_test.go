@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/sariya23/game_service/internal/outerror"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGenres_errorhandler(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name        string
+		err         error
+		expectedErr error
+	}{
+		{
+			name:        "GenreNotFound",
+			err:         fmt.Errorf("%s: %w", "qwe", outerror.ErrGenreNotFound),
+			expectedErr: status.Error(codes.NotFound, outerror.GenreNotFoundMessage),
+		},
+		{
+			name:        "GenreAlreadyExist",
+			err:         fmt.Errorf("%s: %w", "qwe", outerror.ErrGenreAlreadyExist),
+			expectedErr: status.Error(codes.AlreadyExists, outerror.GenreAlreadyExistMessage),
+		},
+		{
+			name:        "GenreInUse",
+			err:         fmt.Errorf("%s: %w", "qwe", outerror.ErrGenreInUse),
+			expectedErr: status.Error(codes.FailedPrecondition, outerror.GenreInUseMessage),
+		},
+		{
+			name:        "GenreCycle",
+			err:         fmt.Errorf("%s: %w", "qwe", outerror.ErrGenreCycle),
+			expectedErr: status.Error(codes.FailedPrecondition, outerror.GenreCycleMessage),
+		},
+		{
+			name:        "MergeIntoItself",
+			err:         fmt.Errorf("%s: %w", "qwe", outerror.ErrMergeIntoItself),
+			expectedErr: status.Error(codes.InvalidArgument, outerror.MergeIntoItselfMessage),
+		},
+		{
+			name:        "ModerationForbidden",
+			err:         fmt.Errorf("%s: %w", "qwe", outerror.ErrModerationForbidden),
+			expectedErr: status.Error(codes.PermissionDenied, outerror.ModerationForbiddenMessage),
+		},
+		{
+			name:        "SomeErr",
+			err:         errors.New("some error"),
+			expectedErr: status.Error(codes.Internal, outerror.InternalMessage),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expectedErr, Genres(tc.err))
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/sariya23/game_service/internal/outerror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Moderation переводит ошибку SubmitForReview, ApproveGame, RejectGame
+// и ListPendingGames в gRPC статус.
+func Moderation(err error) error {
+	switch {
+	case errors.Is(err, outerror.ErrGameNotFound):
+		return status.Error(codes.NotFound, outerror.GameNotFoundMessage)
+	case errors.Is(err, outerror.ErrInvalidNewGameStatus):
+		return status.Error(codes.FailedPrecondition, outerror.InvalidNewGameStatusMessage)
+	case errors.Is(err, outerror.ErrCallerRequired):
+		return status.Error(codes.Unauthenticated, outerror.CallerRequiredMessage)
+	case errors.Is(err, outerror.ErrModerationForbidden):
+		return status.Error(codes.PermissionDenied, outerror.ModerationForbiddenMessage)
+	case errors.Is(err, outerror.ErrGameCoverRequired):
+		return status.Error(codes.FailedPrecondition, outerror.GameCoverRequiredMessage)
+	case errors.Is(err, outerror.ErrGameGenreRequired):
+		return status.Error(codes.FailedPrecondition, outerror.GameGenreRequiredMessage)
+	default:
+		return status.Error(codes.Internal, outerror.InternalMessage)
+	}
+}
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/sariya23/game_service/internal/outerror"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestModeration_errorhandler(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name        string
+		err         error
+		expectedErr error
+	}{
+		{
+			name:        "GameNotFound",
+			err:         fmt.Errorf("%s: %w", "qwe", outerror.ErrGameNotFound),
+			expectedErr: status.Error(codes.NotFound, outerror.GameNotFoundMessage),
+		},
+		{
+			name:        "InvalidNewGameStatus",
+			err:         fmt.Errorf("%s: %w", "qwe", outerror.ErrInvalidNewGameStatus),
+			expectedErr: status.Error(codes.FailedPrecondition, outerror.InvalidNewGameStatusMessage),
+		},
+		{
+			name:        "CallerRequired",
+			err:         fmt.Errorf("%s: %w", "qwe", outerror.ErrCallerRequired),
+			expectedErr: status.Error(codes.Unauthenticated, outerror.CallerRequiredMessage),
+		},
+		{
+			name:        "ModerationForbidden",
+			err:         fmt.Errorf("%s: %w", "qwe", outerror.ErrModerationForbidden),
+			expectedErr: status.Error(codes.PermissionDenied, outerror.ModerationForbiddenMessage),
+		},
+		{
+			name:        "GameCoverRequired",
+			err:         fmt.Errorf("%s: %w", "qwe", outerror.ErrGameCoverRequired),
+			expectedErr: status.Error(codes.FailedPrecondition, outerror.GameCoverRequiredMessage),
+		},
+		{
+			name:        "GameGenreRequired",
+			err:         fmt.Errorf("%s: %w", "qwe", outerror.ErrGameGenreRequired),
+			expectedErr: status.Error(codes.FailedPrecondition, outerror.GameGenreRequiredMessage),
+		},
+		{
+			name:        "SomeErr",
+			err:         errors.New("some error"),
+			expectedErr: status.Error(codes.Internal, outerror.InternalMessage),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expectedErr, Moderation(tc.err))
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/sariya23/game_service/internal/outerror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SchedulePublish переводит ошибку SchedulePublish в gRPC статус.
+func SchedulePublish(err error) error {
+	switch {
+	case errors.Is(err, outerror.ErrGameNotFound):
+		return status.Error(codes.NotFound, outerror.GameNotFoundMessage)
+	case errors.Is(err, outerror.ErrModerationForbidden):
+		return status.Error(codes.PermissionDenied, outerror.ModerationForbiddenMessage)
+	case errors.Is(err, outerror.ErrPublishAtInPast):
+		return status.Error(codes.InvalidArgument, outerror.PublishAtInPastMessage)
+	case errors.Is(err, outerror.ErrGameNotPending):
+		return status.Error(codes.FailedPrecondition, outerror.GameNotPendingMessage)
+	case errors.Is(err, outerror.ErrGameCoverRequired):
+		return status.Error(codes.FailedPrecondition, outerror.GameCoverRequiredMessage)
+	case errors.Is(err, outerror.ErrGameGenreRequired):
+		return status.Error(codes.FailedPrecondition, outerror.GameGenreRequiredMessage)
+	default:
+		return status.Error(codes.Internal, outerror.InternalMessage)
+	}
+}
@@ -0,0 +1,27 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/sariya23/game_service/internal/outerror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Tags переводит ошибку CreateTag, RenameTag, DeleteTag и MergeTags в gRPC статус.
+func Tags(err error) error {
+	switch {
+	case errors.Is(err, outerror.ErrTagNotFound):
+		return status.Error(codes.NotFound, outerror.TagNotFoundMessage)
+	case errors.Is(err, outerror.ErrTagAlreadyExist):
+		return status.Error(codes.AlreadyExists, outerror.TagAlreadyExistMessage)
+	case errors.Is(err, outerror.ErrTagInUse):
+		return status.Error(codes.FailedPrecondition, outerror.TagInUseMessage)
+	case errors.Is(err, outerror.ErrMergeIntoItself):
+		return status.Error(codes.InvalidArgument, outerror.MergeIntoItselfMessage)
+	case errors.Is(err, outerror.ErrModerationForbidden):
+		return status.Error(codes.PermissionDenied, outerror.ModerationForbiddenMessage)
+	default:
+		return status.Error(codes.Internal, outerror.InternalMessage)
+	}
+}
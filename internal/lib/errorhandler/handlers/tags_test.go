@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/sariya23/game_service/internal/outerror"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTags_errorhandler(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name        string
+		err         error
+		expectedErr error
+	}{
+		{
+			name:        "TagNotFound",
+			err:         fmt.Errorf("%s: %w", "qwe", outerror.ErrTagNotFound),
+			expectedErr: status.Error(codes.NotFound, outerror.TagNotFoundMessage),
+		},
+		{
+			name:        "TagAlreadyExist",
+			err:         fmt.Errorf("%s: %w", "qwe", outerror.ErrTagAlreadyExist),
+			expectedErr: status.Error(codes.AlreadyExists, outerror.TagAlreadyExistMessage),
+		},
+		{
+			name:        "TagInUse",
+			err:         fmt.Errorf("%s: %w", "qwe", outerror.ErrTagInUse),
+			expectedErr: status.Error(codes.FailedPrecondition, outerror.TagInUseMessage),
+		},
+		{
+			name:        "MergeIntoItself",
+			err:         fmt.Errorf("%s: %w", "qwe", outerror.ErrMergeIntoItself),
+			expectedErr: status.Error(codes.InvalidArgument, outerror.MergeIntoItselfMessage),
+		},
+		{
+			name:        "ModerationForbidden",
+			err:         fmt.Errorf("%s: %w", "qwe", outerror.ErrModerationForbidden),
+			expectedErr: status.Error(codes.PermissionDenied, outerror.ModerationForbiddenMessage),
+		},
+		{
+			name:        "SomeErr",
+			err:         errors.New("some error"),
+			expectedErr: status.Error(codes.Internal, outerror.InternalMessage),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expectedErr, Tags(tc.err))
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package handlers
+
+import (
+	"github.com/sariya23/game_service/internal/outerror"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// unknownNamesError - InvalidArgument, в деталях которого (errdetails.BadRequest)
+// перечислены неизвестные тэги или жанры из err, по одному на нарушение поля field.
+func unknownNamesError(err error, message string, field string) error {
+	names := outerror.UnknownNames(err)
+	st := status.New(codes.InvalidArgument, message)
+	if len(names) == 0 {
+		return st.Err()
+	}
+	badRequest := &errdetails.BadRequest{}
+	for _, name := range names {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       field,
+			Description: name,
+		})
+	}
+	withDetails, detailsErr := st.WithDetails(badRequest)
+	if detailsErr != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/sariya23/game_service/internal/outerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnknownNames_errorhandler(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name            string
+		err             error
+		expectedMessage string
+		expectedField   string
+	}{
+		{
+			name:            "AddGame unknown tags",
+			err:             func() error { _, err := AddGame(unknownNames(outerror.ErrTagNotFound), 0); return err }(),
+			expectedMessage: outerror.TagNotFoundMessage,
+			expectedField:   "tags",
+		},
+		{
+			name:            "AddGame unknown genres",
+			err:             func() error { _, err := AddGame(unknownNames(outerror.ErrGenreNotFound), 0); return err }(),
+			expectedMessage: outerror.GenreNotFoundMessage,
+			expectedField:   "genres",
+		},
+		{
+			name:            "UpdateGame unknown tags",
+			err:             UpdateGame(unknownNames(outerror.ErrTagNotFound)),
+			expectedMessage: outerror.TagNotFoundMessage,
+			expectedField:   "tags",
+		},
+		{
+			name:            "UpdateGame unknown genres",
+			err:             UpdateGame(unknownNames(outerror.ErrGenreNotFound)),
+			expectedMessage: outerror.GenreNotFoundMessage,
+			expectedField:   "genres",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			st, ok := status.FromError(tc.err)
+			require.True(t, ok)
+			assert.Equal(t, codes.InvalidArgument, st.Code())
+			assert.Equal(t, tc.expectedMessage, st.Message())
+			require.Len(t, st.Details(), 1)
+			badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+			require.True(t, ok)
+			require.Len(t, badRequest.FieldViolations, 2)
+			assert.Equal(t, tc.expectedField, badRequest.FieldViolations[0].Field)
+			assert.Equal(t, "Coop", badRequest.FieldViolations[0].Description)
+			assert.Equal(t, "Roguelite", badRequest.FieldViolations[1].Description)
+		})
+	}
+}
+
+func unknownNames(err error) error {
+	return fmt.Errorf("%s: %w", "qwe", &outerror.UnknownNamesError{Err: err, Names: []string{"Coop", "Roguelite"}})
+}
@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/sariya23/game_service/internal/model/dto"
+	"github.com/sariya23/game_service/internal/outerror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UpdateGame переводит ошибку сервиса в gRPC статус.
+// Ответ у UpdateGame пустой, поэтому возвращается только ошибка.
+func UpdateGame(err error) error {
+	switch {
+	case errors.Is(err, outerror.ErrCallerRequired):
+		return status.Error(codes.Unauthenticated, outerror.CallerRequiredMessage)
+	case errors.Is(err, outerror.ErrGameNotFound):
+		return status.Error(codes.NotFound, outerror.GameNotFoundMessage)
+	case errors.Is(err, outerror.ErrGameAlreadyExist):
+		return status.Error(codes.AlreadyExists, outerror.GameAlreadyExistMessage)
+	case errors.Is(err, outerror.ErrGenreNotFound):
+		return unknownNamesError(err, outerror.GenreNotFoundMessage, dto.UpdateGameFieldGenres)
+	case errors.Is(err, outerror.ErrTagNotFound):
+		return unknownNamesError(err, outerror.TagNotFoundMessage, dto.UpdateGameFieldTags)
+	default:
+		return status.Error(codes.Internal, outerror.InternalMessage)
+	}
+}
@@ -11,12 +11,20 @@ import (
 
 func UpdateGameStatus(err error) (*game.UpdateGameStatusResponse, error) {
 	switch {
+	case errors.Is(err, outerror.ErrCallerRequired):
+		return &game.UpdateGameStatusResponse{}, status.Error(codes.Unauthenticated, outerror.CallerRequiredMessage)
 	case errors.Is(err, outerror.ErrUnknownGameStatus):
 		return &game.UpdateGameStatusResponse{}, status.Error(codes.InvalidArgument, outerror.UnknownGameStatusMessage)
 	case errors.Is(err, outerror.ErrInvalidNewGameStatus):
 		return &game.UpdateGameStatusResponse{}, status.Error(codes.InvalidArgument, outerror.InvalidNewGameStatusMessage)
+	case errors.Is(err, outerror.ErrModerationRequired):
+		return &game.UpdateGameStatusResponse{}, status.Error(codes.FailedPrecondition, outerror.ModerationRequiredMessage)
 	case errors.Is(err, outerror.ErrGameNotFound):
 		return &game.UpdateGameStatusResponse{}, status.Error(codes.NotFound, outerror.GameNotFoundMessage)
+	case errors.Is(err, outerror.ErrGameCoverRequired):
+		return &game.UpdateGameStatusResponse{}, status.Error(codes.FailedPrecondition, outerror.GameCoverRequiredMessage)
+	case errors.Is(err, outerror.ErrGameGenreRequired):
+		return &game.UpdateGameStatusResponse{}, status.Error(codes.FailedPrecondition, outerror.GameGenreRequiredMessage)
 	default:
 		return &game.UpdateGameStatusResponse{}, status.Error(codes.Internal, outerror.InternalMessage)
 	}
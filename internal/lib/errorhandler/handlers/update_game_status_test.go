@@ -19,6 +19,12 @@ func TestUpdateGameStatus_errorhandler(t *testing.T) {
 		expectedResp *game.UpdateGameStatusResponse
 		expectedErr  error
 	}{
+		{
+			name:         "CallerRequired",
+			err:          fmt.Errorf("%s: %w", "qwe", outerror.ErrCallerRequired),
+			expectedResp: &game.UpdateGameStatusResponse{},
+			expectedErr:  status.Error(codes.Unauthenticated, outerror.CallerRequiredMessage),
+		},
 		{
 			name:         "UnknownGameStatus",
 			err:          fmt.Errorf("%s: %w", "qwe", outerror.ErrUnknownGameStatus),
@@ -31,12 +37,30 @@ func TestUpdateGameStatus_errorhandler(t *testing.T) {
 			expectedResp: &game.UpdateGameStatusResponse{},
 			expectedErr:  status.Error(codes.InvalidArgument, outerror.InvalidNewGameStatusMessage),
 		},
+		{
+			name:         "ModerationRequired",
+			err:          fmt.Errorf("%s: %w", "qwe", outerror.ErrModerationRequired),
+			expectedResp: &game.UpdateGameStatusResponse{},
+			expectedErr:  status.Error(codes.FailedPrecondition, outerror.ModerationRequiredMessage),
+		},
 		{
 			name:         "GameNotFound",
 			err:          fmt.Errorf("%s: %w", "qwe", outerror.ErrGameNotFound),
 			expectedResp: &game.UpdateGameStatusResponse{},
 			expectedErr:  status.Error(codes.NotFound, outerror.GameNotFoundMessage),
 		},
+		{
+			name:         "GameCoverRequired",
+			err:          fmt.Errorf("%s: %w", "qwe", outerror.ErrGameCoverRequired),
+			expectedResp: &game.UpdateGameStatusResponse{},
+			expectedErr:  status.Error(codes.FailedPrecondition, outerror.GameCoverRequiredMessage),
+		},
+		{
+			name:         "GameGenreRequired",
+			err:          fmt.Errorf("%s: %w", "qwe", outerror.ErrGameGenreRequired),
+			expectedResp: &game.UpdateGameStatusResponse{},
+			expectedErr:  status.Error(codes.FailedPrecondition, outerror.GameGenreRequiredMessage),
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
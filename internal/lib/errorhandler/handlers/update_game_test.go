@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/sariya23/game_service/internal/outerror"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUpdateGame_errorhandler(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name        string
+		err         error
+		expectedErr error
+	}{
+		{
+			name:        "CallerRequired",
+			err:         fmt.Errorf("%s: %w", "qwe", outerror.ErrCallerRequired),
+			expectedErr: status.Error(codes.Unauthenticated, outerror.CallerRequiredMessage),
+		},
+		{
+			name:        "GameNotFound",
+			err:         fmt.Errorf("%s: %w", "qwe", outerror.ErrGameNotFound),
+			expectedErr: status.Error(codes.NotFound, outerror.GameNotFoundMessage),
+		},
+		{
+			name:        "GameAlreadyExist",
+			err:         fmt.Errorf("%s: %w", "qwe", outerror.ErrGameAlreadyExist),
+			expectedErr: status.Error(codes.AlreadyExists, outerror.GameAlreadyExistMessage),
+		},
+		{
+			name:        "GenreNotFound",
+			err:         fmt.Errorf("%s: %w", "qwe", outerror.ErrGenreNotFound),
+			expectedErr: status.Error(codes.InvalidArgument, outerror.GenreNotFoundMessage),
+		},
+		{
+			name:        "TagNotFound",
+			err:         fmt.Errorf("%s: %w", "qwe", outerror.ErrTagNotFound),
+			expectedErr: status.Error(codes.InvalidArgument, outerror.TagNotFoundMessage),
+		},
+		{
+			name:        "SomeErr",
+			err:         errors.New("some error"),
+			expectedErr: status.Error(codes.Internal, outerror.InternalMessage),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expectedErr, UpdateGame(tc.err))
+		})
+	}
+}
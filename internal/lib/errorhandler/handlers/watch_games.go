@@ -0,0 +1,24 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sariya23/game_service/internal/outerror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// WatchGames переводит ошибку WatchGames в gRPC статус.
+func WatchGames(err error) error {
+	switch {
+	case errors.Is(err, outerror.ErrWatchLagged):
+		return status.Error(codes.Aborted, outerror.WatchLaggedMessage)
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, context.Canceled.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, context.DeadlineExceeded.Error())
+	default:
+		return status.Error(codes.Internal, outerror.InternalMessage)
+	}
+}
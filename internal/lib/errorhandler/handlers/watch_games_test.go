@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/sariya23/game_service/internal/outerror"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestWatchGames_errorhandler(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name        string
+		err         error
+		expectedErr error
+	}{
+		{
+			name:        "WatchLagged",
+			err:         fmt.Errorf("%s: %w", "qwe", outerror.ErrWatchLagged),
+			expectedErr: status.Error(codes.Aborted, outerror.WatchLaggedMessage),
+		},
+		{
+			name:        "Canceled",
+			err:         fmt.Errorf("%s: %w", "qwe", context.Canceled),
+			expectedErr: status.Error(codes.Canceled, context.Canceled.Error()),
+		},
+		{
+			name:        "DeadlineExceeded",
+			err:         fmt.Errorf("%s: %w", "qwe", context.DeadlineExceeded),
+			expectedErr: status.Error(codes.DeadlineExceeded, context.DeadlineExceeded.Error()),
+		},
+		{
+			name:        "SomeErr",
+			err:         errors.New("some error"),
+			expectedErr: status.Error(codes.Internal, outerror.InternalMessage),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expectedErr, WatchGames(tc.err))
+		})
+	}
+}
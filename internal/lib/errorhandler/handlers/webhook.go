@@ -0,0 +1,25 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/sariya23/game_service/internal/outerror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Webhook переводит ошибку CreateWebhook, ListWebhooks и DeleteWebhook в gRPC статус.
+func Webhook(err error) error {
+	switch {
+	case errors.Is(err, outerror.ErrWebhookNotFound):
+		return status.Error(codes.NotFound, outerror.WebhookNotFoundMessage)
+	case errors.Is(err, outerror.ErrInvalidWebhook):
+		return status.Error(codes.InvalidArgument, outerror.InvalidWebhookMessage)
+	case errors.Is(err, outerror.ErrWebhookAddressForbidden):
+		return status.Error(codes.InvalidArgument, outerror.WebhookAddressForbiddenMessage)
+	case errors.Is(err, outerror.ErrModerationForbidden):
+		return status.Error(codes.PermissionDenied, outerror.ModerationForbiddenMessage)
+	default:
+		return status.Error(codes.Internal, outerror.InternalMessage)
+	}
+}
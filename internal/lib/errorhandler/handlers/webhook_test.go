@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/sariya23/game_service/internal/outerror"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestWebhook_errorhandler(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name        string
+		err         error
+		expectedErr error
+	}{
+		{
+			name:        "WebhookNotFound",
+			err:         fmt.Errorf("%s: %w", "qwe", outerror.ErrWebhookNotFound),
+			expectedErr: status.Error(codes.NotFound, outerror.WebhookNotFoundMessage),
+		},
+		{
+			name:        "InvalidWebhook",
+			err:         fmt.Errorf("%s: %w", "qwe", outerror.ErrInvalidWebhook),
+			expectedErr: status.Error(codes.InvalidArgument, outerror.InvalidWebhookMessage),
+		},
+		{
+			name:        "WebhookAddressForbidden",
+			err:         fmt.Errorf("%s: %w", "qwe", outerror.ErrWebhookAddressForbidden),
+			expectedErr: status.Error(codes.InvalidArgument, outerror.WebhookAddressForbiddenMessage),
+		},
+		{
+			name:        "ModerationForbidden",
+			err:         fmt.Errorf("%s: %w", "qwe", outerror.ErrModerationForbidden),
+			expectedErr: status.Error(codes.PermissionDenied, outerror.ModerationForbiddenMessage),
+		},
+		{
+			name:        "SomeErr",
+			err:         errors.New("some error"),
+			expectedErr: status.Error(codes.Internal, outerror.InternalMessage),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expectedErr, Webhook(tc.err))
+		})
+	}
+}
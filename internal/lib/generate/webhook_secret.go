@@ -0,0 +1,13 @@
+package generate
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// GenerateWebhookSecret возвращает случайный секрет для подписи webhook.
+func GenerateWebhookSecret() string {
+	b := make([]byte, 32)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
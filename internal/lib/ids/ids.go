@@ -0,0 +1,15 @@
+package ids
+
+// Unique возвращает ids без повторов, сохраняя порядок первых вхождений.
+func Unique(ids []int64) []int64 {
+	seen := make(map[int64]struct{}, len(ids))
+	res := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		res = append(res, id)
+	}
+	return res
+}
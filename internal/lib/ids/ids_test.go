@@ -0,0 +1,26 @@
+package ids
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnique(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name     string
+		ids      []int64
+		expected []int64
+	}{
+		{name: "empty", ids: nil, expected: []int64{}},
+		{name: "no duplicates", ids: []int64{3, 1, 2}, expected: []int64{3, 1, 2}},
+		{name: "duplicates keep first order", ids: []int64{2, 1, 2, 3, 1}, expected: []int64{2, 1, 3}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, Unique(tc.ids))
+		})
+	}
+}
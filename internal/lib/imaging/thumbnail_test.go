@@ -0,0 +1,107 @@
+package imaging
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func filledImage(width, height int, c color.RGBA) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := range height {
+		for x := range width {
+			img.SetRGBA(x, y, c)
+		}
+	}
+	return img
+}
+
+func TestThumbnail(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name           string
+		srcW, srcH     int
+		width          int
+		expectedWidth  int
+		expectedHeight int
+	}{
+		{name: "keeps aspect ratio", srcW: 800, srcH: 600, width: 160, expectedWidth: 160, expectedHeight: 120},
+		{name: "rounds height", srcW: 1000, srcH: 333, width: 480, expectedWidth: 480, expectedHeight: 160},
+		{name: "very wide image keeps 1px height", srcW: 2000, srcH: 2, width: 160, expectedWidth: 160, expectedHeight: 1},
+		{name: "does not upscale", srcW: 100, srcH: 50, width: 160, expectedWidth: 100, expectedHeight: 50},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got := Thumbnail(filledImage(tc.srcW, tc.srcH, color.RGBA{R: 10, A: 255}), tc.width)
+			assert.Equal(t, tc.expectedWidth, got.Bounds().Dx())
+			assert.Equal(t, tc.expectedHeight, got.Bounds().Dy())
+		})
+	}
+}
+
+func TestThumbnail_averagesPixels(t *testing.T) {
+	t.Parallel()
+	src := image.NewRGBA(image.Rect(0, 0, 4, 2))
+	for y := range 2 {
+		src.SetRGBA(0, y, color.RGBA{R: 0, A: 255})
+		src.SetRGBA(1, y, color.RGBA{R: 200, A: 255})
+		src.SetRGBA(2, y, color.RGBA{B: 100, A: 255})
+		src.SetRGBA(3, y, color.RGBA{B: 100, A: 255})
+	}
+	got := Thumbnail(src, 2)
+	assert.Equal(t, color.RGBA{R: 100, A: 255}, got.RGBAAt(0, 0))
+	assert.Equal(t, color.RGBA{B: 100, A: 255}, got.RGBAAt(1, 0))
+}
+
+func TestThumbnailBytes(t *testing.T) {
+	t.Parallel()
+	src := filledImage(320, 200, color.RGBA{G: 200, A: 255})
+	encode := func(format string) []byte {
+		var buf bytes.Buffer
+		var err error
+		switch format {
+		case "png":
+			err = png.Encode(&buf, src)
+		case "jpeg":
+			err = jpeg.Encode(&buf, src, nil)
+		case "gif":
+			err = gif.Encode(&buf, src, nil)
+		}
+		require.NoError(t, err)
+		return buf.Bytes()
+	}
+	cases := []struct {
+		name                string
+		data                []byte
+		expectedContentType string
+	}{
+		{name: "png stays png", data: encode("png"), expectedContentType: "image/png"},
+		{name: "jpeg stays jpeg", data: encode("jpeg"), expectedContentType: "image/jpeg"},
+		{name: "gif becomes png", data: encode("gif"), expectedContentType: "image/png"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, contentType, err := ThumbnailBytes(tc.data, 160)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedContentType, contentType)
+			cfg, _, err := image.DecodeConfig(bytes.NewReader(got))
+			require.NoError(t, err)
+			assert.Equal(t, 160, cfg.Width)
+			assert.Equal(t, 100, cfg.Height)
+		})
+	}
+	t.Run("not an image", func(t *testing.T) {
+		t.Parallel()
+		_, _, err := ThumbnailBytes([]byte("qwe"), 160)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,86 @@
+package pagetoken
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/sariya23/game_service/internal/model/dto"
+	"github.com/sariya23/game_service/internal/outerror"
+)
+
+// Encode упаковывает курсор в непрозрачный для клиента токен.
+func Encode(cursor dto.GameListCursor) string {
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		// структура курсора всегда сериализуется
+		panic(fmt.Sprintf("pagetoken.Encode: %v", err))
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// Decode разбирает токен страницы. Для пустого токена возвращает nil -
+// это запрос первой страницы.
+func Decode(token string) (*dto.GameListCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", outerror.ErrInvalidPageToken, err)
+	}
+	var cursor dto.GameListCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, fmt.Errorf("%w: %v", outerror.ErrInvalidPageToken, err)
+	}
+	if !valid(cursor) {
+		return nil, outerror.ErrInvalidPageToken
+	}
+	return &cursor, nil
+}
+
+// FiltersHash - отпечаток фильтров выдачи для курсора. Порядок значений
+// в списках не влияет на выдачу, поэтому не влияет и на отпечаток.
+func FiltersHash(filters dto.GameFilters) string {
+	filters.Genres = sortedCopy(filters.Genres)
+	filters.Tags = sortedCopy(filters.Tags)
+	filters.ExcludeGenres = sortedCopy(filters.ExcludeGenres)
+	filters.ExcludeTags = sortedCopy(filters.ExcludeTags)
+	filters.Statuses = sortedCopy(filters.Statuses)
+	data, err := json.Marshal(filters)
+	if err != nil {
+		// фильтры всегда сериализуются
+		panic(fmt.Sprintf("pagetoken.FiltersHash: %v", err))
+	}
+	sum := sha256.Sum256(data)
+	return base64.RawURLEncoding.EncodeToString(sum[:12])
+}
+
+func sortedCopy[T cmp.Ordered](values []T) []T {
+	if len(values) == 0 {
+		return nil
+	}
+	res := slices.Clone(values)
+	slices.Sort(res)
+	return res
+}
+
+// valid проверяет, что в курсоре есть значения для его режима сортировки.
+func valid(cursor dto.GameListCursor) bool {
+	if cursor.GameID <= 0 {
+		return false
+	}
+	switch cursor.Sort {
+	case dto.GameSortTitle, dto.GameSortNewest, dto.GameSortOldest:
+		return !cursor.ReleaseDate.IsZero()
+	case dto.GameSortRecentlyAdded:
+		return true
+	case dto.GameSortRelevance:
+		return cursor.Rank != nil
+	default:
+		return false
+	}
+}
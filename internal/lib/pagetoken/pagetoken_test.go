@@ -0,0 +1,107 @@
+package pagetoken
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/model/dto"
+	"github.com/sariya23/game_service/internal/outerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	t.Parallel()
+	rank := float32(0.0607927)
+	cases := []struct {
+		name   string
+		cursor dto.GameListCursor
+	}{
+		{
+			name: "title",
+			cursor: dto.GameListCursor{
+				Sort:        dto.GameSortTitle,
+				Title:       "Ведьмак 3",
+				ReleaseDate: time.Date(2015, 5, 19, 0, 0, 0, 0, time.UTC),
+				GameID:      42,
+				Filters:     "qwe",
+			},
+		},
+		{
+			name: "relevance",
+			cursor: dto.GameListCursor{
+				Sort:        dto.GameSortRelevance,
+				Rank:        &rank,
+				Title:       "The Witcher 3",
+				ReleaseDate: time.Date(2015, 5, 19, 0, 0, 0, 0, time.UTC),
+				GameID:      7,
+			},
+		},
+		{
+			name:   "recently added",
+			cursor: dto.GameListCursor{Sort: dto.GameSortRecentlyAdded, GameID: 3},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			cursor, err := Decode(Encode(tc.cursor))
+			require.NoError(t, err)
+			assert.Equal(t, tc.cursor, *cursor)
+		})
+	}
+}
+
+func TestDecode_EmptyToken(t *testing.T) {
+	t.Parallel()
+	cursor, err := Decode("")
+	require.NoError(t, err)
+	assert.Nil(t, cursor)
+}
+
+func TestDecode_InvalidToken(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name  string
+		token string
+	}{
+		{name: "not base64", token: "%%%"},
+		{name: "not json", token: base64.RawURLEncoding.EncodeToString([]byte("qwe"))},
+		{name: "no game id", token: base64.RawURLEncoding.EncodeToString([]byte(`{"s":1,"t":"qwe","d":"2015-05-19T00:00:00Z"}`))},
+		{name: "no release date", token: base64.RawURLEncoding.EncodeToString([]byte(`{"s":2,"t":"qwe","id":1}`))},
+		{name: "relevance without rank", token: base64.RawURLEncoding.EncodeToString([]byte(`{"s":5,"id":1}`))},
+		{name: "unspecified sort", token: base64.RawURLEncoding.EncodeToString([]byte(`{"s":0,"id":1}`))},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			cursor, err := Decode(tc.token)
+			assert.ErrorIs(t, err, outerror.ErrInvalidPageToken)
+			assert.Nil(t, cursor)
+		})
+	}
+}
+
+func TestFiltersHash(t *testing.T) {
+	t.Parallel()
+	filters := dto.GameFilters{
+		Genres:   []string{"rpg", "action"},
+		Tags:     []string{"open world"},
+		Statuses: []game.GameStatusType{game.GameStatusType_PUBLISH, game.GameStatusType_DRAFT},
+		Sort:     dto.GameSortTitle,
+	}
+	reordered := filters
+	reordered.Genres = []string{"action", "rpg"}
+	reordered.Statuses = []game.GameStatusType{game.GameStatusType_DRAFT, game.GameStatusType_PUBLISH}
+	otherYear := filters
+	otherYear.ReleaseYear = 2015
+	otherTags := filters
+	otherTags.Tags = []string{"co-op"}
+
+	assert.Equal(t, FiltersHash(filters), FiltersHash(reordered))
+	assert.Equal(t, []string{"rpg", "action"}, filters.Genres)
+	assert.NotEqual(t, FiltersHash(filters), FiltersHash(otherYear))
+	assert.NotEqual(t, FiltersHash(filters), FiltersHash(otherTags))
+}
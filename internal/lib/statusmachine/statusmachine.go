@@ -0,0 +1,181 @@
+// Package statusmachine описывает допустимые переходы статусов игры.
+// Все, что не перечислено явно, запрещено.
+package statusmachine
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/model"
+	"github.com/sariya23/game_service/internal/outerror"
+)
+
+// Guard - дополнительное условие перехода. Name попадает в подпись
+// ребра на графе.
+type Guard struct {
+	Name  string
+	Check func(g model.GameNoImageURL) error
+}
+
+// Transition - разрешенный переход From -> To.
+type Transition struct {
+	From, To game.GameStatusType
+	Guards   []Guard
+}
+
+type Machine struct {
+	transitions []Transition
+}
+
+func New(transitions ...Transition) *Machine {
+	return &Machine{transitions: transitions}
+}
+
+var (
+	HasCover = Guard{
+		Name: "has cover",
+		Check: func(g model.GameNoImageURL) error {
+			if g.ImageKey == "" {
+				return outerror.ErrGameCoverRequired
+			}
+			return nil
+		},
+	}
+	HasGenre = Guard{
+		Name: "has genre",
+		Check: func(g model.GameNoImageURL) error {
+			if len(g.Genres) == 0 {
+				return outerror.ErrGameGenreRequired
+			}
+			return nil
+		},
+	}
+)
+
+// Default - переходы статусов каталога:
+// DRAFT -> PENDING - отправка на модерацию;
+// PENDING -> PUBLISH - одобрение, только с обложкой и жанром;
+// PENDING -> DRAFT - отказ.
+func Default() *Machine {
+	return New(
+		Transition{From: game.GameStatusType_DRAFT, To: game.GameStatusType_PENDING},
+		Transition{From: game.GameStatusType_PENDING, To: game.GameStatusType_PUBLISH, Guards: []Guard{HasCover, HasGenre}},
+		Transition{From: game.GameStatusType_PENDING, To: game.GameStatusType_DRAFT},
+	)
+}
+
+func (m *Machine) find(from, to game.GameStatusType) (Transition, bool) {
+	for _, t := range m.transitions {
+		if t.From == from && t.To == to {
+			return t, true
+		}
+	}
+	return Transition{}, false
+}
+
+// Can сообщает, есть ли переход from -> to, без проверки условий.
+func (m *Machine) Can(from, to game.GameStatusType) bool {
+	_, ok := m.find(from, to)
+	return ok
+}
+
+// Check проверяет переход для игры g: сам переход и все его условия.
+// Неизвестный переход - ErrInvalidNewGameStatus, иначе ошибка первого
+// не выполненного условия.
+func (m *Machine) Check(from, to game.GameStatusType, g model.GameNoImageURL) error {
+	t, ok := m.find(from, to)
+	if !ok {
+		return outerror.ErrInvalidNewGameStatus
+	}
+	for _, guard := range t.Guards {
+		if err := guard.Check(g); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Statuses - все статусы, участвующие в переходах, в порядке значений enum.
+func (m *Machine) Statuses() []game.GameStatusType {
+	var statuses []game.GameStatusType
+	for _, t := range m.transitions {
+		for _, s := range []game.GameStatusType{t.From, t.To} {
+			if !slices.Contains(statuses, s) {
+				statuses = append(statuses, s)
+			}
+		}
+	}
+	slices.Sort(statuses)
+	return statuses
+}
+
+// Validate сверяет машину и enum GameStatusType со строками таблицы
+// game_status: у каждого значения enum должна быть строка с тем же id
+// и именем, лишних строк быть не должно, а переходы - только между
+// известными статусами.
+func (m *Machine) Validate(dbStatuses []model.GameStatus) error {
+	var errs []error
+	byID := make(map[int32]string, len(dbStatuses))
+	for _, s := range dbStatuses {
+		byID[int32(s.ID)] = s.Name
+		if _, ok := game.GameStatusType_name[int32(s.ID)]; !ok {
+			errs = append(errs, fmt.Errorf("status %d %q from db is not in GameStatusType", s.ID, s.Name))
+		}
+	}
+	for id, name := range game.GameStatusType_name {
+		dbName, ok := byID[id]
+		if !ok {
+			errs = append(errs, fmt.Errorf("status %s is not in db", name))
+			continue
+		}
+		if dbName != strings.ToLower(name) {
+			errs = append(errs, fmt.Errorf("status %d is %q in db, want %q", id, dbName, strings.ToLower(name)))
+		}
+	}
+	for _, s := range m.Statuses() {
+		if _, ok := game.GameStatusType_name[int32(s)]; !ok {
+			errs = append(errs, fmt.Errorf("transition uses unknown status %d", s))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// DOT - граф переходов в формате Graphviz.
+func (m *Machine) DOT() string {
+	var b strings.Builder
+	b.WriteString("digraph game_status {\n")
+	for _, t := range m.transitions {
+		fmt.Fprintf(&b, "\t%s -> %s", t.From, t.To)
+		if label := guardsLabel(t); label != "" {
+			fmt.Fprintf(&b, " [label=%q]", label)
+		}
+		b.WriteString(";\n")
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// Mermaid - граф переходов в виде stateDiagram Mermaid.
+func (m *Machine) Mermaid() string {
+	var b strings.Builder
+	b.WriteString("stateDiagram-v2\n")
+	for _, t := range m.transitions {
+		fmt.Fprintf(&b, "    %s --> %s", t.From, t.To)
+		if label := guardsLabel(t); label != "" {
+			fmt.Fprintf(&b, ": %s", label)
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+func guardsLabel(t Transition) string {
+	names := make([]string, 0, len(t.Guards))
+	for _, g := range t.Guards {
+		names = append(names, g.Name)
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,109 @@
+package statusmachine
+
+import (
+	"testing"
+
+	"github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/model"
+	"github.com/sariya23/game_service/internal/outerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefault_Can(t *testing.T) {
+	t.Parallel()
+	m := Default()
+	statuses := []game.GameStatusType{
+		game.GameStatusType_DRAFT,
+		game.GameStatusType_PENDING,
+		game.GameStatusType_PUBLISH,
+		game.GameStatusType(42),
+	}
+	allowed := map[[2]game.GameStatusType]bool{
+		{game.GameStatusType_DRAFT, game.GameStatusType_PENDING}:   true,
+		{game.GameStatusType_PENDING, game.GameStatusType_PUBLISH}: true,
+		{game.GameStatusType_PENDING, game.GameStatusType_DRAFT}:   true,
+	}
+	for _, from := range statuses {
+		for _, to := range statuses {
+			assert.Equal(t, allowed[[2]game.GameStatusType{from, to}], m.Can(from, to), "%s -> %s", from, to)
+		}
+	}
+}
+
+func TestDefault_Check(t *testing.T) {
+	t.Parallel()
+	publishable := model.GameNoImageURL{ImageKey: "games/1/cover/qwe.png", Genres: []model.Genre{{GenreID: 1, GenreName: "RPG"}}}
+	cases := []struct {
+		name     string
+		from, to game.GameStatusType
+		game     model.GameNoImageURL
+		expected error
+	}{
+		{name: "publish", from: game.GameStatusType_PENDING, to: game.GameStatusType_PUBLISH, game: publishable},
+		{name: "publish without cover", from: game.GameStatusType_PENDING, to: game.GameStatusType_PUBLISH, game: model.GameNoImageURL{Genres: publishable.Genres}, expected: outerror.ErrGameCoverRequired},
+		{name: "publish without genre", from: game.GameStatusType_PENDING, to: game.GameStatusType_PUBLISH, game: model.GameNoImageURL{ImageKey: publishable.ImageKey}, expected: outerror.ErrGameGenreRequired},
+		{name: "submit without cover", from: game.GameStatusType_DRAFT, to: game.GameStatusType_PENDING, game: model.GameNoImageURL{}},
+		{name: "draft to publish", from: game.GameStatusType_DRAFT, to: game.GameStatusType_PUBLISH, game: publishable, expected: outerror.ErrInvalidNewGameStatus},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := Default().Check(tc.from, tc.to, tc.game)
+			if tc.expected == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tc.expected)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+	seeded := []model.GameStatus{{ID: 0, Name: "draft"}, {ID: 1, Name: "pending"}, {ID: 2, Name: "publish"}}
+	cases := []struct {
+		name      string
+		machine   *Machine
+		db        []model.GameStatus
+		expectErr bool
+	}{
+		{name: "seeded statuses", machine: Default(), db: seeded},
+		{name: "missing row", machine: Default(), db: seeded[:2], expectErr: true},
+		{name: "extra row", machine: Default(), db: append(append([]model.GameStatus{}, seeded...), model.GameStatus{ID: 3, Name: "archived"}), expectErr: true},
+		{name: "renamed row", machine: Default(), db: []model.GameStatus{{ID: 0, Name: "draft"}, {ID: 1, Name: "review"}, {ID: 2, Name: "publish"}}, expectErr: true},
+		{
+			name:      "transition to unknown status",
+			machine:   New(Transition{From: game.GameStatusType_PUBLISH, To: game.GameStatusType(3)}),
+			db:        seeded,
+			expectErr: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.machine.Validate(tc.db)
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestGraph(t *testing.T) {
+	t.Parallel()
+	m := Default()
+	require.Equal(t, `digraph game_status {
+	DRAFT -> PENDING;
+	PENDING -> PUBLISH [label="has cover, has genre"];
+	PENDING -> DRAFT;
+}
+`, m.DOT())
+	require.Equal(t, `stateDiagram-v2
+    DRAFT --> PENDING
+    PENDING --> PUBLISH: has cover, has genre
+    PENDING --> DRAFT
+`, m.Mermaid())
+}
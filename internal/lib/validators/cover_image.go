@@ -0,0 +1,59 @@
+package validators
+
+import (
+	"bytes"
+	"image"
+	_ "image/gif"  // регистрирует декодер GIF для image.DecodeConfig
+	_ "image/jpeg" // регистрирует декодер JPEG для image.DecodeConfig
+	_ "image/png"  // регистрирует декодер PNG для image.DecodeConfig
+	"net/http"
+
+	"github.com/sariya23/game_service/internal/config"
+	"github.com/sariya23/game_service/internal/outerror"
+)
+
+// CoverImageLimits - ограничения на обложку игры.
+type CoverImageLimits struct {
+	MaxBytes  int64
+	MaxWidth  int
+	MaxHeight int
+}
+
+func CoverImageLimitsFromConfig(minioConfig *config.Minio) CoverImageLimits {
+	return CoverImageLimits{
+		MaxBytes:  minioConfig.MaxCoverImageBytes,
+		MaxWidth:  minioConfig.MaxCoverImageWidth,
+		MaxHeight: minioConfig.MaxCoverImageHeight,
+	}
+}
+
+var supportedCoverImageTypes = map[string]string{
+	"image/png":  "png",
+	"image/jpeg": "jpeg",
+	"image/gif":  "gif",
+}
+
+// CoverImage проверяет обложку по содержимому, а не по тому, что прислал клиент.
+// Возвращает определенный Content-Type, который потом передается в s3.
+// Нулевой лимит означает, что ограничения нет.
+func CoverImage(coverImage []byte, limits CoverImageLimits) (contentType string, valid bool, message string) {
+	if limits.MaxBytes > 0 && int64(len(coverImage)) > limits.MaxBytes {
+		return "", false, outerror.CoverImageTooLargeMessage
+	}
+	contentType = http.DetectContentType(coverImage)
+	format, ok := supportedCoverImageTypes[contentType]
+	if !ok {
+		return "", false, outerror.UnsupportedCoverImageMessage
+	}
+	imageConfig, decodedFormat, err := image.DecodeConfig(bytes.NewReader(coverImage))
+	if err != nil || decodedFormat != format {
+		return "", false, outerror.InvalidCoverImageMessage
+	}
+	if limits.MaxWidth > 0 && imageConfig.Width > limits.MaxWidth {
+		return "", false, outerror.CoverImageDimensionsMessage
+	}
+	if limits.MaxHeight > 0 && imageConfig.Height > limits.MaxHeight {
+		return "", false, outerror.CoverImageDimensionsMessage
+	}
+	return contentType, true, ""
+}
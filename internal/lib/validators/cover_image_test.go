@@ -0,0 +1,128 @@
+package validators
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/sariya23/game_service/internal/outerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testImage(width, height int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 100, A: 255})
+		}
+	}
+	return img
+}
+
+func encodeTestImage(t *testing.T, format string, width, height int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	var err error
+	switch format {
+	case "png":
+		err = png.Encode(&buf, testImage(width, height))
+	case "jpeg":
+		err = jpeg.Encode(&buf, testImage(width, height), nil)
+	case "gif":
+		err = gif.Encode(&buf, testImage(width, height), nil)
+	}
+	require.NoError(t, err)
+	return buf.Bytes()
+}
+
+func TestCoverImage_validation(t *testing.T) {
+	t.Parallel()
+	limits := CoverImageLimits{MaxBytes: 1 << 20, MaxWidth: 200, MaxHeight: 100}
+	pngImage := encodeTestImage(t, "png", 50, 50)
+	cases := []struct {
+		name                string
+		coverImage          []byte
+		limits              CoverImageLimits
+		expectedContentType string
+		expectedValid       bool
+		expectedMessage     string
+	}{
+		{
+			name:                "valid png",
+			coverImage:          pngImage,
+			limits:              limits,
+			expectedContentType: "image/png",
+			expectedValid:       true,
+		},
+		{
+			name:                "valid jpeg",
+			coverImage:          encodeTestImage(t, "jpeg", 50, 50),
+			limits:              limits,
+			expectedContentType: "image/jpeg",
+			expectedValid:       true,
+		},
+		{
+			name:                "valid gif",
+			coverImage:          encodeTestImage(t, "gif", 50, 50),
+			limits:              limits,
+			expectedContentType: "image/gif",
+			expectedValid:       true,
+		},
+		{
+			name:            "text instead of image",
+			coverImage:      []byte("definitely not an image"),
+			limits:          limits,
+			expectedValid:   false,
+			expectedMessage: outerror.UnsupportedCoverImageMessage,
+		},
+		{
+			name:            "corrupted png",
+			coverImage:      pngImage[:20],
+			limits:          limits,
+			expectedValid:   false,
+			expectedMessage: outerror.InvalidCoverImageMessage,
+		},
+		{
+			name:            "too many bytes",
+			coverImage:      pngImage,
+			limits:          CoverImageLimits{MaxBytes: 10},
+			expectedValid:   false,
+			expectedMessage: outerror.CoverImageTooLargeMessage,
+		},
+		{
+			name:            "too wide",
+			coverImage:      encodeTestImage(t, "png", 201, 10),
+			limits:          limits,
+			expectedValid:   false,
+			expectedMessage: outerror.CoverImageDimensionsMessage,
+		},
+		{
+			name:            "too high",
+			coverImage:      encodeTestImage(t, "png", 10, 101),
+			limits:          limits,
+			expectedValid:   false,
+			expectedMessage: outerror.CoverImageDimensionsMessage,
+		},
+		{
+			name:                "no limits",
+			coverImage:          encodeTestImage(t, "png", 300, 300),
+			limits:              CoverImageLimits{},
+			expectedContentType: "image/png",
+			expectedValid:       true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			gotContentType, gotValid, gotMessage := CoverImage(tc.coverImage, tc.limits)
+			assert.Equal(t, tc.expectedContentType, gotContentType)
+			assert.Equal(t, tc.expectedValid, gotValid)
+			assert.Equal(t, tc.expectedMessage, gotMessage)
+		})
+	}
+}
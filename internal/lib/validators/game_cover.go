@@ -0,0 +1,15 @@
+package validators
+
+import (
+	"github.com/sariya23/game_service/internal/outerror"
+)
+
+func SetGameCover(gameID int64, coverImage []byte) (valid bool, message string) {
+	if gameID < 0 {
+		return false, outerror.NegativeGameIDMessage
+	}
+	if len(coverImage) == 0 {
+		return false, outerror.CoverImageRequiredMessage
+	}
+	return true, ""
+}
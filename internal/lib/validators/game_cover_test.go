@@ -0,0 +1,49 @@
+package validators
+
+import (
+	"testing"
+
+	"github.com/sariya23/game_service/internal/outerror"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetGameCover_validation(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name            string
+		gameID          int64
+		coverImage      []byte
+		expectedValid   bool
+		expectedMessage string
+	}{
+		{
+			name:            "negative game id",
+			gameID:          -1,
+			coverImage:      []byte{1},
+			expectedValid:   false,
+			expectedMessage: outerror.NegativeGameIDMessage,
+		},
+		{
+			name:            "no image",
+			gameID:          1,
+			coverImage:      nil,
+			expectedValid:   false,
+			expectedMessage: outerror.CoverImageRequiredMessage,
+		},
+		{
+			name:            "valid",
+			gameID:          1,
+			coverImage:      []byte{1},
+			expectedValid:   true,
+			expectedMessage: "",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			gotValid, gotMessage := SetGameCover(tc.gameID, tc.coverImage)
+			assert.Equal(t, tc.expectedValid, gotValid)
+			assert.Equal(t, tc.expectedMessage, gotMessage)
+		})
+	}
+}
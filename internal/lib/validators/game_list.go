@@ -0,0 +1,19 @@
+package validators
+
+import (
+	"github.com/sariya23/game_service/internal/model/dto"
+	"github.com/sariya23/game_service/internal/outerror"
+)
+
+func GameList(filters dto.GameFilters) (valid bool, message string) {
+	if filters.ReleaseYear < 0 {
+		return false, outerror.NegativeYearMessage
+	}
+	if filters.Decade < 0 || filters.Decade%10 != 0 {
+		return false, outerror.InvalidDecadeMessage
+	}
+	if !filters.ReleasedAfter.IsZero() && !filters.ReleasedBefore.IsZero() && filters.ReleasedAfter.After(filters.ReleasedBefore) {
+		return false, outerror.InvalidReleaseDateRangeMessage
+	}
+	return true, ""
+}
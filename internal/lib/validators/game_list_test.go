@@ -0,0 +1,76 @@
+package validators
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sariya23/game_service/internal/model/dto"
+	"github.com/sariya23/game_service/internal/outerror"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGameList_validation(t *testing.T) {
+	t.Parallel()
+	day := func(year int, month time.Month, d int) time.Time {
+		return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+	}
+	cases := []struct {
+		name            string
+		filters         dto.GameFilters
+		expectedValid   bool
+		expectedMessage string
+	}{
+		{
+			name:          "no filters",
+			filters:       dto.GameFilters{},
+			expectedValid: true,
+		},
+		{
+			name:            "negative year",
+			filters:         dto.GameFilters{ReleaseYear: -1},
+			expectedValid:   false,
+			expectedMessage: outerror.NegativeYearMessage,
+		},
+		{
+			name:            "negative decade",
+			filters:         dto.GameFilters{Decade: -1990},
+			expectedValid:   false,
+			expectedMessage: outerror.InvalidDecadeMessage,
+		},
+		{
+			name:            "decade not divisible by 10",
+			filters:         dto.GameFilters{Decade: 1995},
+			expectedValid:   false,
+			expectedMessage: outerror.InvalidDecadeMessage,
+		},
+		{
+			name:          "decade",
+			filters:       dto.GameFilters{Decade: 1990},
+			expectedValid: true,
+		},
+		{
+			name:            "after is later than before",
+			filters:         dto.GameFilters{ReleasedAfter: day(2020, 1, 2), ReleasedBefore: day(2020, 1, 1)},
+			expectedValid:   false,
+			expectedMessage: outerror.InvalidReleaseDateRangeMessage,
+		},
+		{
+			name:          "same day range",
+			filters:       dto.GameFilters{ReleasedAfter: day(2020, 1, 1), ReleasedBefore: day(2020, 1, 1)},
+			expectedValid: true,
+		},
+		{
+			name:          "only after with upcoming",
+			filters:       dto.GameFilters{ReleasedAfter: day(2020, 1, 1), UpcomingOnly: true},
+			expectedValid: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			valid, message := GameList(tc.filters)
+			assert.Equal(t, tc.expectedValid, valid)
+			assert.Equal(t, tc.expectedMessage, message)
+		})
+	}
+}
@@ -2,20 +2,11 @@ package validators
 
 import (
 	"github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/lib/statusmachine"
 )
 
-// GameStatus ...
-// Запрещено:
-// DRAFT -> PUBLISH
-// PUBLISH -> PENDING
-// PUBLISH -> DRAFT
+// GameStatus сообщает, есть ли переход между статусами в statusmachine.Default.
+// Условия перехода (обложка, жанры) здесь не проверяются.
 func GameStatus(currentStatus, newStatus game.GameStatusType) bool {
-	if currentStatus == game.GameStatusType_DRAFT && newStatus == game.GameStatusType_PUBLISH {
-		return false
-	} else if currentStatus == game.GameStatusType_PUBLISH && newStatus == game.GameStatusType_PENDING {
-		return false
-	} else if currentStatus == game.GameStatusType_PUBLISH && newStatus == game.GameStatusType_DRAFT {
-		return false
-	}
-	return true
+	return statusmachine.Default().Can(currentStatus, newStatus)
 }
@@ -0,0 +1,24 @@
+package validators
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/sariya23/game_service/internal/outerror"
+)
+
+const maxRejectReasonLen = 1000
+
+func RejectGame(gameID int64, reason string) (valid bool, message string) {
+	if gameID < 0 {
+		return false, outerror.NegativeGameIDMessage
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return false, outerror.RejectReasonRequiredMessage
+	}
+	if utf8.RuneCountInString(reason) > maxRejectReasonLen {
+		return false, outerror.RejectReasonTooLongMessage
+	}
+	return true, ""
+}
@@ -0,0 +1,35 @@
+package validators
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/sariya23/game_service/internal/outerror"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRejectGame_validation(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name            string
+		gameID          int64
+		reason          string
+		expectedValid   bool
+		expectedMessage string
+	}{
+		{name: "valid", gameID: 1, reason: "Нет описания", expectedValid: true},
+		{name: "negative game id", gameID: -1, reason: "Нет описания", expectedValid: false, expectedMessage: outerror.NegativeGameIDMessage},
+		{name: "empty reason", gameID: 1, reason: "", expectedValid: false, expectedMessage: outerror.RejectReasonRequiredMessage},
+		{name: "only spaces", gameID: 1, reason: "   ", expectedValid: false, expectedMessage: outerror.RejectReasonRequiredMessage},
+		{name: "max length in runes", gameID: 1, reason: strings.Repeat("я", 1000), expectedValid: true},
+		{name: "too long", gameID: 1, reason: strings.Repeat("я", 1001), expectedValid: false, expectedMessage: outerror.RejectReasonTooLongMessage},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			valid, message := RejectGame(tc.gameID, tc.reason)
+			assert.Equal(t, tc.expectedValid, valid)
+			assert.Equal(t, tc.expectedMessage, message)
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package validators
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/sariya23/game_service/internal/outerror"
+)
+
+const (
+	minSuggestPrefixLen = 2
+	maxSuggestPrefixLen = 100
+)
+
+func SuggestGames(prefix string) (valid bool, message string) {
+	prefixLen := utf8.RuneCountInString(strings.TrimSpace(prefix))
+	if prefixLen < minSuggestPrefixLen {
+		return false, outerror.SuggestPrefixTooShortMessage
+	}
+	if prefixLen > maxSuggestPrefixLen {
+		return false, outerror.SuggestPrefixTooLongMessage
+	}
+	return true, ""
+}
@@ -0,0 +1,52 @@
+package validators
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/sariya23/game_service/internal/outerror"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuggestGames_validation(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name            string
+		prefix          string
+		expectedValid   bool
+		expectedMessage string
+	}{
+		{
+			name:            "empty prefix",
+			prefix:          "",
+			expectedValid:   false,
+			expectedMessage: outerror.SuggestPrefixTooShortMessage,
+		},
+		{
+			name:            "one letter with spaces",
+			prefix:          "  в ",
+			expectedValid:   false,
+			expectedMessage: outerror.SuggestPrefixTooShortMessage,
+		},
+		{
+			name:            "too long prefix",
+			prefix:          strings.Repeat("ы", 101),
+			expectedValid:   false,
+			expectedMessage: outerror.SuggestPrefixTooLongMessage,
+		},
+		{
+			name:            "two cyrillic letters",
+			prefix:          "ве",
+			expectedValid:   true,
+			expectedMessage: "",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			gotValid, gotMessage := SuggestGames(tc.prefix)
+			assert.Equal(t, tc.expectedValid, gotValid)
+			assert.Equal(t, tc.expectedMessage, gotMessage)
+		})
+	}
+}
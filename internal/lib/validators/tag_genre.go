@@ -0,0 +1,80 @@
+package validators
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/sariya23/game_service/internal/outerror"
+)
+
+// maxTagGenreNameLen совпадает с varchar(70) в таблицах tag и genre.
+const maxTagGenreNameLen = 70
+
+func CreateTag(tagName string) (valid bool, message string) {
+	return tagGenreName(tagName, outerror.TagNameRequiredMessage, outerror.TagNameTooLongMessage)
+}
+
+func RenameTag(tagID int64, tagName string) (valid bool, message string) {
+	if tagID < 0 {
+		return false, outerror.NegativeTagIDMessage
+	}
+	return CreateTag(tagName)
+}
+
+func CreateGenre(genreName string) (valid bool, message string) {
+	return tagGenreName(genreName, outerror.GenreNameRequiredMessage, outerror.GenreNameTooLongMessage)
+}
+
+func RenameGenre(genreID int64, genreName string) (valid bool, message string) {
+	if genreID < 0 {
+		return false, outerror.NegativeGenreIDMessage
+	}
+	return CreateGenre(genreName)
+}
+
+func MergeTags(sourceIDs []int64, targetID int64) (valid bool, message string) {
+	return mergeIDs(sourceIDs, targetID, outerror.NegativeTagIDMessage)
+}
+
+func MergeGenres(sourceIDs []int64, targetID int64) (valid bool, message string) {
+	return mergeIDs(sourceIDs, targetID, outerror.NegativeGenreIDMessage)
+}
+
+func SetGenreParent(genreID int64, parentID int64) (valid bool, message string) {
+	if genreID < 0 || parentID < 0 {
+		return false, outerror.NegativeGenreIDMessage
+	}
+	if genreID == parentID {
+		return false, outerror.GenreCycleMessage
+	}
+	return true, ""
+}
+
+func mergeIDs(sourceIDs []int64, targetID int64, negativeIDMessage string) (bool, string) {
+	if len(sourceIDs) == 0 {
+		return false, outerror.MergeSourcesRequiredMessage
+	}
+	if targetID < 0 {
+		return false, negativeIDMessage
+	}
+	for _, id := range sourceIDs {
+		if id < 0 {
+			return false, negativeIDMessage
+		}
+		if id == targetID {
+			return false, outerror.MergeIntoItselfMessage
+		}
+	}
+	return true, ""
+}
+
+func tagGenreName(name string, requiredMessage string, tooLongMessage string) (bool, string) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return false, requiredMessage
+	}
+	if utf8.RuneCountInString(name) > maxTagGenreNameLen {
+		return false, tooLongMessage
+	}
+	return true, ""
+}
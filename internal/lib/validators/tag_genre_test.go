@@ -0,0 +1,121 @@
+package validators
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/sariya23/game_service/internal/outerror"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenameTag_validation(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name            string
+		tagID           int64
+		tagName         string
+		expectedValid   bool
+		expectedMessage string
+	}{
+		{name: "valid", tagID: 1, tagName: "Рогалик", expectedValid: true},
+		{name: "negative tag id", tagID: -1, tagName: "Рогалик", expectedValid: false, expectedMessage: outerror.NegativeTagIDMessage},
+		{name: "empty name", tagID: 1, tagName: "", expectedValid: false, expectedMessage: outerror.TagNameRequiredMessage},
+		{name: "only spaces", tagID: 1, tagName: "   ", expectedValid: false, expectedMessage: outerror.TagNameRequiredMessage},
+		{name: "max length in runes", tagID: 1, tagName: strings.Repeat("я", 70), expectedValid: true},
+		{name: "too long", tagID: 1, tagName: strings.Repeat("я", 71), expectedValid: false, expectedMessage: outerror.TagNameTooLongMessage},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			valid, message := RenameTag(tc.tagID, tc.tagName)
+			assert.Equal(t, tc.expectedValid, valid)
+			assert.Equal(t, tc.expectedMessage, message)
+		})
+	}
+}
+
+func TestRenameGenre_validation(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name            string
+		genreID         int64
+		genreName       string
+		expectedValid   bool
+		expectedMessage string
+	}{
+		{name: "valid", genreID: 1, genreName: "Roguelite", expectedValid: true},
+		{name: "negative genre id", genreID: -1, genreName: "Roguelite", expectedValid: false, expectedMessage: outerror.NegativeGenreIDMessage},
+		{name: "empty name", genreID: 1, genreName: "", expectedValid: false, expectedMessage: outerror.GenreNameRequiredMessage},
+		{name: "only spaces", genreID: 1, genreName: "  ", expectedValid: false, expectedMessage: outerror.GenreNameRequiredMessage},
+		{name: "max length in runes", genreID: 1, genreName: strings.Repeat("ж", 70), expectedValid: true},
+		{name: "too long", genreID: 1, genreName: strings.Repeat("ж", 71), expectedValid: false, expectedMessage: outerror.GenreNameTooLongMessage},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			valid, message := RenameGenre(tc.genreID, tc.genreName)
+			assert.Equal(t, tc.expectedValid, valid)
+			assert.Equal(t, tc.expectedMessage, message)
+		})
+	}
+}
+
+func TestMergeTags_validation(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name            string
+		sourceIDs       []int64
+		targetID        int64
+		expectedValid   bool
+		expectedMessage string
+	}{
+		{name: "valid", sourceIDs: []int64{2, 3}, targetID: 1, expectedValid: true},
+		{name: "no sources", sourceIDs: nil, targetID: 1, expectedValid: false, expectedMessage: outerror.MergeSourcesRequiredMessage},
+		{name: "negative target", sourceIDs: []int64{2}, targetID: -1, expectedValid: false, expectedMessage: outerror.NegativeTagIDMessage},
+		{name: "negative source", sourceIDs: []int64{2, -3}, targetID: 1, expectedValid: false, expectedMessage: outerror.NegativeTagIDMessage},
+		{name: "target in sources", sourceIDs: []int64{2, 1}, targetID: 1, expectedValid: false, expectedMessage: outerror.MergeIntoItselfMessage},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			valid, message := MergeTags(tc.sourceIDs, tc.targetID)
+			assert.Equal(t, tc.expectedValid, valid)
+			assert.Equal(t, tc.expectedMessage, message)
+		})
+	}
+}
+
+func TestMergeGenres_validation(t *testing.T) {
+	t.Parallel()
+	valid, message := MergeGenres([]int64{2}, -1)
+	assert.False(t, valid)
+	assert.Equal(t, outerror.NegativeGenreIDMessage, message)
+	valid, message = MergeGenres([]int64{2}, 1)
+	assert.True(t, valid)
+	assert.Empty(t, message)
+}
+
+func TestSetGenreParent_validation(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name            string
+		genreID         int64
+		parentID        int64
+		expectedValid   bool
+		expectedMessage string
+	}{
+		{name: "valid", genreID: 2, parentID: 1, expectedValid: true},
+		{name: "make root", genreID: 2, parentID: 0, expectedValid: true},
+		{name: "negative genre id", genreID: -2, parentID: 1, expectedValid: false, expectedMessage: outerror.NegativeGenreIDMessage},
+		{name: "negative parent id", genreID: 2, parentID: -1, expectedValid: false, expectedMessage: outerror.NegativeGenreIDMessage},
+		{name: "parent is itself", genreID: 2, parentID: 2, expectedValid: false, expectedMessage: outerror.GenreCycleMessage},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			valid, message := SetGenreParent(tc.genreID, tc.parentID)
+			assert.Equal(t, tc.expectedValid, valid)
+			assert.Equal(t, tc.expectedMessage, message)
+		})
+	}
+}
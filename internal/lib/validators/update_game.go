@@ -0,0 +1,32 @@
+package validators
+
+import (
+	"slices"
+
+	"github.com/sariya23/game_service/internal/model/dto"
+	"github.com/sariya23/game_service/internal/outerror"
+)
+
+func UpdateGame(request dto.UpdateGameHandler) (valid bool, message string) {
+	if request.GameID < 0 {
+		return false, outerror.NegativeGameIDMessage
+	}
+	if len(request.UpdateMask) == 0 {
+		return false, outerror.EmptyUpdateMaskMessage
+	}
+	for _, path := range request.UpdateMask {
+		if !slices.Contains(dto.UpdateGameFields, path) {
+			return false, outerror.UnknownUpdateFieldMessage
+		}
+	}
+	if slices.Contains(request.UpdateMask, dto.UpdateGameFieldTitle) && request.Title == "" {
+		return false, outerror.TitleRequiredMessage
+	}
+	if slices.Contains(request.UpdateMask, dto.UpdateGameFieldDescription) && request.Description == "" {
+		return false, outerror.DescriptionRequiredMessage
+	}
+	if slices.Contains(request.UpdateMask, dto.UpdateGameFieldReleaseDate) && request.ReleaseDate.IsZero() {
+		return false, outerror.ReleaseDateRequiredMessage
+	}
+	return true, ""
+}
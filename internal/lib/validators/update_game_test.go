@@ -0,0 +1,81 @@
+package validators
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sariya23/game_service/internal/model/dto"
+	"github.com/sariya23/game_service/internal/outerror"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateGame_validation(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name            string
+		request         dto.UpdateGameHandler
+		expectedValid   bool
+		expectedMessage string
+	}{
+		{
+			name:            "negative game id",
+			request:         dto.UpdateGameHandler{GameID: -1, UpdateMask: []string{dto.UpdateGameFieldTitle}, Title: "qwe"},
+			expectedValid:   false,
+			expectedMessage: outerror.NegativeGameIDMessage,
+		},
+		{
+			name:            "empty mask",
+			request:         dto.UpdateGameHandler{GameID: 1, Title: "qwe"},
+			expectedValid:   false,
+			expectedMessage: outerror.EmptyUpdateMaskMessage,
+		},
+		{
+			name:            "unknown field",
+			request:         dto.UpdateGameHandler{GameID: 1, UpdateMask: []string{"cover_image"}},
+			expectedValid:   false,
+			expectedMessage: outerror.UnknownUpdateFieldMessage,
+		},
+		{
+			name:            "empty title in mask",
+			request:         dto.UpdateGameHandler{GameID: 1, UpdateMask: []string{dto.UpdateGameFieldTitle}},
+			expectedValid:   false,
+			expectedMessage: outerror.TitleRequiredMessage,
+		},
+		{
+			name:            "empty description in mask",
+			request:         dto.UpdateGameHandler{GameID: 1, UpdateMask: []string{dto.UpdateGameFieldDescription}},
+			expectedValid:   false,
+			expectedMessage: outerror.DescriptionRequiredMessage,
+		},
+		{
+			name:            "empty release date in mask",
+			request:         dto.UpdateGameHandler{GameID: 1, UpdateMask: []string{dto.UpdateGameFieldReleaseDate}},
+			expectedValid:   false,
+			expectedMessage: outerror.ReleaseDateRequiredMessage,
+		},
+		{
+			name:            "empty tags clear links",
+			request:         dto.UpdateGameHandler{GameID: 1, UpdateMask: []string{dto.UpdateGameFieldTags}},
+			expectedValid:   true,
+			expectedMessage: "",
+		},
+		{
+			name: "valid, fields outside mask are ignored",
+			request: dto.UpdateGameHandler{
+				GameID:      1,
+				ReleaseDate: time.Date(2018, 12, 25, 0, 0, 0, 0, time.UTC),
+				UpdateMask:  []string{dto.UpdateGameFieldReleaseDate},
+			},
+			expectedValid:   true,
+			expectedMessage: "",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			gotValid, gotMessage := UpdateGame(tc.request)
+			assert.Equal(t, tc.expectedValid, gotValid)
+			assert.Equal(t, tc.expectedMessage, gotMessage)
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package validators
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/sariya23/game_service/internal/events"
+	"github.com/sariya23/game_service/internal/outerror"
+)
+
+func CreateWebhook(rawURL string, eventTypes []string) (valid bool, message string) {
+	rawURL = strings.TrimSpace(rawURL)
+	if rawURL == "" {
+		return false, outerror.WebhookURLRequiredMessage
+	}
+	parsed, err := url.Parse(rawURL)
+	if err != nil || parsed.Host == "" || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return false, outerror.InvalidWebhookURLMessage
+	}
+	for _, eventType := range eventTypes {
+		if !events.Known(eventType) {
+			return false, outerror.UnknownEventTypeMessage
+		}
+	}
+	return true, ""
+}
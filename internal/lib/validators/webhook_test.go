@@ -0,0 +1,37 @@
+package validators
+
+import (
+	"testing"
+
+	"github.com/sariya23/game_service/internal/events"
+	"github.com/sariya23/game_service/internal/outerror"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateWebhook_validation(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name            string
+		url             string
+		eventTypes      []string
+		expectedValid   bool
+		expectedMessage string
+	}{
+		{name: "valid, all events", url: "https://partner.example.com/hook", expectedValid: true},
+		{name: "valid, some events", url: "http://localhost:8080/hook", eventTypes: []string{events.GameCreated, events.GameDeleted}, expectedValid: true},
+		{name: "empty url", url: "", expectedValid: false, expectedMessage: outerror.WebhookURLRequiredMessage},
+		{name: "only spaces", url: "   ", expectedValid: false, expectedMessage: outerror.WebhookURLRequiredMessage},
+		{name: "relative url", url: "/hook", expectedValid: false, expectedMessage: outerror.InvalidWebhookURLMessage},
+		{name: "unsupported scheme", url: "ftp://partner.example.com/hook", expectedValid: false, expectedMessage: outerror.InvalidWebhookURLMessage},
+		{name: "broken url", url: "http://[::1", expectedValid: false, expectedMessage: outerror.InvalidWebhookURLMessage},
+		{name: "unknown event", url: "https://partner.example.com/hook", eventTypes: []string{events.GameCreated, "GameLiked"}, expectedValid: false, expectedMessage: outerror.UnknownEventTypeMessage},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			valid, message := CreateWebhook(tc.url, tc.eventTypes)
+			assert.Equal(t, tc.expectedValid, valid)
+			assert.Equal(t, tc.expectedMessage, message)
+		})
+	}
+}
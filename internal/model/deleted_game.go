@@ -0,0 +1,16 @@
+package model
+
+import (
+	"time"
+
+	"github.com/sariya23/api_game_service/gen/game"
+)
+
+// DeletedGame - мягко удаленная игра, которую еще можно восстановить.
+type DeletedGame struct {
+	GameID      int64
+	Title       string
+	ReleaseDate time.Time
+	GameStatus  game.GameStatusType
+	DeletedAt   time.Time
+}
@@ -5,12 +5,13 @@ import (
 )
 
 type AddGameHandler struct {
-	Title       string
-	Genres      []string
-	Description string
-	ReleaseDate time.Time
-	CoverImage  []byte
-	Tags        []string
+	Title                 string
+	Genres                []string
+	Description           string
+	ReleaseDate           time.Time
+	CoverImage            []byte
+	Tags                  []string
+	CoverImageContentType string
 }
 
 type AddGameService struct {
@@ -6,6 +6,7 @@ type DeletedGame struct {
 	GameID      int64
 	Title       string
 	ReleaseYear uint64
+	ImageKey    string
 }
 
 func DeletedGameFromGame(game model.Game) *DeletedGame {
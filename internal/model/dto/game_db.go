@@ -9,12 +9,13 @@ import (
 )
 
 type GameDB struct {
-	GameID      int64
-	Title       string
-	Description string
-	ReleaseDate time.Time
-	ImageKey    sql.NullString
-	GameStatus  game.GameStatusType
+	GameID             int64
+	Title              string
+	Description        string
+	ReleaseDate        time.Time
+	ImageKey           sql.NullString
+	ImageVariantWidths []int
+	GameStatus         game.GameStatusType
 }
 
 func (g GameDB) ToGameNoImageURL() model.GameNoImageURL {
@@ -23,11 +24,12 @@ func (g GameDB) ToGameNoImageURL() model.GameNoImageURL {
 		imgKey = g.ImageKey.String
 	}
 	return model.GameNoImageURL{
-		GameID:      g.GameID,
-		Title:       g.Title,
-		Description: g.Description,
-		ReleaseDate: g.ReleaseDate,
-		GameStatus:  g.GameStatus,
-		ImageKey:    imgKey,
+		GameID:             g.GameID,
+		Title:              g.Title,
+		Description:        g.Description,
+		ReleaseDate:        g.ReleaseDate,
+		GameStatus:         g.GameStatus,
+		ImageKey:           imgKey,
+		ImageVariantWidths: g.ImageVariantWidths,
 	}
 }
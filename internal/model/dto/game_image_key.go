@@ -0,0 +1,6 @@
+package dto
+
+type GameImageKey struct {
+	GameID   int64
+	ImageKey string
+}
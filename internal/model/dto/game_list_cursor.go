@@ -0,0 +1,16 @@
+package dto
+
+import "time"
+
+// GameListCursor - позиция последней отданной игры в GameList.
+// Rank заполнен только для выдачи с полнотекстовым поиском.
+// Sort - режим сортировки, для которого выдан курсор, Filters - хэш
+// фильтров запроса, чтобы токен нельзя было применить к другой выдаче.
+type GameListCursor struct {
+	Sort        GameSort  `json:"s"`
+	Rank        *float32  `json:"r,omitempty"`
+	Title       string    `json:"t"`
+	ReleaseDate time.Time `json:"d"`
+	GameID      int64     `json:"id"`
+	Filters     string    `json:"f"`
+}
@@ -0,0 +1,18 @@
+package dto
+
+import (
+	"github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/model"
+)
+
+// GameModeration - решение модерации вместе с переходом статуса,
+// который оно выполняет.
+type GameModeration struct {
+	GameID     int64
+	Decision   model.ModerationDecision
+	FromStatus game.GameStatusType
+	ToStatus   game.GameStatusType
+	Reason     string
+	Actor      string
+	RequestID  string
+}
@@ -0,0 +1,24 @@
+package dto
+
+// GameSort - порядок выдачи GameList.
+type GameSort int32
+
+const (
+	// GameSortUnspecified - по релевантности при поиске, иначе по названию.
+	GameSortUnspecified GameSort = iota
+	// GameSortTitle - по названию, затем по дате выхода.
+	GameSortTitle
+	// GameSortNewest - сначала новые релизы.
+	GameSortNewest
+	// GameSortOldest - сначала старые релизы.
+	GameSortOldest
+	// GameSortRecentlyAdded - сначала недавно добавленные игры.
+	GameSortRecentlyAdded
+	// GameSortRelevance - по ts_rank, только вместе с Query.
+	GameSortRelevance
+)
+
+// Known сообщает, есть ли такой режим сортировки.
+func (s GameSort) Known() bool {
+	return s >= GameSortUnspecified && s <= GameSortRelevance
+}
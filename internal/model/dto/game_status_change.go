@@ -0,0 +1,13 @@
+package dto
+
+import "github.com/sariya23/api_game_service/gen/game"
+
+// GameStatusChange - смена статуса игры. Если ExpectedStatus задан,
+// статус меняется только когда игра все еще в нем.
+type GameStatusChange struct {
+	GameID         int64
+	ExpectedStatus *game.GameStatusType
+	NewStatus      game.GameStatusType
+	Actor          string
+	RequestID      string
+}
@@ -1,7 +1,34 @@
 package dto
 
+import (
+	"time"
+
+	"github.com/sariya23/api_game_service/gen/game"
+)
+
 type GameFilters struct {
 	ReleaseYear int32
-	Genres      []string
-	Tags        []string
+	// Decade - первый год десятилетия, например 1990.
+	Decade int32
+	// ReleasedAfter и ReleasedBefore - границы даты выхода включительно,
+	// нулевое значение - без границы.
+	ReleasedAfter  time.Time
+	ReleasedBefore time.Time
+	// UpcomingOnly - только игры, которые еще не вышли.
+	UpcomingOnly bool
+	Genres       []string
+	Tags         []string
+	// GenresMatch и TagsMatch - нужен любой из Genres/Tags или все сразу.
+	GenresMatch MatchMode
+	TagsMatch   MatchMode
+	// ExcludeGenres и ExcludeTags - игры хотя бы с одним из них не попадают в выдачу.
+	ExcludeGenres []string
+	ExcludeTags   []string
+	// Query - полнотекстовый поиск по названию и описанию
+	// с русской и английской морфологией.
+	Query string
+	Sort  GameSort
+	// Statuses - статусы игр в выдаче. Пустой список для публичного
+	// запроса означает PUBLISH, для привилегированного - все статусы.
+	Statuses []game.GameStatusType
 }
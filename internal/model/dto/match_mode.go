@@ -0,0 +1,16 @@
+package dto
+
+// MatchMode - как фильтр по списку тэгов или жанров сочетает значения.
+type MatchMode int32
+
+const (
+	// MatchAny - у игры есть хотя бы одно значение из списка.
+	MatchAny MatchMode = iota
+	// MatchAll - у игры есть все значения из списка.
+	MatchAll
+)
+
+// Known сообщает, есть ли такой режим.
+func (m MatchMode) Known() bool {
+	return m == MatchAny || m == MatchAll
+}
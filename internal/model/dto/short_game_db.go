@@ -12,6 +12,7 @@ type ShortGameDB struct {
 	Title, Description string
 	ReleaseDate        time.Time
 	ImageKey           sql.NullString
+	ImageVariantWidths []int
 }
 
 func (sh ShortGameDB) ToShortGameNoImageURL() model.ShortGameNoImageURL {
@@ -20,10 +21,11 @@ func (sh ShortGameDB) ToShortGameNoImageURL() model.ShortGameNoImageURL {
 		imgKey = sh.ImageKey.String
 	}
 	return model.ShortGameNoImageURL{
-		GameID:      sh.GameID,
-		ImageKey:    imgKey,
-		Description: sh.Description,
-		ReleaseDate: sh.ReleaseDate,
-		Title:       sh.Title,
+		GameID:             sh.GameID,
+		ImageKey:           imgKey,
+		ImageVariantWidths: sh.ImageVariantWidths,
+		Description:        sh.Description,
+		ReleaseDate:        sh.ReleaseDate,
+		Title:              sh.Title,
 	}
 }
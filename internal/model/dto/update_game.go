@@ -0,0 +1,42 @@
+package dto
+
+import "time"
+
+// Пути field mask, которые можно передать в UpdateGame.
+const (
+	UpdateGameFieldTitle       = "title"
+	UpdateGameFieldDescription = "description"
+	UpdateGameFieldReleaseDate = "release_date"
+	UpdateGameFieldTags        = "tags"
+	UpdateGameFieldGenres      = "genres"
+)
+
+// UpdateGameFields - все пути, которые поддерживает UpdateGame.
+var UpdateGameFields = []string{
+	UpdateGameFieldTitle,
+	UpdateGameFieldDescription,
+	UpdateGameFieldReleaseDate,
+	UpdateGameFieldTags,
+	UpdateGameFieldGenres,
+}
+
+type UpdateGameHandler struct {
+	GameID      int64
+	Title       string
+	Genres      []string
+	Description string
+	ReleaseDate time.Time
+	Tags        []string
+	UpdateMask  []string
+}
+
+type UpdateGameService struct {
+	GameID       int64
+	Title        string
+	Description  string
+	ReleaseDate  time.Time
+	TagIDs       []int64
+	GenreIDs     []int64
+	UpdateTags   bool
+	UpdateGenres bool
+}
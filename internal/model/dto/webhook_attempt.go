@@ -0,0 +1,16 @@
+package dto
+
+import "time"
+
+// WebhookAttempt - результат одной попытки доставки.
+// Если Delivered=false и GiveUp=false, следующая попытка будет в NextAttemptAt.
+type WebhookAttempt struct {
+	DeliveryID    int64
+	Attempt       int
+	StatusCode    int
+	Error         string
+	Duration      time.Duration
+	Delivered     bool
+	GiveUp        bool
+	NextAttemptAt time.Time
+}
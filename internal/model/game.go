@@ -7,12 +7,14 @@ import (
 )
 
 type Game struct {
-	GameID      int64
-	Title       string
-	Description string
-	ReleaseDate time.Time
-	ImageURL    string
-	Tags        []Tag
-	Genres      []Genre
-	GameStatus  game.GameStatusType
+	GameID           int64
+	Title            string
+	Description      string
+	ReleaseDate      time.Time
+	ImageURL         string
+	ImageVariantURLs map[int]string
+	Tags             []Tag
+	Genres           []Genre
+	GenrePaths       []GenrePath
+	GameStatus       game.GameStatusType
 }
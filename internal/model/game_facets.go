@@ -0,0 +1,20 @@
+package model
+
+// FacetCount - сколько игр подходит под значение фасета.
+type FacetCount struct {
+	Value string
+	Count int64
+}
+
+// YearFacetCount - сколько игр вышло в году Year.
+type YearFacetCount struct {
+	Year  int32
+	Count int64
+}
+
+// GameFacets - счетчики для боковой панели фильтров GameList.
+type GameFacets struct {
+	Tags   []FacetCount
+	Genres []FacetCount
+	Years  []YearFacetCount
+}
@@ -0,0 +1,23 @@
+package model
+
+import "time"
+
+// ModerationDecision - решение по игре в очереди модерации.
+type ModerationDecision string
+
+const (
+	ModerationSubmit  ModerationDecision = "submit"
+	ModerationApprove ModerationDecision = "approve"
+	ModerationReject  ModerationDecision = "reject"
+)
+
+// PendingGame - игра в очереди модераторов.
+type PendingGame struct {
+	GameID      int64
+	Title       string
+	ReleaseDate time.Time
+	// SubmittedBy и SubmittedAt пустые, если игру перевели в PENDING
+	// в обход SubmitForReview.
+	SubmittedBy string
+	SubmittedAt time.Time
+}
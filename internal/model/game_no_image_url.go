@@ -7,14 +7,15 @@ import (
 )
 
 type GameNoImageURL struct {
-	GameID      int64
-	Title       string
-	Description string
-	ReleaseDate time.Time
-	ImageKey    string
-	Tags        []Tag
-	Genres      []Genre
-	GameStatus  game.GameStatusType
+	GameID             int64
+	Title              string
+	Description        string
+	ReleaseDate        time.Time
+	ImageKey           string
+	ImageVariantWidths []int
+	Tags               []Tag
+	Genres             []Genre
+	GameStatus         game.GameStatusType
 }
 
 func (g GameNoImageURL) ToDomain(imageURL string) Game {
@@ -0,0 +1,16 @@
+package model
+
+import (
+	"time"
+
+	"github.com/sariya23/api_game_service/gen/game"
+)
+
+// GameStatusHistoryEntry - одна смена статуса игры.
+type GameStatusHistoryEntry struct {
+	OldStatus game.GameStatusType
+	NewStatus game.GameStatusType
+	Actor     string
+	RequestID string
+	ChangedAt time.Time
+}
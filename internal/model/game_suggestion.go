@@ -0,0 +1,6 @@
+package model
+
+type GameSuggestion struct {
+	GameID int64
+	Title  string
+}
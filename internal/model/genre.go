@@ -1,10 +1,19 @@
 package model
 
+import "strings"
+
 type Genre struct {
 	GenreID   int64
 	GenreName string
 }
 
+// GenrePath - цепочка жанров от корня до жанра, например RPG › Action RPG.
+type GenrePath []Genre
+
+func (p GenrePath) String() string {
+	return strings.Join(GenreNames(p), " › ")
+}
+
 func GenreIDs(g []Genre) []int64 {
 	if len(g) == 0 {
 		return nil
@@ -0,0 +1,13 @@
+package model
+
+import "time"
+
+// OutboxEvent - событие об изменении игры, ожидающее доставки.
+type OutboxEvent struct {
+	OutboxID  int64
+	GameID    int64
+	EventType string
+	Payload   []byte
+	Attempts  int
+	CreatedAt time.Time
+}
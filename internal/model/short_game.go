@@ -7,4 +7,5 @@ type ShortGame struct {
 	Title, Description string
 	ReleaseDate        time.Time
 	ImageURL           string
+	ThumbnailURL       string
 }
@@ -7,6 +7,7 @@ type ShortGameNoImageURL struct {
 	Title, Description string
 	ReleaseDate        time.Time
 	ImageKey           string
+	ImageVariantWidths []int
 }
 
 func (sh ShortGameNoImageURL) ToShortGame(imageURL string) ShortGame {
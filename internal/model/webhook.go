@@ -0,0 +1,32 @@
+package model
+
+import "time"
+
+// Статусы доставки события в webhook.
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliveryDelivered = "delivered"
+	WebhookDeliveryFailed    = "failed"
+)
+
+// Webhook - подписка партнера на события игр. Пустой EventTypes - все события.
+type Webhook struct {
+	WebhookID  int64
+	URL        string
+	Secret     string
+	EventTypes []string
+	CreatedBy  string
+	CreatedAt  time.Time
+}
+
+// WebhookDelivery - событие, которое нужно доставить в webhook.
+type WebhookDelivery struct {
+	DeliveryID int64
+	WebhookID  int64
+	URL        string
+	Secret     string
+	OutboxID   int64
+	EventType  string
+	Payload    []byte
+	Attempts   int
+}
@@ -12,6 +12,29 @@ var (
 	ErrImageNotFoundS3            = errors.New("game image not found in s3")
 	ErrUnknownGameStatus          = errors.New("unknown game status")
 	ErrInvalidNewGameStatus       = errors.New("invalid new game status")
+	ErrInvalidPageToken           = errors.New("invalid page token")
+	ErrUnknownGameSort            = errors.New("unknown game sort")
+	ErrRelevanceSortWithoutQuery  = errors.New("relevance sort requires search query")
+	ErrUnknownMatchMode           = errors.New("unknown match mode")
+	ErrStatusFilterForbidden      = errors.New("status filter is not allowed for caller")
+	ErrCallerRequired             = errors.New("caller is required")
+	ErrModerationForbidden        = errors.New("caller is not a moderator")
+	ErrGameCoverRequired          = errors.New("game without cover cannot be published")
+	ErrGameGenreRequired          = errors.New("game without genre cannot be published")
+	ErrGameNotPending             = errors.New("game is not pending")
+	ErrPublishAtInPast            = errors.New("publish time is in the past")
+	ErrWatchLagged                = errors.New("watcher is lagging behind")
+	ErrWebhookNotFound            = errors.New("webhook not found")
+	ErrTagAlreadyExist            = errors.New("tag with this name already exist")
+	ErrGenreAlreadyExist          = errors.New("genre with this name already exist")
+	ErrTagInUse                   = errors.New("tag is linked to games")
+	ErrGenreInUse                 = errors.New("genre is linked to games")
+	ErrGenreCycle                 = errors.New("genre hierarchy cycle")
+	ErrInvalidAuthToken           = errors.New("invalid auth token")
+	ErrModerationRequired         = errors.New("leaving pending requires a moderation decision")
+	ErrInvalidWebhook             = errors.New("invalid webhook")
+	ErrWebhookAddressForbidden    = errors.New("webhook address is not public")
+	ErrMergeIntoItself            = errors.New("merge target is one of the sources")
 )
 
 var (
@@ -30,4 +53,54 @@ var (
 	NegativeGameIDMessage             = "Negative game id"
 	NegativeYearMessage               = "Negative year"
 	EmptyRequestMessage               = "Empty request"
+	EmptyUpdateMaskMessage            = "Update mask is empty"
+	UnknownUpdateFieldMessage         = "Unknown field in update mask"
+	CoverImageRequiredMessage         = "Cover image is required field"
+	CannotSaveGameImageMessage        = "Cannot save game image. Store is not response"
+	UnsupportedCoverImageMessage      = "Cover image must be PNG, JPEG or GIF"
+	InvalidCoverImageMessage          = "Cover image is corrupted"
+	CoverImageTooLargeMessage         = "Cover image size exceeds the limit"
+	CoverImageDimensionsMessage       = "Cover image dimensions exceed the limit"
+	SuggestPrefixTooShortMessage      = "Search prefix is too short"
+	SuggestPrefixTooLongMessage       = "Search prefix is too long"
+	InvalidPageTokenMessage           = "Invalid page token"
+	UnknownGameSortMessage            = "Unknown sort"
+	RelevanceSortWithoutQueryMessage  = "Relevance sort requires search query"
+	UnknownMatchModeMessage           = "Unknown match mode"
+	InvalidDecadeMessage              = "Decade must be a non-negative year divisible by 10"
+	InvalidReleaseDateRangeMessage    = "ReleasedAfter is later than ReleasedBefore"
+	StatusFilterForbiddenMessage      = "Only published games are available"
+	CallerRequiredMessage             = "Caller is required"
+	ModerationForbiddenMessage        = "Only moderators can do this"
+	RejectReasonRequiredMessage       = "Reject reason is required"
+	RejectReasonTooLongMessage        = "Reject reason is too long"
+	GameCoverRequiredMessage          = "Game without cover cannot be published"
+	GameGenreRequiredMessage          = "Game without genre cannot be published"
+	GameNotPendingMessage             = "Only pending games can be scheduled"
+	PublishAtInPastMessage            = "Publish time must be in the future"
+	WatchLaggedMessage                = "Client is too slow, resume from the last received seq"
+	WebhookNotFoundMessage            = "Webhook not found"
+	WebhookURLRequiredMessage         = "Webhook url is required"
+	InvalidWebhookURLMessage          = "Webhook url must be an absolute http or https url"
+	UnknownEventTypeMessage           = "Unknown event type"
+	TagAlreadyExistMessage            = "Tag already exist"
+	GenreAlreadyExistMessage          = "Genre already exist"
+	TagInUseMessage                   = "Tag is linked to games, use force to unlink and delete it"
+	GenreInUseMessage                 = "Genre is linked to games, use force to unlink and delete it"
+	TagNameRequiredMessage            = "Tag name is required"
+	TagNameTooLongMessage             = "Tag name is too long"
+	GenreNameRequiredMessage          = "Genre name is required"
+	GenreNameTooLongMessage           = "Genre name is too long"
+	NegativeTagIDMessage              = "Negative tag id"
+	NegativeGenreIDMessage            = "Negative genre id"
+	MergeSourcesRequiredMessage       = "At least one source id is required"
+	MergeIntoItselfMessage            = "Target id cannot be one of the source ids"
+	GenreCycleMessage                 = "Genre cannot be nested into itself or its sub-genre"
+	InvalidAuthTokenMessage           = "Invalid or expired auth token"
+	ModerationRequiredMessage         = "Use ApproveGame or RejectGame to move a game out of PENDING"
+	CallerMetadataForbiddenMessage    = "user_id and user_role metadata are not accepted, pass a bearer token in authorization"
+	NegativeAfterSeqMessage           = "Negative after_seq"
+	InvalidWebhookMessage             = "Webhook url or event types are invalid"
+	WebhookAddressForbiddenMessage    = "Webhook url must resolve to a public address"
+	NegativeWebhookIDMessage          = "Negative webhook id"
 )
@@ -0,0 +1,31 @@
+package outerror
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// UnknownNamesError - ErrTagNotFound или ErrGenreNotFound вместе с именами,
+// которых нет в справочнике. errors.Is видит исходную ошибку.
+type UnknownNamesError struct {
+	Err   error
+	Names []string
+}
+
+func (e *UnknownNamesError) Error() string {
+	return fmt.Sprintf("%v: %s", e.Err, strings.Join(e.Names, ", "))
+}
+
+func (e *UnknownNamesError) Unwrap() error {
+	return e.Err
+}
+
+// UnknownNames возвращает неизвестные имена из цепочки err или nil.
+func UnknownNames(err error) []string {
+	var unknownNamesErr *UnknownNamesError
+	if errors.As(err, &unknownNamesErr) {
+		return unknownNamesErr.Names
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package outerror
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnknownNames(t *testing.T) {
+	t.Parallel()
+	err := fmt.Errorf("qwe: %w", &UnknownNamesError{Err: ErrTagNotFound, Names: []string{"Coop", "Roguelite"}})
+
+	assert.ErrorIs(t, err, ErrTagNotFound)
+	assert.NotErrorIs(t, err, ErrGenreNotFound)
+	assert.Equal(t, []string{"Coop", "Roguelite"}, UnknownNames(err))
+	assert.Equal(t, "qwe: tag not found: Coop, Roguelite", err.Error())
+	assert.Nil(t, UnknownNames(fmt.Errorf("qwe: %w", ErrTagNotFound)))
+	assert.Nil(t, UnknownNames(errors.New("some error")))
+}
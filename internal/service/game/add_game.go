@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log/slog"
 
+	"github.com/sariya23/game_service/internal/lib/caller"
 	"github.com/sariya23/game_service/internal/lib/logger"
 	"github.com/sariya23/game_service/internal/model"
 	"github.com/sariya23/game_service/internal/model/dto"
@@ -23,6 +24,10 @@ func (gameService *GameService) AddGame(
 	log = log.With("title", gameToAdd.Title)
 	log = log.With("release_date", gameToAdd.ReleaseDate.String())
 	log = logger.EnrichRequestID(ctx, log)
+	if caller.ID(ctx) == "" {
+		log.Warn("anonymous caller")
+		return 0, fmt.Errorf("%s: %w", operationPlace, outerror.ErrCallerRequired)
+	}
 	_, err := gameService.gameRepository.GetGameByTitleAndReleaseYear(ctx, gameToAdd.Title, int32(gameToAdd.ReleaseDate.Year()))
 	if err == nil {
 		log.Warn("game already exists", slog.String("title", gameToAdd.Title), slog.String("release_date", gameToAdd.ReleaseDate.String()))
@@ -31,32 +36,13 @@ func (gameService *GameService) AddGame(
 		log.Error("unexpected error, cannot check game", slog.String("error", err.Error()))
 		return 0, fmt.Errorf("%s:%w", operationPlace, err)
 	}
-	var errSaveImage error
-	var imageKey string
-	if len(gameToAdd.CoverImage) != 0 {
-		gameKey := minioclient.GameKey(gameToAdd.Title, gameToAdd.ReleaseDate.Year())
-		imageKey, err = gameService.s3Storager.SaveObject(
-			ctx,
-			gameKey,
-			bytes.NewReader(gameToAdd.CoverImage),
-		)
-		if err != nil {
-			log.Error("failed to save image",
-				slog.String("game_key", gameKey),
-				slog.String("error", err.Error()),
-			)
-			errSaveImage = outerror.ErrCannotSaveGameImage
-		} else {
-			log.Info("image successfully saved in s3", slog.String("game_key", gameKey))
-		}
-	}
 	var tagIDs []int64
 	if t := gameToAdd.Tags; len(t) != 0 {
 		tags, err := gameService.tagReposetory.GetTagByNames(ctx, t)
 		if err != nil {
 			if errors.Is(err, outerror.ErrTagNotFound) {
-				log.Warn("tag doesnt exists", slog.Any("tags", t))
-				return 0, fmt.Errorf("%s: %w", operationPlace, outerror.ErrTagNotFound)
+				log.Warn("tag doesnt exists", slog.Any("tags", t), slog.Any("unknown", outerror.UnknownNames(err)))
+				return 0, fmt.Errorf("%s: %w", operationPlace, err)
 			}
 			log.Error("cannot check tags, unexpected error", slog.String("error", err.Error()))
 			return 0, fmt.Errorf("%s: %w", operationPlace, err)
@@ -68,8 +54,8 @@ func (gameService *GameService) AddGame(
 		genres, err := gameService.genreReposetory.GetGenreByNames(ctx, g)
 		if err != nil {
 			if errors.Is(err, outerror.ErrGenreNotFound) {
-				log.Warn("genre doesnt exists", slog.Any("tags", g))
-				return 0, fmt.Errorf("%s: %w", operationPlace, outerror.ErrGenreNotFound)
+				log.Warn("genre doesnt exists", slog.Any("genres", g), slog.Any("unknown", outerror.UnknownNames(err)))
+				return 0, fmt.Errorf("%s: %w", operationPlace, err)
 			}
 			log.Error("cannot check genres, unexpected error", slog.String("error", err.Error()))
 			return 0, fmt.Errorf("%s: %w", operationPlace, err)
@@ -83,13 +69,40 @@ func (gameService *GameService) AddGame(
 		Description: gameToAdd.Description,
 		TagIDs:      tagIDs,
 		GenreIDs:    genreIDs,
-		ImageKey:    imageKey,
 	}
 	gameID, err := gameService.gameRepository.SaveGame(ctx, addGameService)
 	if err != nil {
 		log.Error("unexpected error, cannot save game", slog.String("error", err.Error()))
 		return 0, fmt.Errorf("%s: %w", operationPlace, err)
 	}
-	// Отправка сообщения в кафку
+	var errSaveImage error
+	if len(gameToAdd.CoverImage) != 0 {
+		errSaveImage = gameService.saveNewGameCover(ctx, log, gameID, gameToAdd.CoverImage, gameToAdd.CoverImageContentType)
+	}
+	// GameCreated уже записан в outbox в транзакции SaveGame.
 	return gameID, errSaveImage
 }
+
+// saveNewGameCover загружает обложку только что сохраненной игры. Ключ
+// обложки строится из game_id, поэтому загрузка идет после SaveGame.
+// Если обложку сохранить не удалось, игра остается без нее.
+func (gameService *GameService) saveNewGameCover(ctx context.Context, log *slog.Logger, gameID int64, coverImage []byte, contentType string) error {
+	gameKey := minioclient.GameCoverKey(gameID, contentType)
+	imageKey, err := gameService.s3Storager.SaveObject(ctx, gameKey, bytes.NewReader(coverImage), contentType)
+	if err != nil {
+		log.Error("failed to save image",
+			slog.String("game_key", gameKey),
+			slog.String("error", err.Error()),
+		)
+		return outerror.ErrCannotSaveGameImage
+	}
+	variantWidths := gameService.saveCoverVariants(ctx, log, imageKey, coverImage)
+	err = gameService.gameRepository.UpdateGameImageKey(ctx, gameID, imageKey, variantWidths)
+	if err != nil {
+		log.Error("cannot save image key", slog.String("game_key", gameKey), slog.String("error", err.Error()))
+		gameService.deleteCover(ctx, log, imageKey)
+		return outerror.ErrCannotSaveGameImage
+	}
+	log.Info("image successfully saved in s3", slog.String("game_key", gameKey))
+	return nil
+}
@@ -4,12 +4,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 
+	"github.com/sariya23/game_service/internal/lib/caller"
 	"github.com/sariya23/game_service/internal/lib/logger"
 	"github.com/sariya23/game_service/internal/outerror"
-	minioclient "github.com/sariya23/game_service/internal/storage/s3/minio"
 )
 
+// DeleteGame мягко удаляет игру. Доступно только модераторам, как и
+// RestoreGame. Обложка остается в S3 до очистки по сроку хранения,
+// чтобы игру можно было восстановить.
 func (gameService *GameService) DeleteGame(
 	ctx context.Context,
 	gameID int64,
@@ -17,6 +21,14 @@ func (gameService *GameService) DeleteGame(
 	const operationPlace = "gameservice.DeleteGame"
 	log := gameService.log.With("operationPlace", operationPlace)
 	log = logger.EnrichRequestID(ctx, log)
+	if caller.ID(ctx) == "" {
+		log.Warn("anonymous caller")
+		return 0, fmt.Errorf("%s: %w", operationPlace, outerror.ErrCallerRequired)
+	}
+	if !caller.IsPrivileged(ctx) {
+		log.Warn("caller is not a moderator", slog.String("role", caller.Role(ctx)))
+		return 0, fmt.Errorf("%s: %w", operationPlace, outerror.ErrModerationForbidden)
+	}
 	deletedGame, err := gameService.gameRepository.DaleteGame(ctx, gameID)
 	if err != nil {
 		if errors.Is(err, outerror.ErrGameNotFound) {
@@ -26,16 +38,6 @@ func (gameService *GameService) DeleteGame(
 		log.Error(fmt.Sprintf("unexpected error; err=%v", err))
 		return 0, fmt.Errorf("%s: %w", operationPlace, err)
 	}
-	log.Info(fmt.Sprintf("game with id=%v deleted from DB", gameID))
-	gameKey := minioclient.GameKey(deletedGame.Title, int(deletedGame.ReleaseYear))
-	err = gameService.s3Storager.DeleteObject(ctx, gameKey)
-	var errDeleteImage error
-	if err != nil {
-		log.Error("cannot delete image from s3")
-		log.Info(fmt.Sprintf("game: %+v", deletedGame))
-		errDeleteImage = err
-	} else {
-		log.Info("image cover deleted from s3 or is not present")
-	}
-	return deletedGame.GameID, errDeleteImage
+	log.Info(fmt.Sprintf("game with id=%v marked as deleted", gameID))
+	return deletedGame.GameID, nil
 }
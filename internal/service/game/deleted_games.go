@@ -0,0 +1,84 @@
+package gameservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/sariya23/game_service/internal/lib/caller"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/model"
+	"github.com/sariya23/game_service/internal/outerror"
+)
+
+const (
+	DefaultDeletedGamesLimit uint32 = 20
+	MaxDeletedGamesLimit     uint32 = 100
+)
+
+// ListDeletedGames возвращает корзину мягко удаленных игр. Доступно только модераторам.
+func (gameService *GameService) ListDeletedGames(ctx context.Context, limit uint32) ([]model.DeletedGame, error) {
+	const operationPlace = "gameservice.ListDeletedGames"
+	log := gameService.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	if !caller.IsPrivileged(ctx) {
+		log.Warn("caller is not a moderator", slog.String("role", caller.Role(ctx)))
+		return nil, fmt.Errorf("%s: %w", operationPlace, outerror.ErrModerationForbidden)
+	}
+	if limit == 0 {
+		limit = DefaultDeletedGamesLimit
+	}
+	limit = min(limit, MaxDeletedGamesLimit)
+	games, err := gameService.gameRepository.ListDeletedGames(ctx, limit)
+	if err != nil {
+		log.Error(fmt.Sprintf("unexpected error; err=%v", err))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return games, nil
+}
+
+// RestoreGame возвращает мягко удаленную игру. Доступно только модераторам.
+func (gameService *GameService) RestoreGame(ctx context.Context, gameID int64) error {
+	const operationPlace = "gameservice.RestoreGame"
+	log := gameService.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	if !caller.IsPrivileged(ctx) {
+		log.Warn("caller is not a moderator", slog.String("role", caller.Role(ctx)))
+		return fmt.Errorf("%s: %w", operationPlace, outerror.ErrModerationForbidden)
+	}
+	err := gameService.gameRepository.RestoreGame(ctx, gameID, caller.ID(ctx), caller.RequestID(ctx))
+	if err != nil {
+		if errors.Is(err, outerror.ErrGameNotFound) || errors.Is(err, outerror.ErrGameAlreadyExist) {
+			log.Warn("cannot restore game", slog.Int64("gameID", gameID), slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		log.Error(fmt.Sprintf("unexpected error; err=%v", err))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return nil
+}
+
+// PurgeDeletedGames окончательно удаляет до limit игр, пролежавших в корзине
+// дольше retention, вместе с обложками. Возвращает число удаленных игр.
+func (gameService *GameService) PurgeDeletedGames(ctx context.Context, retention time.Duration, limit uint32) (int, error) {
+	const operationPlace = "gameservice.PurgeDeletedGames"
+	log := gameService.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	purged, err := gameService.gameRepository.PurgeDeletedGames(ctx, time.Now().Add(-retention), limit)
+	if err != nil {
+		log.Error(fmt.Sprintf("unexpected error; err=%v", err))
+		return 0, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	for _, deletedGame := range purged {
+		if deletedGame.ImageKey == "" {
+			continue
+		}
+		gameService.deleteCover(ctx, log.With(slog.Int64("gameID", deletedGame.GameID)), deletedGame.ImageKey)
+	}
+	if len(purged) > 0 {
+		log.Info("deleted games purged", slog.Int("count", len(purged)))
+	}
+	return len(purged), nil
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"io"
 	"log/slog"
+	"time"
 
 	"github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/lib/statusmachine"
 	"github.com/sariya23/game_service/internal/model"
 	"github.com/sariya23/game_service/internal/model/dto"
 )
@@ -13,10 +15,23 @@ import (
 type GameReposetory interface {
 	GetGameByTitleAndReleaseYear(ctx context.Context, title string, releaseYear int32) (*model.Game, error)
 	GetGameByID(ctx context.Context, gameID int64) (*model.GameNoImageURL, error)
-	GameList(ctx context.Context, filters dto.GameFilters, limit uint32) ([]model.ShortGameNoImageURL, error)
+	GameList(ctx context.Context, filters dto.GameFilters, limit uint32, after *dto.GameListCursor) ([]model.ShortGameNoImageURL, *dto.GameListCursor, error)
+	GameFacets(ctx context.Context, filters dto.GameFilters) (model.GameFacets, error)
+	SuggestGames(ctx context.Context, prefix string, limit uint32, statuses []game.GameStatusType) ([]model.GameSuggestion, error)
 	SaveGame(ctx context.Context, game dto.AddGameService) (int64, error)
+	UpdateGame(ctx context.Context, game dto.UpdateGameService) error
+	UpdateGameImageKey(ctx context.Context, gameID int64, imageKey string, variantWidths []int) error
+	ReplaceGameImageKey(ctx context.Context, gameID int64, newImageKey string, variantWidths []int) (oldImageKey string, err error)
 	DaleteGame(ctx context.Context, gameID int64) (*dto.DeletedGame, error)
-	UpdateGameStatus(ctx context.Context, gameID int64, newStatus game.GameStatusType) error
+	UpdateGameStatus(ctx context.Context, change dto.GameStatusChange) error
+	GetGameStatusHistory(ctx context.Context, gameID int64) ([]model.GameStatusHistoryEntry, error)
+	ModerateGame(ctx context.Context, moderation dto.GameModeration) error
+	ListPendingGames(ctx context.Context, limit uint32) ([]model.PendingGame, error)
+	SchedulePublish(ctx context.Context, gameID int64, publishAt *time.Time) error
+	PublishDueGames(ctx context.Context, now time.Time, limit uint32, check func(model.GameNoImageURL) error, actor string, requestID string) ([]int64, error)
+	ListDeletedGames(ctx context.Context, limit uint32) ([]model.DeletedGame, error)
+	RestoreGame(ctx context.Context, gameID int64, actor, requestID string) error
+	PurgeDeletedGames(ctx context.Context, deletedBefore time.Time, limit uint32) ([]dto.DeletedGame, error)
 }
 
 type TagRepository interface {
@@ -25,10 +40,11 @@ type TagRepository interface {
 
 type GenreRepository interface {
 	GetGenreByNames(ctx context.Context, genres []string) ([]model.Genre, error)
+	GetGenrePaths(ctx context.Context, genreIDs []int64) (map[int64]model.GenrePath, error)
 }
 
 type S3Storager interface {
-	SaveObject(ctx context.Context, name string, data io.Reader) (string, error)
+	SaveObject(ctx context.Context, name string, data io.Reader, contentType string) (string, error)
 	DeleteObject(ctx context.Context, name string) error
 	GeneratePresignedURL(ctx context.Context, objectName string) (string, error)
 }
@@ -39,6 +55,7 @@ type GameService struct {
 	tagReposetory   TagRepository
 	genreReposetory GenreRepository
 	s3Storager      S3Storager
+	statusMachine   *statusmachine.Machine
 }
 
 func NewGameService(
@@ -55,5 +72,6 @@ func NewGameService(
 		tagReposetory:   tagReposetory,
 		genreReposetory: genreReposetory,
 		gameRepository:  gameReposiroy,
+		statusMachine:   statusmachine.Default(),
 	}
 }
@@ -0,0 +1,164 @@
+package gameservice
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/sariya23/game_service/internal/lib/caller"
+	"github.com/sariya23/game_service/internal/lib/imaging"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/outerror"
+	minioclient "github.com/sariya23/game_service/internal/storage/s3/minio"
+)
+
+// SetGameCover загружает новую обложку игры или заменяет текущую.
+// Каждая загрузка получает свой ключ, поэтому из s3 удаляется только ключ,
+// который реально заменен в БД: на него больше никто не ссылается, и
+// параллельные замены не удаляют обложку друг друга.
+func (gameService *GameService) SetGameCover(
+	ctx context.Context,
+	gameID int64,
+	coverImage []byte,
+	contentType string,
+) error {
+	const operationPlace = "gameservice.SetGameCover"
+	log := gameService.log.With("operationPlace", operationPlace)
+	log = log.With("game_id", gameID)
+	log = logger.EnrichRequestID(ctx, log)
+	if caller.ID(ctx) == "" {
+		log.Warn("anonymous caller")
+		return fmt.Errorf("%s: %w", operationPlace, outerror.ErrCallerRequired)
+	}
+	_, err := gameService.gameRepository.GetGameByID(ctx, gameID)
+	if err != nil {
+		if errors.Is(err, outerror.ErrGameNotFound) {
+			log.Warn("game not found to set cover")
+			return fmt.Errorf("%s: %w", operationPlace, outerror.ErrGameNotFound)
+		}
+		log.Error("cannot get game to set cover", slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+
+	gameKey := minioclient.GameCoverKey(gameID, contentType)
+	imageKey, err := gameService.s3Storager.SaveObject(ctx, gameKey, bytes.NewReader(coverImage), contentType)
+	if err != nil {
+		log.Error("failed to save image",
+			slog.String("game_key", gameKey),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%s: %w", operationPlace, outerror.ErrCannotSaveGameImage)
+	}
+	variantWidths := gameService.saveCoverVariants(ctx, log, imageKey, coverImage)
+
+	// Ключ новый, поэтому при ошибке загруженный объект можно удалить.
+	oldImageKey, err := gameService.gameRepository.ReplaceGameImageKey(ctx, gameID, imageKey, variantWidths)
+	if err != nil {
+		gameService.deleteCover(ctx, log, imageKey)
+		if errors.Is(err, outerror.ErrGameNotFound) {
+			log.Warn("game deleted while setting cover", slog.String("image_key", imageKey))
+			return fmt.Errorf("%s: %w", operationPlace, outerror.ErrGameNotFound)
+		}
+		log.Error("cannot save new image key", slog.String("image_key", imageKey), slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	log.Info("game cover updated", slog.String("image_key", imageKey))
+
+	if oldImageKey != "" {
+		gameService.deleteCover(ctx, log, oldImageKey)
+	}
+	return nil
+}
+
+// RemoveGameCover убирает обложку у игры. Если обложки нет, ничего не делает.
+func (gameService *GameService) RemoveGameCover(
+	ctx context.Context,
+	gameID int64,
+) error {
+	const operationPlace = "gameservice.RemoveGameCover"
+	log := gameService.log.With("operationPlace", operationPlace)
+	log = log.With("game_id", gameID)
+	log = logger.EnrichRequestID(ctx, log)
+	if caller.ID(ctx) == "" {
+		log.Warn("anonymous caller")
+		return fmt.Errorf("%s: %w", operationPlace, outerror.ErrCallerRequired)
+	}
+	oldImageKey, err := gameService.gameRepository.ReplaceGameImageKey(ctx, gameID, "", nil)
+	if err != nil {
+		if errors.Is(err, outerror.ErrGameNotFound) {
+			log.Warn("game not found to remove cover")
+			return fmt.Errorf("%s: %w", operationPlace, outerror.ErrGameNotFound)
+		}
+		log.Error("cannot remove image key", slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if oldImageKey == "" {
+		log.Info("game has no image cover")
+		return nil
+	}
+	log.Info("game cover removed")
+
+	gameService.deleteCover(ctx, log, oldImageKey)
+	return nil
+}
+
+// deleteCover удаляет обложку и ее уменьшенные копии из s3. Ошибки только
+// логируются: в БД ключа уже нет, и в худшем случае в бакете останется лишний объект.
+func (gameService *GameService) deleteCover(ctx context.Context, log *slog.Logger, imageKey string) {
+	for _, width := range minioclient.CoverVariantWidths {
+		variantKey := minioclient.CoverVariantKey(imageKey, width)
+		if err := gameService.s3Storager.DeleteObject(ctx, variantKey); err != nil {
+			log.Warn("cannot delete image variant from s3", slog.String("image_key", variantKey), slog.String("error", err.Error()))
+		}
+	}
+	if err := gameService.s3Storager.DeleteObject(ctx, imageKey); err != nil {
+		log.Warn("cannot delete old image from s3", slog.String("image_key", imageKey), slog.String("error", err.Error()))
+		return
+	}
+	log.Info("old image deleted from s3", slog.String("image_key", imageKey))
+}
+
+// saveCoverVariants сохраняет уменьшенные копии обложки рядом с оригиналом
+// и возвращает ширины сохраненных копий. Копии не обязательны: если их нет,
+// клиент может загрузить оригинал, поэтому ошибка одной копии только
+// логируется и не мешает остальным.
+func (gameService *GameService) saveCoverVariants(ctx context.Context, log *slog.Logger, imageKey string, coverImage []byte) []int {
+	saved := make([]int, 0, len(minioclient.CoverVariantWidths))
+	for _, width := range minioclient.CoverVariantWidths {
+		variantKey := minioclient.CoverVariantKey(imageKey, width)
+		variant, contentType, err := imaging.ThumbnailBytes(coverImage, width)
+		if err != nil {
+			log.Warn("cannot resize image", slog.String("image_key", variantKey), slog.Int("width", width), slog.String("error", err.Error()))
+			continue
+		}
+		_, err = gameService.s3Storager.SaveObject(ctx, variantKey, bytes.NewReader(variant), contentType)
+		if err != nil {
+			log.Warn("cannot save image variant", slog.String("image_key", variantKey), slog.String("error", err.Error()))
+			continue
+		}
+		saved = append(saved, width)
+	}
+	if len(saved) != len(minioclient.CoverVariantWidths) {
+		log.Warn("some image variants are missing", slog.String("image_key", imageKey), slog.Int("saved", len(saved)), slog.Int("expected", len(minioclient.CoverVariantWidths)))
+	}
+	return saved
+}
+
+// coverVariantURLs генерирует ссылки на сохраненные уменьшенные копии обложки.
+func (gameService *GameService) coverVariantURLs(ctx context.Context, log *slog.Logger, imageKey string, variantWidths []int) map[int]string {
+	if imageKey == "" || len(variantWidths) == 0 {
+		return nil
+	}
+	urls := make(map[int]string, len(variantWidths))
+	for _, width := range variantWidths {
+		variantURL, err := gameService.s3Storager.GeneratePresignedURL(ctx, minioclient.CoverVariantKey(imageKey, width))
+		if err != nil {
+			log.Warn("failed to generate presigned URL for image variant", slog.Int("width", width), slog.String("error", err.Error()))
+			continue
+		}
+		urls[width] = variantURL
+	}
+	return urls
+}
@@ -0,0 +1,29 @@
+package gameservice
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/model"
+	"github.com/sariya23/game_service/internal/model/dto"
+)
+
+// GameFacets возвращает счетчики тэгов, жанров и годов для тех же
+// фильтров, что и GameList. Сортировка на счетчики не влияет.
+func (gameService *GameService) GameFacets(ctx context.Context, gameFilters dto.GameFilters) (model.GameFacets, error) {
+	const operationPlace = "gameservice.GameFacets"
+	log := gameService.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	gameFilters, err := normalizeGameFilters(ctx, gameFilters)
+	if err != nil {
+		log.Warn(fmt.Sprintf("invalid filters; err=%v", err))
+		return model.GameFacets{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	facets, err := gameService.gameRepository.GameFacets(ctx, gameFilters)
+	if err != nil {
+		log.Error(fmt.Sprintf("unexpected error; err=%v", err))
+		return model.GameFacets{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return facets, nil
+}
@@ -3,27 +3,72 @@ package gameservice
 import (
 	"context"
 	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
 
 	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/lib/pagetoken"
 	"github.com/sariya23/game_service/internal/model"
 	"github.com/sariya23/game_service/internal/model/dto"
+	"github.com/sariya23/game_service/internal/outerror"
+	minioclient "github.com/sariya23/game_service/internal/storage/s3/minio"
 )
 
+const (
+	DefaultGameListLimit uint32 = 10
+	MaxGameListLimit     uint32 = 100
+)
+
+// GameList возвращает страницу игр и токен следующей страницы.
+// Пустой pageToken - первая страница, пустой токен в ответе - страниц больше нет.
+// limit больше MaxGameListLimit обрезается до MaxGameListLimit.
 func (gameService *GameService) GameList(
 	ctx context.Context,
 	gameFilters dto.GameFilters,
 	limit uint32,
-) ([]model.ShortGame, error) {
+	pageToken string,
+) ([]model.ShortGame, string, error) {
 	const operationPlace = "gameservice.GetTopGames"
 	log := gameService.log.With("operationPlace", operationPlace)
 	log = logger.EnrichRequestID(ctx, log)
 	if limit == 0 {
-		limit = 10
+		limit = DefaultGameListLimit
+	}
+	limit = min(limit, MaxGameListLimit)
+	gameFilters, err := normalizeGameFilters(ctx, gameFilters)
+	if err != nil {
+		log.Warn(fmt.Sprintf("invalid filters; err=%v", err))
+		return nil, "", fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	switch {
+	case !gameFilters.Sort.Known():
+		log.Warn("unknown sort", slog.Int("sort", int(gameFilters.Sort)))
+		return nil, "", fmt.Errorf("%s: %w", operationPlace, outerror.ErrUnknownGameSort)
+	case gameFilters.Sort == dto.GameSortRelevance && gameFilters.Query == "":
+		log.Warn("relevance sort without query")
+		return nil, "", fmt.Errorf("%s: %w", operationPlace, outerror.ErrRelevanceSortWithoutQuery)
+	case gameFilters.Sort == dto.GameSortUnspecified && gameFilters.Query != "":
+		gameFilters.Sort = dto.GameSortRelevance
+	case gameFilters.Sort == dto.GameSortUnspecified:
+		gameFilters.Sort = dto.GameSortTitle
 	}
-	gamesNoImageURL, err := gameService.gameRepository.GameList(ctx, gameFilters, limit)
+	after, err := pagetoken.Decode(pageToken)
+	if err != nil {
+		log.Warn(fmt.Sprintf("cannot decode page token; err=%v", err))
+		return nil, "", fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	// Токен от одной выдачи нельзя применять к другой: после смены
+	// сортировки или фильтров строки пропускались бы или повторялись.
+	filtersHash := pagetoken.FiltersHash(gameFilters)
+	if after != nil && (after.Sort != gameFilters.Sort || after.Filters != filtersHash || (after.Rank == nil) != (gameFilters.Query == "")) {
+		log.Warn("page token does not match request")
+		return nil, "", fmt.Errorf("%s: %w", operationPlace, outerror.ErrInvalidPageToken)
+	}
+	gamesNoImageURL, next, err := gameService.gameRepository.GameList(ctx, gameFilters, limit, after)
 	if err != nil {
 		log.Error(fmt.Sprintf("unexpected error; err=%v", err))
-		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+		return nil, "", fmt.Errorf("%s: %w", operationPlace, err)
 	}
 
 	games := make([]model.ShortGame, 0, len(gamesNoImageURL))
@@ -33,8 +78,58 @@ func (gameService *GameService) GameList(
 			log.Warn(fmt.Sprintf("unexpected error while generate URL; err=%v", err))
 		}
 		shortGame := g.ToShortGame(imageURL)
+		// Если миниатюру сохранить не удалось, клиент получает оригинал.
+		shortGame.ThumbnailURL = imageURL
+		if g.ImageKey != "" && slices.Contains(g.ImageVariantWidths, minioclient.CoverVariantWidths[0]) {
+			thumbnailKey := minioclient.CoverVariantKey(g.ImageKey, minioclient.CoverVariantWidths[0])
+			thumbnailURL, err := gameService.s3Storager.GeneratePresignedURL(ctx, thumbnailKey)
+			if err != nil {
+				log.Warn(fmt.Sprintf("unexpected error while generate thumbnail URL; err=%v", err))
+			} else {
+				shortGame.ThumbnailURL = thumbnailURL
+			}
+		}
 		games = append(games, shortGame)
 	}
 
-	return games, nil
+	var nextPageToken string
+	if next != nil {
+		next.Filters = filtersHash
+		nextPageToken = pagetoken.Encode(*next)
+	}
+	return games, nextPageToken, nil
+}
+
+// normalizeGameFilters приводит фильтры GameList и GameFacets к виду,
+// который ожидает репозиторий, и ограничивает статусы видимыми вызывающему.
+func normalizeGameFilters(ctx context.Context, gameFilters dto.GameFilters) (dto.GameFilters, error) {
+	if !gameFilters.TagsMatch.Known() || !gameFilters.GenresMatch.Known() {
+		return gameFilters, outerror.ErrUnknownMatchMode
+	}
+	statuses, err := visibleStatuses(ctx, gameFilters.Statuses)
+	if err != nil {
+		return gameFilters, err
+	}
+	gameFilters.Statuses = statuses
+	gameFilters.Query = strings.TrimSpace(gameFilters.Query)
+	// MatchAll сравнивает число найденных значений с длиной списка.
+	gameFilters.Tags = uniqueNames(gameFilters.Tags)
+	gameFilters.Genres = uniqueNames(gameFilters.Genres)
+	return gameFilters, nil
+}
+
+func uniqueNames(names []string) []string {
+	if len(names) == 0 {
+		return names
+	}
+	seen := make(map[string]struct{}, len(names))
+	res := make([]string, 0, len(names))
+	for _, name := range names {
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+		res = append(res, name)
+	}
+	return res
 }
@@ -27,10 +27,26 @@ func (gameService *GameService) GetGame(
 		log.Error("unexpected error from repository", slog.String("error", err.Error()))
 		return nil, fmt.Errorf("%s: %w", operationPlace, err)
 	}
+	// Для публичного запроса неопубликованной игры как будто нет.
+	if !canSee(ctx, gameNoImageURL.GameStatus) {
+		log.Warn("game is not published", slog.Int64("game_id", gameID))
+		return nil, fmt.Errorf("%s: %w", operationPlace, outerror.ErrGameNotFound)
+	}
 	imageURL, err := gameService.s3Storager.GeneratePresignedURL(ctx, gameNoImageURL.ImageKey)
 	if err != nil {
 		log.Warn("failed to generate presigned URL for image", slog.String("error", err.Error()))
 	}
 	game := gameNoImageURL.ToDomain(imageURL)
+	game.ImageVariantURLs = gameService.coverVariantURLs(ctx, log, gameNoImageURL.ImageKey, gameNoImageURL.ImageVariantWidths)
+	if len(game.Genres) > 0 {
+		paths, err := gameService.genreReposetory.GetGenrePaths(ctx, model.GenreIDs(game.Genres))
+		if err != nil {
+			log.Error("cannot get genre paths", slog.String("error", err.Error()))
+			return nil, fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		for _, genre := range game.Genres {
+			game.GenrePaths = append(game.GenrePaths, paths[genre.GenreID])
+		}
+	}
 	return &game, nil
 }
@@ -0,0 +1,44 @@
+package gameservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/sariya23/game_service/internal/lib/caller"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/model"
+	"github.com/sariya23/game_service/internal/outerror"
+)
+
+// GetGameStatusHistory возвращает историю смен статуса игры. История
+// хранится и после удаления игры, поэтому ErrGameNotFound возвращается,
+// только если нет ни игры, ни истории.
+func (gameService *GameService) GetGameStatusHistory(ctx context.Context, gameID int64) ([]model.GameStatusHistoryEntry, error) {
+	const operationPlace = "gameservice.GetGameStatusHistory"
+	log := gameService.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	if !caller.IsPrivileged(ctx) {
+		log.Warn("caller is not a moderator", slog.String("role", caller.Role(ctx)))
+		return nil, fmt.Errorf("%s: %w", operationPlace, outerror.ErrModerationForbidden)
+	}
+	history, err := gameService.gameRepository.GetGameStatusHistory(ctx, gameID)
+	if err != nil {
+		log.Error(fmt.Sprintf("unexpected error; err=%v", err))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if len(history) > 0 {
+		return history, nil
+	}
+	_, err = gameService.gameRepository.GetGameByID(ctx, gameID)
+	if err != nil {
+		if errors.Is(err, outerror.ErrGameNotFound) {
+			log.Warn("game not found", slog.Int64("gameID", gameID))
+			return nil, fmt.Errorf("%s: %w", operationPlace, outerror.ErrGameNotFound)
+		}
+		log.Error(fmt.Sprintf("unexpected error; err=%v", err))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return history, nil
+}
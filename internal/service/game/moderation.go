@@ -0,0 +1,127 @@
+package gameservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/lib/caller"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/model"
+	"github.com/sariya23/game_service/internal/model/dto"
+	"github.com/sariya23/game_service/internal/outerror"
+)
+
+const (
+	DefaultPendingGamesLimit uint32 = 20
+	MaxPendingGamesLimit     uint32 = 100
+)
+
+// SubmitForReview отправляет черновик на модерацию: DRAFT -> PENDING.
+func (gameService *GameService) SubmitForReview(ctx context.Context, gameID int64) error {
+	const operationPlace = "gameservice.SubmitForReview"
+	return gameService.moderate(ctx, operationPlace, dto.GameModeration{
+		GameID:     gameID,
+		Decision:   model.ModerationSubmit,
+		FromStatus: game.GameStatusType_DRAFT,
+		ToStatus:   game.GameStatusType_PENDING,
+	}, false)
+}
+
+// ApproveGame публикует игру из очереди модерации: PENDING -> PUBLISH.
+func (gameService *GameService) ApproveGame(ctx context.Context, gameID int64) error {
+	const operationPlace = "gameservice.ApproveGame"
+	return gameService.moderate(ctx, operationPlace, dto.GameModeration{
+		GameID:     gameID,
+		Decision:   model.ModerationApprove,
+		FromStatus: game.GameStatusType_PENDING,
+		ToStatus:   game.GameStatusType_PUBLISH,
+	}, true)
+}
+
+// RejectGame возвращает игру из очереди модерации в черновики
+// с причиной отказа: PENDING -> DRAFT.
+func (gameService *GameService) RejectGame(ctx context.Context, gameID int64, reason string) error {
+	const operationPlace = "gameservice.RejectGame"
+	return gameService.moderate(ctx, operationPlace, dto.GameModeration{
+		GameID:     gameID,
+		Decision:   model.ModerationReject,
+		FromStatus: game.GameStatusType_PENDING,
+		ToStatus:   game.GameStatusType_DRAFT,
+		Reason:     strings.TrimSpace(reason),
+	}, true)
+}
+
+// ListPendingGames возвращает очередь модерации. Доступно только модераторам.
+func (gameService *GameService) ListPendingGames(ctx context.Context, limit uint32) ([]model.PendingGame, error) {
+	const operationPlace = "gameservice.ListPendingGames"
+	log := gameService.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	if !caller.IsPrivileged(ctx) {
+		log.Warn("caller is not a moderator", slog.String("role", caller.Role(ctx)))
+		return nil, fmt.Errorf("%s: %w", operationPlace, outerror.ErrModerationForbidden)
+	}
+	if limit == 0 {
+		limit = DefaultPendingGamesLimit
+	}
+	limit = min(limit, MaxPendingGamesLimit)
+	games, err := gameService.gameRepository.ListPendingGames(ctx, limit)
+	if err != nil {
+		log.Error(fmt.Sprintf("unexpected error; err=%v", err))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return games, nil
+}
+
+// moderate проверяет вызывающего и сохраняет решение. Решения approve
+// и reject принимают только модераторы.
+func (gameService *GameService) moderate(
+	ctx context.Context,
+	operationPlace string,
+	moderation dto.GameModeration,
+	moderatorOnly bool,
+) error {
+	log := gameService.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	moderation.Actor = caller.ID(ctx)
+	moderation.RequestID = caller.RequestID(ctx)
+	if moderation.Actor == "" {
+		log.Warn("anonymous moderation decision", slog.Int64("gameID", moderation.GameID))
+		return fmt.Errorf("%s: %w", operationPlace, outerror.ErrCallerRequired)
+	}
+	if moderatorOnly && !caller.IsPrivileged(ctx) {
+		log.Warn("caller is not a moderator", slog.String("actor", moderation.Actor), slog.String("role", caller.Role(ctx)))
+		return fmt.Errorf("%s: %w", operationPlace, outerror.ErrModerationForbidden)
+	}
+	gameNoImageURL, err := gameService.gameRepository.GetGameByID(ctx, moderation.GameID)
+	if err != nil {
+		if errors.Is(err, outerror.ErrGameNotFound) {
+			log.Warn("game not found", slog.Int64("gameID", moderation.GameID))
+			return fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		log.Error(fmt.Sprintf("unexpected error; err=%v", err))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if err = gameService.statusMachine.Check(moderation.FromStatus, moderation.ToStatus, *gameNoImageURL); err != nil {
+		log.Warn("transition is not allowed", slog.Int64("gameID", moderation.GameID), slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	err = gameService.gameRepository.ModerateGame(ctx, moderation)
+	if err != nil {
+		if errors.Is(err, outerror.ErrGameNotFound) || errors.Is(err, outerror.ErrInvalidNewGameStatus) {
+			log.Warn("cannot moderate game", slog.Int64("gameID", moderation.GameID), slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		log.Error(fmt.Sprintf("unexpected error; err=%v", err))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	log.Info("moderation decision saved",
+		slog.Int64("gameID", moderation.GameID),
+		slog.String("decision", string(moderation.Decision)),
+		slog.String("actor", moderation.Actor),
+	)
+	return nil
+}
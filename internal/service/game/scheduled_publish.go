@@ -0,0 +1,96 @@
+package gameservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/lib/caller"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/model"
+	"github.com/sariya23/game_service/internal/outerror"
+)
+
+// SchedulerActor - actor в истории статусов и в решениях модерации для
+// публикаций по расписанию.
+const SchedulerActor = "scheduler"
+
+// SchedulePublish ставит игру из PENDING на автоматическую публикацию в publishAt.
+// Нулевой publishAt снимает игру с расписания. Доступно только модераторам.
+func (gameService *GameService) SchedulePublish(ctx context.Context, gameID int64, publishAt time.Time) error {
+	const operationPlace = "gameservice.SchedulePublish"
+	log := gameService.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	if !caller.IsPrivileged(ctx) {
+		log.Warn("caller is not a moderator", slog.String("role", caller.Role(ctx)))
+		return fmt.Errorf("%s: %w", operationPlace, outerror.ErrModerationForbidden)
+	}
+	gameNoImageURL, err := gameService.gameRepository.GetGameByID(ctx, gameID)
+	if err != nil {
+		if errors.Is(err, outerror.ErrGameNotFound) {
+			log.Warn("game not found", slog.Int64("gameID", gameID))
+			return fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		log.Error(fmt.Sprintf("unexpected error; err=%v", err))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if gameNoImageURL.GameStatus != game.GameStatusType_PENDING {
+		log.Warn("game is not pending", slog.Int64("gameID", gameID), slog.Any("status", gameNoImageURL.GameStatus))
+		return fmt.Errorf("%s: %w", operationPlace, outerror.ErrGameNotPending)
+	}
+
+	var scheduledAt *time.Time
+	if !publishAt.IsZero() {
+		if !publishAt.After(time.Now()) {
+			log.Warn("publish time is in the past", slog.Time("publishAt", publishAt))
+			return fmt.Errorf("%s: %w", operationPlace, outerror.ErrPublishAtInPast)
+		}
+		// Guards проверяются сразу, чтобы не ждать отказа планировщика.
+		// В момент публикации они проверяются еще раз.
+		if err = gameService.statusMachine.Check(game.GameStatusType_PENDING, game.GameStatusType_PUBLISH, *gameNoImageURL); err != nil {
+			log.Warn("game cannot be published", slog.Int64("gameID", gameID), slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		scheduledAt = &publishAt
+	}
+
+	if err = gameService.gameRepository.SchedulePublish(ctx, gameID, scheduledAt); err != nil {
+		if errors.Is(err, outerror.ErrGameNotPending) {
+			log.Warn("game status changed concurrently", slog.Int64("gameID", gameID))
+			return fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		log.Error(fmt.Sprintf("unexpected error; err=%v", err))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	log.Info("publish scheduled", slog.Int64("gameID", gameID), slog.Any("publishAt", scheduledAt))
+	return nil
+}
+
+// PublishDueGames публикует до limit игр, у которых наступило время публикации.
+// Переход проверяется той же машиной состояний, что и в UpdateGameStatus.
+func (gameService *GameService) PublishDueGames(ctx context.Context, limit uint32) ([]int64, error) {
+	const operationPlace = "gameservice.PublishDueGames"
+	log := gameService.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	published, err := gameService.gameRepository.PublishDueGames(
+		ctx,
+		time.Now(),
+		limit,
+		func(gameNoImageURL model.GameNoImageURL) error {
+			return gameService.statusMachine.Check(gameNoImageURL.GameStatus, game.GameStatusType_PUBLISH, gameNoImageURL)
+		},
+		SchedulerActor,
+		caller.RequestID(ctx),
+	)
+	if err != nil {
+		log.Error(fmt.Sprintf("unexpected error; err=%v", err))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if len(published) > 0 {
+		log.Info("scheduled games published", slog.Any("gameIDs", published))
+	}
+	return published, nil
+}
@@ -0,0 +1,42 @@
+package gameservice
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/model"
+)
+
+const (
+	DefaultSuggestLimit = 5
+	MaxSuggestLimit     = 10
+)
+
+// SuggestGames возвращает подсказки для поисковой строки.
+// Лимит жестко ограничен MaxSuggestLimit, чтобы запрос оставался дешевым.
+func (gameService *GameService) SuggestGames(
+	ctx context.Context,
+	prefix string,
+	limit uint32,
+) ([]model.GameSuggestion, error) {
+	const operationPlace = "gameservice.SuggestGames"
+	log := gameService.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	if limit == 0 {
+		limit = DefaultSuggestLimit
+	}
+	limit = min(limit, MaxSuggestLimit)
+	statuses, err := visibleStatuses(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	suggestions, err := gameService.gameRepository.SuggestGames(ctx, strings.TrimSpace(prefix), limit, statuses)
+	if err != nil {
+		log.Error("cannot suggest games", slog.String("prefix", prefix), slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return suggestions, nil
+}
@@ -0,0 +1,111 @@
+package gameservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/sariya23/game_service/internal/lib/caller"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/model"
+	"github.com/sariya23/game_service/internal/model/dto"
+	"github.com/sariya23/game_service/internal/outerror"
+)
+
+// UpdateGame частично обновляет игру. Меняются только поля из UpdateMask,
+// game_id и обложка игры остаются прежними.
+func (gameService *GameService) UpdateGame(
+	ctx context.Context,
+	gameToUpdate dto.UpdateGameHandler,
+) error {
+	const operationPlace = "gameservice.UpdateGame"
+	log := gameService.log.With("operationPlace", operationPlace)
+	log = log.With("game_id", gameToUpdate.GameID)
+	log = logger.EnrichRequestID(ctx, log)
+	if caller.ID(ctx) == "" {
+		log.Warn("anonymous caller")
+		return fmt.Errorf("%s: %w", operationPlace, outerror.ErrCallerRequired)
+	}
+	currentGame, err := gameService.gameRepository.GetGameByID(ctx, gameToUpdate.GameID)
+	if err != nil {
+		if errors.Is(err, outerror.ErrGameNotFound) {
+			log.Warn("game to update not found")
+			return fmt.Errorf("%s: %w", operationPlace, outerror.ErrGameNotFound)
+		}
+		log.Error("cannot get game to update", slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+
+	updateGameService := dto.UpdateGameService{
+		GameID:      currentGame.GameID,
+		Title:       currentGame.Title,
+		Description: currentGame.Description,
+		ReleaseDate: currentGame.ReleaseDate,
+	}
+	mask := gameToUpdate.UpdateMask
+	if slices.Contains(mask, dto.UpdateGameFieldTitle) {
+		updateGameService.Title = gameToUpdate.Title
+	}
+	if slices.Contains(mask, dto.UpdateGameFieldDescription) {
+		updateGameService.Description = gameToUpdate.Description
+	}
+	if slices.Contains(mask, dto.UpdateGameFieldReleaseDate) {
+		updateGameService.ReleaseDate = gameToUpdate.ReleaseDate
+	}
+
+	if updateGameService.Title != currentGame.Title || updateGameService.ReleaseDate.Year() != currentGame.ReleaseDate.Year() {
+		sameGame, err := gameService.gameRepository.GetGameByTitleAndReleaseYear(ctx, updateGameService.Title, int32(updateGameService.ReleaseDate.Year()))
+		if err == nil && sameGame.GameID != currentGame.GameID {
+			log.Warn("game already exists", slog.String("title", updateGameService.Title), slog.String("release_date", updateGameService.ReleaseDate.String()))
+			return fmt.Errorf("%s: %w", operationPlace, outerror.ErrGameAlreadyExist)
+		} else if err != nil && !errors.Is(err, outerror.ErrGameNotFound) {
+			log.Error("unexpected error, cannot check game", slog.String("error", err.Error()))
+			return fmt.Errorf("%s: %w", operationPlace, err)
+		}
+	}
+
+	if slices.Contains(mask, dto.UpdateGameFieldTags) {
+		updateGameService.UpdateTags = true
+		if t := gameToUpdate.Tags; len(t) != 0 {
+			tags, err := gameService.tagReposetory.GetTagByNames(ctx, t)
+			if err != nil {
+				if errors.Is(err, outerror.ErrTagNotFound) {
+					log.Warn("tag doesnt exists", slog.Any("tags", t), slog.Any("unknown", outerror.UnknownNames(err)))
+					return fmt.Errorf("%s: %w", operationPlace, err)
+				}
+				log.Error("cannot check tags, unexpected error", slog.String("error", err.Error()))
+				return fmt.Errorf("%s: %w", operationPlace, err)
+			}
+			updateGameService.TagIDs = model.TagIDs(tags)
+		}
+	}
+	if slices.Contains(mask, dto.UpdateGameFieldGenres) {
+		updateGameService.UpdateGenres = true
+		if g := gameToUpdate.Genres; len(g) != 0 {
+			genres, err := gameService.genreReposetory.GetGenreByNames(ctx, g)
+			if err != nil {
+				if errors.Is(err, outerror.ErrGenreNotFound) {
+					log.Warn("genre doesnt exists", slog.Any("genres", g), slog.Any("unknown", outerror.UnknownNames(err)))
+					return fmt.Errorf("%s: %w", operationPlace, err)
+				}
+				log.Error("cannot check genres, unexpected error", slog.String("error", err.Error()))
+				return fmt.Errorf("%s: %w", operationPlace, err)
+			}
+			updateGameService.GenreIDs = model.GenreIDs(genres)
+		}
+	}
+
+	err = gameService.gameRepository.UpdateGame(ctx, updateGameService)
+	if err != nil {
+		if errors.Is(err, outerror.ErrGameNotFound) {
+			log.Warn("game deleted while updating")
+			return fmt.Errorf("%s: %w", operationPlace, outerror.ErrGameNotFound)
+		}
+		log.Error("unexpected error, cannot update game", slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	log.Info("game updated", slog.Any("update_mask", mask))
+	return nil
+}
@@ -7,19 +7,32 @@ import (
 	"log/slog"
 
 	game_api "github.com/sariya23/api_game_service/gen/game"
-	"github.com/sariya23/game_service/internal/lib/validators"
+	"github.com/sariya23/game_service/internal/lib/caller"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/model/dto"
 	"github.com/sariya23/game_service/internal/outerror"
 )
 
-// UpdateGameStatus меняет статус видимости игры.
+// UpdateGameStatus меняет статус видимости игры. Очередь модерации обходить
+// нельзя: в PENDING игра попадает только через SubmitForReview, а выводит ее
+// оттуда ApproveGame или RejectGame.
 func (gameService *GameService) UpdateGameStatus(ctx context.Context, gameID int64, newStatus game_api.GameStatusType) error {
 	const operationPlace = "gameservice.UpdateGameStatus"
 	log := gameService.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	if caller.ID(ctx) == "" {
+		log.Warn("anonymous caller")
+		return fmt.Errorf("%s: %w", operationPlace, outerror.ErrCallerRequired)
+	}
 
 	if _, ok := game_api.GameStatusType_name[int32(newStatus)]; !ok {
 		log.Warn("pass unknown status", slog.Int64("gameID", gameID), slog.Any("newStatus", newStatus))
 		return fmt.Errorf("%s: %w", operationPlace, outerror.ErrUnknownGameStatus)
 	}
+	if newStatus == game_api.GameStatusType_PENDING {
+		log.Warn("game must be submitted for review", slog.Int64("gameID", gameID))
+		return fmt.Errorf("%s: %w", operationPlace, outerror.ErrModerationRequired)
+	}
 
 	game, err := gameService.gameRepository.GetGameByID(ctx, gameID)
 	if err != nil {
@@ -30,13 +43,29 @@ func (gameService *GameService) UpdateGameStatus(ctx context.Context, gameID int
 		log.Error("cannot get game by id to set new status", slog.String("err", err.Error()))
 		return fmt.Errorf("%s: %w", operationPlace, err)
 	}
-	if valid := validators.GameStatus(game.GameStatus, newStatus); !valid {
-		log.Warn("cannot update status", slog.Int64("gameID", gameID), slog.Any("currentStatus", game.GameStatus), slog.Any("newStatus", newStatus))
-		return fmt.Errorf("%s: %w", operationPlace, outerror.ErrInvalidNewGameStatus)
+	if game.GameStatus == game_api.GameStatusType_PENDING {
+		log.Warn("pending game needs moderation decision", slog.Int64("gameID", gameID), slog.Any("newStatus", newStatus))
+		return fmt.Errorf("%s: %w", operationPlace, outerror.ErrModerationRequired)
+	}
+	if err = gameService.statusMachine.Check(game.GameStatus, newStatus, *game); err != nil {
+		log.Warn("cannot update status", slog.Int64("gameID", gameID), slog.Any("currentStatus", game.GameStatus), slog.Any("newStatus", newStatus), slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", operationPlace, err)
 	}
 
-	err = gameService.gameRepository.UpdateGameStatus(ctx, gameID, newStatus)
+	// Статус мог смениться после проверки перехода, поэтому репозиторий
+	// сверяет его еще раз под блокировкой.
+	err = gameService.gameRepository.UpdateGameStatus(ctx, dto.GameStatusChange{
+		GameID:         gameID,
+		ExpectedStatus: &game.GameStatus,
+		NewStatus:      newStatus,
+		Actor:          caller.ID(ctx),
+		RequestID:      caller.RequestID(ctx),
+	})
 	if err != nil {
+		if errors.Is(err, outerror.ErrGameNotFound) || errors.Is(err, outerror.ErrInvalidNewGameStatus) {
+			log.Warn("game status changed concurrently", slog.Int64("gameID", gameID))
+			return fmt.Errorf("%s: %w", operationPlace, err)
+		}
 		log.Error("cannot update game status", slog.Any("newStatus", newStatus), slog.Int64("gameID", gameID))
 		return fmt.Errorf("%s: %w", operationPlace, err)
 	}
@@ -0,0 +1,34 @@
+package gameservice
+
+import (
+	"context"
+
+	"github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/lib/caller"
+	"github.com/sariya23/game_service/internal/outerror"
+)
+
+// visibleStatuses возвращает статусы, которые может видеть вызывающий.
+// Публичный запрос видит только PUBLISH, привилегированный - любые
+// запрошенные статусы, а при пустом списке - все.
+func visibleStatuses(ctx context.Context, requested []game.GameStatusType) ([]game.GameStatusType, error) {
+	for _, s := range requested {
+		if _, ok := game.GameStatusType_name[int32(s)]; !ok {
+			return nil, outerror.ErrUnknownGameStatus
+		}
+	}
+	if caller.IsPrivileged(ctx) {
+		return requested, nil
+	}
+	for _, s := range requested {
+		if s != game.GameStatusType_PUBLISH {
+			return nil, outerror.ErrStatusFilterForbidden
+		}
+	}
+	return []game.GameStatusType{game.GameStatusType_PUBLISH}, nil
+}
+
+// canSee сообщает, может ли вызывающий видеть игру в статусе status.
+func canSee(ctx context.Context, status game.GameStatusType) bool {
+	return status == game.GameStatusType_PUBLISH || caller.IsPrivileged(ctx)
+}
@@ -0,0 +1,173 @@
+package genreservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/sariya23/game_service/internal/lib/caller"
+	"github.com/sariya23/game_service/internal/lib/ids"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/model"
+	"github.com/sariya23/game_service/internal/outerror"
+)
+
+type GenreRepository interface {
+	GetGenres(ctx context.Context) ([]model.Genre, error)
+	CreateGenre(ctx context.Context, genreName string) (model.Genre, error)
+	RenameGenre(ctx context.Context, genreID int64, genreName string) error
+	DeleteGenre(ctx context.Context, genreID int64, force bool) (int64, error)
+	MergeGenres(ctx context.Context, sourceIDs []int64, targetID int64) (int64, error)
+	SetGenreParent(ctx context.Context, genreID int64, parentID *int64) error
+}
+
+// GenreService управляет справочником жанров.
+type GenreService struct {
+	log             *slog.Logger
+	genreRepository GenreRepository
+}
+
+func NewGenreService(log *slog.Logger, genreRepository GenreRepository) *GenreService {
+	return &GenreService{
+		log:             log,
+		genreRepository: genreRepository,
+	}
+}
+
+// ListGenres возвращает все жанры.
+func (s *GenreService) ListGenres(ctx context.Context) ([]model.Genre, error) {
+	const operationPlace = "genreservice.ListGenres"
+	log := s.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	genres, err := s.genreRepository.GetGenres(ctx)
+	if err != nil {
+		log.Error(fmt.Sprintf("unexpected error; err=%v", err))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return genres, nil
+}
+
+// CreateGenre создает жанр. Доступно только модераторам.
+func (s *GenreService) CreateGenre(ctx context.Context, genreName string) (model.Genre, error) {
+	const operationPlace = "genreservice.CreateGenre"
+	log := s.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	if !caller.IsPrivileged(ctx) {
+		log.Warn("caller is not a moderator", slog.String("role", caller.Role(ctx)))
+		return model.Genre{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrModerationForbidden)
+	}
+	genre, err := s.genreRepository.CreateGenre(ctx, strings.TrimSpace(genreName))
+	if err != nil {
+		if errors.Is(err, outerror.ErrGenreAlreadyExist) {
+			log.Warn("genre already exists", slog.String("genreName", genreName))
+			return model.Genre{}, fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		log.Error(fmt.Sprintf("unexpected error; err=%v", err))
+		return model.Genre{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	log.Info("genre created", slog.Int64("genreID", genre.GenreID), slog.String("genreName", genre.GenreName))
+	return genre, nil
+}
+
+// RenameGenre меняет имя жанра. Доступно только модераторам.
+func (s *GenreService) RenameGenre(ctx context.Context, genreID int64, genreName string) error {
+	const operationPlace = "genreservice.RenameGenre"
+	log := s.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	if !caller.IsPrivileged(ctx) {
+		log.Warn("caller is not a moderator", slog.String("role", caller.Role(ctx)))
+		return fmt.Errorf("%s: %w", operationPlace, outerror.ErrModerationForbidden)
+	}
+	err := s.genreRepository.RenameGenre(ctx, genreID, strings.TrimSpace(genreName))
+	if err != nil {
+		if errors.Is(err, outerror.ErrGenreNotFound) || errors.Is(err, outerror.ErrGenreAlreadyExist) {
+			log.Warn("cannot rename genre", slog.Int64("genreID", genreID), slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		log.Error(fmt.Sprintf("unexpected error; err=%v", err))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	log.Info("genre renamed", slog.Int64("genreID", genreID), slog.String("genreName", genreName))
+	return nil
+}
+
+// DeleteGenre удаляет жанр. Жанр, привязанный к играм, удаляется только с force,
+// тогда он отвязывается от всех игр. Доступно только модераторам.
+func (s *GenreService) DeleteGenre(ctx context.Context, genreID int64, force bool) error {
+	const operationPlace = "genreservice.DeleteGenre"
+	log := s.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	if !caller.IsPrivileged(ctx) {
+		log.Warn("caller is not a moderator", slog.String("role", caller.Role(ctx)))
+		return fmt.Errorf("%s: %w", operationPlace, outerror.ErrModerationForbidden)
+	}
+	unlinked, err := s.genreRepository.DeleteGenre(ctx, genreID, force)
+	if err != nil {
+		if errors.Is(err, outerror.ErrGenreNotFound) || errors.Is(err, outerror.ErrGenreInUse) {
+			log.Warn("cannot delete genre", slog.Int64("genreID", genreID), slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		log.Error(fmt.Sprintf("unexpected error; err=%v", err))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	log.Info("genre deleted", slog.Int64("genreID", genreID), slog.Int64("unlinkedGames", unlinked))
+	return nil
+}
+
+// MergeGenres сливает дубли sourceIDs в targetID. Игры исходных жанров получают
+// целевой, а старые имена продолжают находить его как алиасы. Доступно только модераторам.
+func (s *GenreService) MergeGenres(ctx context.Context, sourceIDs []int64, targetID int64) error {
+	const operationPlace = "genreservice.MergeGenres"
+	log := s.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	if !caller.IsPrivileged(ctx) {
+		log.Warn("caller is not a moderator", slog.String("role", caller.Role(ctx)))
+		return fmt.Errorf("%s: %w", operationPlace, outerror.ErrModerationForbidden)
+	}
+	if slices.Contains(sourceIDs, targetID) {
+		log.Warn("cannot merge genre into itself", slog.Any("sourceIDs", sourceIDs), slog.Int64("targetID", targetID))
+		return fmt.Errorf("%s: %w", operationPlace, outerror.ErrMergeIntoItself)
+	}
+	sourceIDs = ids.Unique(sourceIDs)
+	relinked, err := s.genreRepository.MergeGenres(ctx, sourceIDs, targetID)
+	if err != nil {
+		if errors.Is(err, outerror.ErrGenreNotFound) || errors.Is(err, outerror.ErrGenreCycle) {
+			log.Warn("cannot merge genres", slog.Any("sourceIDs", sourceIDs), slog.Int64("targetID", targetID), slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		log.Error(fmt.Sprintf("unexpected error; err=%v", err))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	log.Info("genres merged", slog.Any("sourceIDs", sourceIDs), slog.Int64("targetID", targetID), slog.Int64("relinkedGames", relinked))
+	return nil
+}
+
+// SetGenreParent вкладывает жанр в parentID, нулевой parentID делает жанр корневым.
+// Фильтр по родителю в GameList находит игры и всех его поджанров. Доступно только модераторам.
+func (s *GenreService) SetGenreParent(ctx context.Context, genreID int64, parentID int64) error {
+	const operationPlace = "genreservice.SetGenreParent"
+	log := s.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	if !caller.IsPrivileged(ctx) {
+		log.Warn("caller is not a moderator", slog.String("role", caller.Role(ctx)))
+		return fmt.Errorf("%s: %w", operationPlace, outerror.ErrModerationForbidden)
+	}
+	var parent *int64
+	if parentID != 0 {
+		parent = &parentID
+	}
+	err := s.genreRepository.SetGenreParent(ctx, genreID, parent)
+	if err != nil {
+		if errors.Is(err, outerror.ErrGenreNotFound) || errors.Is(err, outerror.ErrGenreCycle) {
+			log.Warn("cannot set genre parent", slog.Int64("genreID", genreID), slog.Int64("parentID", parentID), slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		log.Error(fmt.Sprintf("unexpected error; err=%v", err))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	log.Info("genre parent set", slog.Int64("genreID", genreID), slog.Int64("parentID", parentID))
+	return nil
+}
@@ -0,0 +1,94 @@
+package outboxservice
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/sariya23/game_service/internal/events"
+	"github.com/sariya23/game_service/internal/lib/backoff"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/model"
+)
+
+const (
+	retryBaseDelay = time.Second
+	retryMaxDelay  = 10 * time.Minute
+)
+
+type OutboxRepository interface {
+	RelayBatch(
+		ctx context.Context,
+		limit uint32,
+		maxAttempts int,
+		publish func(model.OutboxEvent) error,
+		retryDelay func(attempt int) time.Duration,
+	) (published int, failed int, dead []model.OutboxEvent, err error)
+}
+
+// Relay доставляет события из outbox через Publisher.
+type Relay struct {
+	log              *slog.Logger
+	outboxRepository OutboxRepository
+	publisher        events.Publisher
+	batchSize        uint32
+	maxAttempts      int
+	publishTimeout   time.Duration
+}
+
+func NewRelay(
+	log *slog.Logger,
+	outboxRepository OutboxRepository,
+	publisher events.Publisher,
+	batchSize uint32,
+	maxAttempts int,
+	publishTimeout time.Duration,
+) *Relay {
+	return &Relay{
+		log:              log,
+		outboxRepository: outboxRepository,
+		publisher:        publisher,
+		batchSize:        batchSize,
+		maxAttempts:      maxAttempts,
+		publishTimeout:   publishTimeout,
+	}
+}
+
+// RelayOnce доставляет одну пачку событий. Неудачные попытки повторяются
+// с экспоненциальной задержкой до maxAttempts раз, после чего событие
+// считается мертвым. Каждая публикация ограничена publishTimeout, потому что
+// идет внутри открытой транзакции relay и держит блокировки outbox.
+func (r *Relay) RelayOnce(ctx context.Context) error {
+	const operationPlace = "outboxservice.RelayOnce"
+	log := r.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	published, failed, dead, err := r.outboxRepository.RelayBatch(
+		ctx,
+		r.batchSize,
+		r.maxAttempts,
+		func(event model.OutboxEvent) error {
+			publishCtx, cancel := context.WithTimeout(ctx, r.publishTimeout)
+			defer cancel()
+			return r.publisher.Publish(publishCtx, event)
+		},
+		func(attempt int) time.Duration {
+			return backoff.Exponential(attempt, retryBaseDelay, retryMaxDelay)
+		},
+	)
+	if err != nil {
+		log.Error(fmt.Sprintf("unexpected error; err=%v", err))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if len(dead) > 0 {
+		deadIDs := make([]int64, 0, len(dead))
+		for _, event := range dead {
+			deadIDs = append(deadIDs, event.OutboxID)
+		}
+		log.Error("outbox events exceeded max attempts", slog.Int("dead", len(dead)), slog.Any("outboxIDs", deadIDs))
+	}
+	if published > 0 || failed > 0 {
+		log.Info("outbox relayed", slog.Int("published", published), slog.Int("failed", failed), slog.Int("dead", len(dead)))
+	}
+	return nil
+}
@@ -0,0 +1,300 @@
+package outboxservice
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/sariya23/game_service/internal/events"
+	"github.com/sariya23/game_service/internal/interceptors"
+	"github.com/sariya23/game_service/internal/lib/backoff"
+	"github.com/sariya23/game_service/internal/lib/caller"
+	"github.com/sariya23/game_service/internal/lib/generate"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/model"
+	"github.com/sariya23/game_service/internal/outerror"
+)
+
+const (
+	watchReplayPageSize  uint32 = 500
+	watchBufferSize             = 256
+	listenRetryBaseDelay        = time.Second
+	listenRetryMaxDelay         = 30 * time.Second
+
+	// lateCommitGrace - сколько событие может ждать коммита после выдачи
+	// outbox_id. События младше этого срока перечитываются при
+	// возобновлении, даже если их outbox_id меньше курсора.
+	lateCommitGrace = time.Minute
+)
+
+type EventsRepository interface {
+	ListEventsAfter(ctx context.Context, afterID int64, limit uint32) ([]model.OutboxEvent, error)
+	ListRecentEvents(ctx context.Context, afterID, beforeID int64, createdSince time.Time, limit uint32) ([]model.OutboxEvent, error)
+	LastEventID(ctx context.Context) (int64, error)
+	ListenEvents(ctx context.Context, onConnected func(), onNotify func(outboxID int64)) error
+}
+
+// Watcher слушает NOTIFY о новых событиях outbox и раздает их подписчикам
+// WatchGames этого процесса. Так события, записанные любой репликой,
+// доходят до клиентов всех реплик.
+type Watcher struct {
+	log              *slog.Logger
+	eventsRepository EventsRepository
+	broker           *events.Broker
+
+	// lastID и initialized меняются только в горутине Run.
+	lastID      int64
+	initialized bool
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewWatcher(log *slog.Logger, eventsRepository EventsRepository) *Watcher {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Watcher{
+		log:              log,
+		eventsRepository: eventsRepository,
+		broker:           events.NewBroker(),
+		ctx:              ctx,
+		cancel:           cancel,
+		done:             make(chan struct{}),
+	}
+}
+
+// Run держит LISTEN и переподключается с экспоненциальной задержкой.
+// После переподключения догоняет события, пропущенные за время обрыва.
+// Блокируется до вызова Stop.
+func (w *Watcher) Run() {
+	const operationPlace = "outboxservice.Watcher.Run"
+	defer close(w.done)
+	ctx := context.WithValue(w.ctx, interceptors.RequestIDKey, generate.GenerateRequestID())
+	log := w.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	var attempt int
+	for {
+		err := w.eventsRepository.ListenEvents(
+			ctx,
+			func() {
+				attempt = 0
+				w.onConnected(ctx, log)
+			},
+			func(outboxID int64) {
+				w.onNotify(ctx, log, outboxID)
+			},
+		)
+		if ctx.Err() != nil {
+			log.Info("watcher stopped")
+			return
+		}
+		attempt++
+		delay := backoff.Exponential(attempt, listenRetryBaseDelay, listenRetryMaxDelay)
+		log.Warn("listen connection lost", slog.Duration("retryIn", delay), slog.String("err", err.Error()))
+		select {
+		case <-ctx.Done():
+			log.Info("watcher stopped")
+			return
+		case <-time.After(delay):
+		}
+	}
+}
+
+// Stop останавливает Run и ждет его завершения.
+func (w *Watcher) Stop() {
+	w.cancel()
+	<-w.done
+}
+
+// WatchGames отдает в send события с outbox_id больше afterSeq: сначала
+// из таблицы, затем по мере появления. outbox_id выдается до коммита,
+// поэтому при возобновлении заново читаются и события не старше
+// lateCommitGrace с outbox_id меньше afterSeq: среди них могут быть
+// закоммиченные после отключения клиента. Доставка - минимум один раз,
+// клиент отбрасывает повторы по OutboxID и переподключается с наибольшим
+// полученным. Вызывающему без привилегий отдаются только события
+// опубликованных игр. Возвращается при ошибке send, отмене ctx или
+// ErrWatchLagged, если клиент не успевает читать.
+func (w *Watcher) WatchGames(ctx context.Context, afterSeq int64, send func(model.OutboxEvent) error) error {
+	const operationPlace = "outboxservice.WatchGames"
+	log := w.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	// Подписка до чтения таблицы, чтобы не потерять события между ними.
+	sub := w.broker.Subscribe(watchBufferSize)
+	defer sub.Close()
+
+	stream := newWatchStream(send, !caller.IsPrivileged(ctx))
+	if afterSeq > 0 {
+		createdSince := time.Now().Add(-lateCommitGrace)
+		lastRead := int64(0)
+		for {
+			page, err := w.eventsRepository.ListRecentEvents(ctx, lastRead, afterSeq, createdSince, watchReplayPageSize)
+			if err != nil {
+				log.Error(fmt.Sprintf("cannot replay recent events; err=%v", err))
+				return fmt.Errorf("%s: %w", operationPlace, err)
+			}
+			for _, event := range page {
+				if err = stream.send(event); err != nil {
+					return fmt.Errorf("%s: %w", operationPlace, err)
+				}
+				lastRead = event.OutboxID
+			}
+			if uint32(len(page)) < watchReplayPageSize {
+				break
+			}
+		}
+	}
+	lastRead := afterSeq
+	for {
+		page, err := w.eventsRepository.ListEventsAfter(ctx, lastRead, watchReplayPageSize)
+		if err != nil {
+			log.Error(fmt.Sprintf("cannot replay events; err=%v", err))
+			return fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		for _, event := range page {
+			if err = stream.send(event); err != nil {
+				return fmt.Errorf("%s: %w", operationPlace, err)
+			}
+			lastRead = event.OutboxID
+		}
+		if uint32(len(page)) < watchReplayPageSize {
+			break
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%s: %w", operationPlace, ctx.Err())
+		case event, ok := <-sub.Events():
+			if !ok {
+				log.Warn("watcher is lagging", slog.Int64("lastRead", lastRead))
+				return fmt.Errorf("%s: %w", operationPlace, outerror.ErrWatchLagged)
+			}
+			if err := stream.send(event); err != nil {
+				return fmt.Errorf("%s: %w", operationPlace, err)
+			}
+			lastRead = max(lastRead, event.OutboxID)
+		}
+	}
+}
+
+// watchStream отбрасывает повторы и события, скрытые от вызывающего.
+// Событие может прийти и из таблицы, и из подписки, поэтому id запоминаются.
+// Повтор возможен только для событий не старше lateCommitGrace, поэтому
+// старые id не запоминаются и забываются, чтобы память не росла
+// с длительностью подписки.
+type watchStream struct {
+	deliver    func(model.OutboxEvent) error
+	publicOnly bool
+	seen       map[int64]time.Time
+	prunedAt   time.Time
+}
+
+func newWatchStream(deliver func(model.OutboxEvent) error, publicOnly bool) *watchStream {
+	return &watchStream{
+		deliver:    deliver,
+		publicOnly: publicOnly,
+		seen:       make(map[int64]time.Time),
+		prunedAt:   time.Now(),
+	}
+}
+
+func (s *watchStream) send(event model.OutboxEvent) error {
+	if _, ok := s.seen[event.OutboxID]; ok {
+		return nil
+	}
+	now := time.Now()
+	if now.Sub(s.prunedAt) >= lateCommitGrace {
+		for id, createdAt := range s.seen {
+			if now.Sub(createdAt) > 2*lateCommitGrace {
+				delete(s.seen, id)
+			}
+		}
+		s.prunedAt = now
+	}
+	if now.Sub(event.CreatedAt) <= 2*lateCommitGrace {
+		s.seen[event.OutboxID] = event.CreatedAt
+	}
+	if s.publicOnly && !events.Public(event) {
+		return nil
+	}
+	return s.deliver(event)
+}
+
+func (w *Watcher) onConnected(ctx context.Context, log *slog.Logger) {
+	if w.initialized {
+		w.catchUpLate(ctx, log)
+		w.catchUp(ctx, log)
+		return
+	}
+	lastID, err := w.eventsRepository.LastEventID(ctx)
+	if err != nil {
+		log.Error("cannot get last event id", slog.String("err", err.Error()))
+		return
+	}
+	w.lastID = lastID
+	w.initialized = true
+}
+
+// onNotify раздает новое событие. outbox_id выдается до коммита, поэтому
+// уведомление может прийти с id меньше уже разосланного - тогда событие
+// читается отдельно.
+func (w *Watcher) onNotify(ctx context.Context, log *slog.Logger, outboxID int64) {
+	if !w.initialized {
+		w.onConnected(ctx, log)
+		return
+	}
+	if outboxID > w.lastID {
+		w.catchUp(ctx, log)
+		return
+	}
+	late, err := w.eventsRepository.ListEventsAfter(ctx, outboxID-1, 1)
+	if err != nil {
+		log.Error("cannot get late event", slog.Int64("outboxID", outboxID), slog.String("err", err.Error()))
+		return
+	}
+	if len(late) == 1 && late[0].OutboxID == outboxID {
+		w.broker.Publish(late[0])
+	}
+}
+
+func (w *Watcher) catchUp(ctx context.Context, log *slog.Logger) {
+	for {
+		page, err := w.eventsRepository.ListEventsAfter(ctx, w.lastID, watchReplayPageSize)
+		if err != nil {
+			log.Error("cannot get new events", slog.String("err", err.Error()))
+			return
+		}
+		for _, event := range page {
+			w.broker.Publish(event)
+			w.lastID = event.OutboxID
+		}
+		if uint32(len(page)) < watchReplayPageSize {
+			return
+		}
+	}
+}
+
+// catchUpLate раздает недавние события с outbox_id меньше lastID: пока
+// соединения не было, они могли закоммититься без уведомления для Watcher.
+// Подписчики отбрасывают уже полученные.
+func (w *Watcher) catchUpLate(ctx context.Context, log *slog.Logger) {
+	createdSince := time.Now().Add(-lateCommitGrace)
+	var lastRead int64
+	for {
+		page, err := w.eventsRepository.ListRecentEvents(ctx, lastRead, w.lastID, createdSince, watchReplayPageSize)
+		if err != nil {
+			log.Error("cannot get recent events", slog.String("err", err.Error()))
+			return
+		}
+		for _, event := range page {
+			w.broker.Publish(event)
+			lastRead = event.OutboxID
+		}
+		if uint32(len(page)) < watchReplayPageSize {
+			return
+		}
+	}
+}
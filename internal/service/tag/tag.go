@@ -0,0 +1,145 @@
+package tagservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/sariya23/game_service/internal/lib/caller"
+	"github.com/sariya23/game_service/internal/lib/ids"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/model"
+	"github.com/sariya23/game_service/internal/outerror"
+)
+
+type TagRepository interface {
+	GetTags(ctx context.Context) ([]model.Tag, error)
+	CreateTag(ctx context.Context, tagName string) (model.Tag, error)
+	RenameTag(ctx context.Context, tagID int64, tagName string) error
+	DeleteTag(ctx context.Context, tagID int64, force bool) (int64, error)
+	MergeTags(ctx context.Context, sourceIDs []int64, targetID int64) (int64, error)
+}
+
+// TagService управляет справочником тэгов.
+type TagService struct {
+	log           *slog.Logger
+	tagRepository TagRepository
+}
+
+func NewTagService(log *slog.Logger, tagRepository TagRepository) *TagService {
+	return &TagService{
+		log:           log,
+		tagRepository: tagRepository,
+	}
+}
+
+// ListTags возвращает все тэги.
+func (s *TagService) ListTags(ctx context.Context) ([]model.Tag, error) {
+	const operationPlace = "tagservice.ListTags"
+	log := s.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	tags, err := s.tagRepository.GetTags(ctx)
+	if err != nil {
+		log.Error(fmt.Sprintf("unexpected error; err=%v", err))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return tags, nil
+}
+
+// CreateTag создает тэг. Доступно только модераторам.
+func (s *TagService) CreateTag(ctx context.Context, tagName string) (model.Tag, error) {
+	const operationPlace = "tagservice.CreateTag"
+	log := s.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	if !caller.IsPrivileged(ctx) {
+		log.Warn("caller is not a moderator", slog.String("role", caller.Role(ctx)))
+		return model.Tag{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrModerationForbidden)
+	}
+	tag, err := s.tagRepository.CreateTag(ctx, strings.TrimSpace(tagName))
+	if err != nil {
+		if errors.Is(err, outerror.ErrTagAlreadyExist) {
+			log.Warn("tag already exists", slog.String("tagName", tagName))
+			return model.Tag{}, fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		log.Error(fmt.Sprintf("unexpected error; err=%v", err))
+		return model.Tag{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	log.Info("tag created", slog.Int64("tagID", tag.TagID), slog.String("tagName", tag.TagName))
+	return tag, nil
+}
+
+// RenameTag меняет имя тэга. Доступно только модераторам.
+func (s *TagService) RenameTag(ctx context.Context, tagID int64, tagName string) error {
+	const operationPlace = "tagservice.RenameTag"
+	log := s.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	if !caller.IsPrivileged(ctx) {
+		log.Warn("caller is not a moderator", slog.String("role", caller.Role(ctx)))
+		return fmt.Errorf("%s: %w", operationPlace, outerror.ErrModerationForbidden)
+	}
+	err := s.tagRepository.RenameTag(ctx, tagID, strings.TrimSpace(tagName))
+	if err != nil {
+		if errors.Is(err, outerror.ErrTagNotFound) || errors.Is(err, outerror.ErrTagAlreadyExist) {
+			log.Warn("cannot rename tag", slog.Int64("tagID", tagID), slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		log.Error(fmt.Sprintf("unexpected error; err=%v", err))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	log.Info("tag renamed", slog.Int64("tagID", tagID), slog.String("tagName", tagName))
+	return nil
+}
+
+// DeleteTag удаляет тэг. Тэг, привязанный к играм, удаляется только с force,
+// тогда он отвязывается от всех игр. Доступно только модераторам.
+func (s *TagService) DeleteTag(ctx context.Context, tagID int64, force bool) error {
+	const operationPlace = "tagservice.DeleteTag"
+	log := s.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	if !caller.IsPrivileged(ctx) {
+		log.Warn("caller is not a moderator", slog.String("role", caller.Role(ctx)))
+		return fmt.Errorf("%s: %w", operationPlace, outerror.ErrModerationForbidden)
+	}
+	unlinked, err := s.tagRepository.DeleteTag(ctx, tagID, force)
+	if err != nil {
+		if errors.Is(err, outerror.ErrTagNotFound) || errors.Is(err, outerror.ErrTagInUse) {
+			log.Warn("cannot delete tag", slog.Int64("tagID", tagID), slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		log.Error(fmt.Sprintf("unexpected error; err=%v", err))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	log.Info("tag deleted", slog.Int64("tagID", tagID), slog.Int64("unlinkedGames", unlinked))
+	return nil
+}
+
+// MergeTags сливает дубли sourceIDs в targetID. Игры исходных тэгов получают
+// целевой, а старые имена продолжают находить его как алиасы. Доступно только модераторам.
+func (s *TagService) MergeTags(ctx context.Context, sourceIDs []int64, targetID int64) error {
+	const operationPlace = "tagservice.MergeTags"
+	log := s.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	if !caller.IsPrivileged(ctx) {
+		log.Warn("caller is not a moderator", slog.String("role", caller.Role(ctx)))
+		return fmt.Errorf("%s: %w", operationPlace, outerror.ErrModerationForbidden)
+	}
+	if slices.Contains(sourceIDs, targetID) {
+		log.Warn("cannot merge tag into itself", slog.Any("sourceIDs", sourceIDs), slog.Int64("targetID", targetID))
+		return fmt.Errorf("%s: %w", operationPlace, outerror.ErrMergeIntoItself)
+	}
+	sourceIDs = ids.Unique(sourceIDs)
+	relinked, err := s.tagRepository.MergeTags(ctx, sourceIDs, targetID)
+	if err != nil {
+		if errors.Is(err, outerror.ErrTagNotFound) {
+			log.Warn("cannot merge tags", slog.Any("sourceIDs", sourceIDs), slog.Int64("targetID", targetID), slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		log.Error(fmt.Sprintf("unexpected error; err=%v", err))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	log.Info("tags merged", slog.Any("sourceIDs", sourceIDs), slog.Int64("targetID", targetID), slog.Int64("relinkedGames", relinked))
+	return nil
+}
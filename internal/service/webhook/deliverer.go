@@ -0,0 +1,105 @@
+package webhookservice
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/sariya23/game_service/internal/lib/backoff"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/model"
+	"github.com/sariya23/game_service/internal/model/dto"
+)
+
+const (
+	retryBaseDelay = 5 * time.Second
+	retryMaxDelay  = time.Hour
+)
+
+type Sender interface {
+	Send(ctx context.Context, delivery model.WebhookDelivery) (int, error)
+}
+
+// Deliverer отправляет события из очереди доставки в webhook.
+type Deliverer struct {
+	log               *slog.Logger
+	webhookRepository WebhookRepository
+	sender            Sender
+	batchSize         uint32
+	maxAttempts       int
+	lease             time.Duration
+}
+
+// NewDeliverer создает доставщика. timeout - таймаут одного запроса,
+// пока он не истек, доставка не будет выдана другому воркеру.
+func NewDeliverer(log *slog.Logger, webhookRepository WebhookRepository, sender Sender, batchSize uint32, maxAttempts int, timeout time.Duration) *Deliverer {
+	return &Deliverer{
+		log:               log,
+		webhookRepository: webhookRepository,
+		sender:            sender,
+		batchSize:         batchSize,
+		maxAttempts:       maxAttempts,
+		lease:             time.Duration(batchSize)*timeout + time.Minute,
+	}
+}
+
+// DeliverOnce отправляет одну пачку доставок и записывает каждую попытку.
+// Неудачные доставки повторяются с экспоненциальной задержкой,
+// после maxAttempts попыток доставка помечается failed.
+func (d *Deliverer) DeliverOnce(ctx context.Context) error {
+	const operationPlace = "webhookservice.DeliverOnce"
+	log := d.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	deliveries, err := d.webhookRepository.ClaimDeliveries(ctx, d.batchSize, d.lease)
+	if err != nil {
+		log.Error(fmt.Sprintf("unexpected error; err=%v", err))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	var delivered, failed int
+	for _, delivery := range deliveries {
+		attempt := d.deliver(ctx, delivery)
+		if attempt.Delivered {
+			delivered++
+		} else {
+			failed++
+			log.Warn("webhook delivery failed",
+				slog.Int64("deliveryID", delivery.DeliveryID),
+				slog.Int64("webhookID", delivery.WebhookID),
+				slog.Int("attempt", attempt.Attempt),
+				slog.Bool("giveUp", attempt.GiveUp),
+				slog.String("err", attempt.Error),
+			)
+		}
+		if err := d.webhookRepository.RecordAttempt(ctx, attempt); err != nil {
+			log.Error(fmt.Sprintf("unexpected error; err=%v", err))
+			return fmt.Errorf("%s: %w", operationPlace, err)
+		}
+	}
+	if delivered > 0 || failed > 0 {
+		log.Info("webhooks delivered", slog.Int("delivered", delivered), slog.Int("failed", failed))
+	}
+	return nil
+}
+
+func (d *Deliverer) deliver(ctx context.Context, delivery model.WebhookDelivery) dto.WebhookAttempt {
+	attempt := dto.WebhookAttempt{
+		DeliveryID: delivery.DeliveryID,
+		Attempt:    delivery.Attempts + 1,
+	}
+	start := time.Now()
+	statusCode, err := d.sender.Send(ctx, delivery)
+	attempt.Duration = time.Since(start)
+	attempt.StatusCode = statusCode
+	if err == nil {
+		attempt.Delivered = true
+		return attempt
+	}
+	attempt.Error = err.Error()
+	if attempt.Attempt >= d.maxAttempts {
+		attempt.GiveUp = true
+		return attempt
+	}
+	attempt.NextAttemptAt = time.Now().Add(backoff.Exponential(attempt.Attempt, retryBaseDelay, retryMaxDelay))
+	return attempt
+}
@@ -0,0 +1,146 @@
+package webhookservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/sariya23/game_service/internal/events"
+	"github.com/sariya23/game_service/internal/lib/caller"
+	"github.com/sariya23/game_service/internal/lib/generate"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/lib/validators"
+	"github.com/sariya23/game_service/internal/model"
+	"github.com/sariya23/game_service/internal/model/dto"
+	"github.com/sariya23/game_service/internal/outerror"
+)
+
+type WebhookRepository interface {
+	CreateWebhook(ctx context.Context, webhook model.Webhook) (model.Webhook, error)
+	ListWebhooks(ctx context.Context) ([]model.Webhook, error)
+	DeleteWebhook(ctx context.Context, webhookID int64) error
+	EnqueueDeliveries(ctx context.Context, event model.OutboxEvent) (int64, error)
+	ClaimDeliveries(ctx context.Context, limit uint32, lease time.Duration) ([]model.WebhookDelivery, error)
+	RecordAttempt(ctx context.Context, attempt dto.WebhookAttempt) error
+}
+
+// URLChecker проверяет, что адрес webhook не ведет во внутреннюю сеть.
+type URLChecker interface {
+	CheckURL(ctx context.Context, rawURL string) error
+}
+
+// WebhookService управляет подписками партнеров на события игр.
+type WebhookService struct {
+	log               *slog.Logger
+	webhookRepository WebhookRepository
+	urlChecker        URLChecker
+}
+
+func NewWebhookService(log *slog.Logger, webhookRepository WebhookRepository, urlChecker URLChecker) *WebhookService {
+	return &WebhookService{
+		log:               log,
+		webhookRepository: webhookRepository,
+		urlChecker:        urlChecker,
+	}
+}
+
+// CreateWebhook создает подписку. Если секрет не передан, он генерируется
+// и возвращается только в ответе на создание. Адрес должен разрешаться
+// только в публичные IP. Доступно только модераторам.
+func (s *WebhookService) CreateWebhook(ctx context.Context, url string, secret string, eventTypes []string) (model.Webhook, error) {
+	const operationPlace = "webhookservice.CreateWebhook"
+	log := s.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	if !caller.IsPrivileged(ctx) {
+		log.Warn("caller is not a moderator", slog.String("role", caller.Role(ctx)))
+		return model.Webhook{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrModerationForbidden)
+	}
+	url = strings.TrimSpace(url)
+	if valid, msg := validators.CreateWebhook(url, eventTypes); !valid {
+		log.Warn("invalid webhook", slog.String("msg", msg))
+		return model.Webhook{}, fmt.Errorf("%s: %s: %w", operationPlace, msg, outerror.ErrInvalidWebhook)
+	}
+	if err := s.urlChecker.CheckURL(ctx, url); err != nil {
+		log.Warn("webhook url is not allowed", slog.String("url", url), slog.String("err", err.Error()))
+		return model.Webhook{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if secret == "" {
+		secret = generate.GenerateWebhookSecret()
+	}
+	webhook, err := s.webhookRepository.CreateWebhook(ctx, model.Webhook{
+		URL:        url,
+		Secret:     secret,
+		EventTypes: eventTypes,
+		CreatedBy:  caller.ID(ctx),
+	})
+	if err != nil {
+		log.Error(fmt.Sprintf("unexpected error; err=%v", err))
+		return model.Webhook{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	log.Info("webhook created", slog.Int64("webhookID", webhook.WebhookID))
+	return webhook, nil
+}
+
+// ListWebhooks возвращает подписки без секретов. Доступно только модераторам.
+func (s *WebhookService) ListWebhooks(ctx context.Context) ([]model.Webhook, error) {
+	const operationPlace = "webhookservice.ListWebhooks"
+	log := s.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	if !caller.IsPrivileged(ctx) {
+		log.Warn("caller is not a moderator", slog.String("role", caller.Role(ctx)))
+		return nil, fmt.Errorf("%s: %w", operationPlace, outerror.ErrModerationForbidden)
+	}
+	webhooks, err := s.webhookRepository.ListWebhooks(ctx)
+	if err != nil {
+		log.Error(fmt.Sprintf("unexpected error; err=%v", err))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return webhooks, nil
+}
+
+// DeleteWebhook удаляет подписку вместе с недоставленными событиями.
+// Доступно только модераторам.
+func (s *WebhookService) DeleteWebhook(ctx context.Context, webhookID int64) error {
+	const operationPlace = "webhookservice.DeleteWebhook"
+	log := s.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	if !caller.IsPrivileged(ctx) {
+		log.Warn("caller is not a moderator", slog.String("role", caller.Role(ctx)))
+		return fmt.Errorf("%s: %w", operationPlace, outerror.ErrModerationForbidden)
+	}
+	err := s.webhookRepository.DeleteWebhook(ctx, webhookID)
+	if err != nil {
+		if errors.Is(err, outerror.ErrWebhookNotFound) {
+			log.Warn("webhook not found", slog.Int64("webhookID", webhookID))
+			return fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		log.Error(fmt.Sprintf("unexpected error; err=%v", err))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	log.Info("webhook deleted", slog.Int64("webhookID", webhookID))
+	return nil
+}
+
+// Publish ставит событие в очередь доставки подписанным webhook.
+// Партнеры получают только события опубликованных игр.
+// Подключается к relay outbox как events.Publisher.
+func (s *WebhookService) Publish(ctx context.Context, event model.OutboxEvent) error {
+	const operationPlace = "webhookservice.Publish"
+	log := s.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	if !events.Public(event) {
+		return nil
+	}
+	enqueued, err := s.webhookRepository.EnqueueDeliveries(ctx, event)
+	if err != nil {
+		log.Error(fmt.Sprintf("unexpected error; err=%v", err))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if enqueued > 0 {
+		log.Info("webhook deliveries enqueued", slog.Int64("outboxID", event.OutboxID), slog.Int64("enqueued", enqueued))
+	}
+	return nil
+}
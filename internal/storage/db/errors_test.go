@@ -0,0 +1,18 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsUniqueViolation(t *testing.T) {
+	t.Parallel()
+	assert.True(t, IsUniqueViolation(fmt.Errorf("qwe: %w", &pgconn.PgError{Code: "23505"})))
+	assert.False(t, IsUniqueViolation(&pgconn.PgError{Code: "23503"}))
+	assert.False(t, IsUniqueViolation(errors.New("some error")))
+	assert.False(t, IsUniqueViolation(nil))
+}
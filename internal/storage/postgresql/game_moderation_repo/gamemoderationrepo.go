@@ -0,0 +1,11 @@
+package gamemoderationrepo
+
+const (
+	GameModerationTable                     = "game_moderation"
+	GameModerationGameModerationIDFieldName = "game_moderation_id"
+	GameModerationGameIDFieldName           = "game_id"
+	GameModerationDecisionFieldName         = "decision"
+	GameModerationReasonFieldName           = "reason"
+	GameModerationActorFieldName            = "actor"
+	GameModerationCreatedAtFieldName        = "created_at"
+)
@@ -0,0 +1,12 @@
+package gamestatushistoryrepo
+
+const (
+	GameStatusHistoryTable                        = "game_status_history"
+	GameStatusHistoryGameStatusHistoryIDFieldName = "game_status_history_id"
+	GameStatusHistoryGameIDFieldName              = "game_id"
+	GameStatusHistoryOldStatusIDFieldName         = "old_status_id"
+	GameStatusHistoryNewStatusIDFieldName         = "new_status_id"
+	GameStatusHistoryActorFieldName               = "actor"
+	GameStatusHistoryRequestIDFieldName           = "request_id"
+	GameStatusHistoryCreatedAtFieldName           = "created_at"
+)
@@ -0,0 +1,44 @@
+package gamestatusrepo
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/model"
+)
+
+// GetGameStatuses возвращает все строки справочника game_status.
+func (gs *GameStatusRepository) GetGameStatuses(ctx context.Context) ([]model.GameStatus, error) {
+	const operationPlace = "postgresql.GetGameStatuses"
+	log := gs.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+
+	getStatusesQuery := fmt.Sprintf("select %s, %s from %s order by %s",
+		GameStatusGameStatusIDFieldName,
+		GameStatusGameNameFieldName,
+		GameStatusTable,
+		GameStatusGameStatusIDFieldName,
+	)
+	rows, err := gs.conn.GetPool().Query(ctx, getStatusesQuery)
+	if err != nil {
+		log.Error("cannot get game statuses", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer rows.Close()
+	var statuses []model.GameStatus
+	for rows.Next() {
+		var gameStatus model.GameStatus
+		if err = rows.Scan(&gameStatus.ID, &gameStatus.Name); err != nil {
+			log.Error("cannot scan game status", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		statuses = append(statuses, gameStatus)
+	}
+	if err = rows.Err(); err != nil {
+		log.Error("cannot prepare next row", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return statuses, nil
+}
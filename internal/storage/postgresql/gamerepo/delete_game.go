@@ -2,30 +2,53 @@ package gamerepo
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"log/slog"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/events"
 	"github.com/sariya23/game_service/internal/lib/logger"
 	"github.com/sariya23/game_service/internal/model/dto"
 	"github.com/sariya23/game_service/internal/outerror"
 )
 
+// DaleteGame мягко удаляет игру: проставляет deleted_at и пишет GameDeleted
+// в outbox. Связи с тэгами и жанрами и обложка остаются до очистки,
+// чтобы игру можно было восстановить.
 func (gr *GameRepository) DaleteGame(ctx context.Context, gameID int64) (*dto.DeletedGame, error) {
 	const operationPlace = "postgresql.DeleteGame"
 	log := gr.log.With("operationPlace", operationPlace)
 	log = logger.EnrichRequestID(ctx, log)
 	deleteGameQuery := fmt.Sprintf(
-		"delete from game where %s=$1 returning %s, extract(year from %s), %s",
+		"update game set %s=now() where %s=$1 and %s is null returning %s, extract(year from %s), %s, %s, %s",
+		GameDeletedAtFieldName,
 		GameGameIDFieldName,
+		GameDeletedAtFieldName,
 		GameGameIDFieldName,
 		GameReleaseDateFieldName,
 		GameTitleFieldName,
+		GameImageKeyFieldName,
+		GameGameStatusIDFieldName,
 	)
+	tx, err := gr.conn.GetPool().Begin(ctx)
+	if err != nil {
+		log.Error(fmt.Sprintf("cannot start transaction, unexpected error = %v", err))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			log.Error("cannot rollback transaction, unexpected error", slog.String("error", rollbackErr.Error()))
+		}
+	}()
 	var deltedGameInfo dto.DeletedGame
-	deleteGameRow := gr.conn.GetPool().QueryRow(ctx, deleteGameQuery, gameID)
-	err := deleteGameRow.Scan(&deltedGameInfo.GameID, &deltedGameInfo.ReleaseYear, &deltedGameInfo.Title)
+	var imageKey sql.NullString
+	var gameStatus game.GameStatusType
+	deleteGameRow := tx.QueryRow(ctx, deleteGameQuery, gameID)
+	err = deleteGameRow.Scan(&deltedGameInfo.GameID, &deltedGameInfo.ReleaseYear, &deltedGameInfo.Title, &imageKey, &gameStatus)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			log.Warn("cannot delete game because it is not found", slog.Int("gameID", int(gameID)))
@@ -34,6 +57,19 @@ func (gr *GameRepository) DaleteGame(ctx context.Context, gameID int64) (*dto.De
 		log.Error("cannot delete game", slog.Any("gameID", gameID), slog.String("err", err.Error()))
 		return nil, fmt.Errorf("%s: %w", operationPlace, err)
 	}
+	deltedGameInfo.ImageKey = imageKey.String
+	event, err := events.NewGameDeleted(deltedGameInfo.GameID, deltedGameInfo.Title, deltedGameInfo.ReleaseYear, gameStatus)
+	if err == nil {
+		err = insertOutboxEventTx(ctx, tx, event)
+	}
+	if err != nil {
+		log.Error("cannot save GameDeleted event", slog.Int64("gameID", gameID), slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		log.Error(fmt.Sprintf("cannot commit transaction, unexpected error = %v", err))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
 	log.Info("game deleted successfully", slog.Int("gameID", int(gameID)))
 	return &deltedGameInfo, nil
 }
@@ -0,0 +1,99 @@
+package gamerepo
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/model"
+	"github.com/sariya23/game_service/internal/model/dto"
+)
+
+// GameFacets считает, сколько игр подходит под каждый тэг, жанр и год
+// при текущих фильтрах. Фильтр измерения при подсчете его фасета
+// не применяется, иначе в панели остались бы только выбранные значения.
+func (gr *GameRepository) GameFacets(ctx context.Context, filters dto.GameFilters) (model.GameFacets, error) {
+	const operationPlace = "postgresql.gamerepo.GameFacets"
+	log := gr.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	var facets model.GameFacets
+	var err error
+	facets.Tags, err = gr.linkFacet(ctx, filters, facetTags, gameTagLink)
+	if err != nil {
+		log.Error("cannot count tag facet", slog.String("err", err.Error()))
+		return model.GameFacets{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	facets.Genres, err = gr.linkFacet(ctx, filters, facetGenres, gameGenreLink)
+	if err != nil {
+		log.Error("cannot count genre facet", slog.String("err", err.Error()))
+		return model.GameFacets{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	facets.Years, err = gr.yearFacet(ctx, filters)
+	if err != nil {
+		log.Error("cannot count year facet", slog.String("err", err.Error()))
+		return model.GameFacets{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return facets, nil
+}
+
+func (gr *GameRepository) linkFacet(ctx context.Context, filters dto.GameFilters, facet gameFacet, link gameLink) ([]model.FacetCount, error) {
+	whereQuery, args, _ := gameFiltersWhere(filters, facet)
+	query := fmt.Sprintf(`
+	select %s, count(*)
+	from %s join %s using(%s)
+	where %s in (select %s from game where true%s)
+	group by %s
+	order by count(*) desc, %s`,
+		link.name,
+		link.linkTable,
+		link.table,
+		link.linkID,
+		link.linkGameID,
+		GameGameIDFieldName,
+		whereQuery,
+		link.name,
+		link.name,
+	)
+	rows, err := gr.conn.GetPool().Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var counts []model.FacetCount
+	for rows.Next() {
+		var count model.FacetCount
+		if err = rows.Scan(&count.Value, &count.Count); err != nil {
+			return nil, err
+		}
+		counts = append(counts, count)
+	}
+	return counts, rows.Err()
+}
+
+func (gr *GameRepository) yearFacet(ctx context.Context, filters dto.GameFilters) ([]model.YearFacetCount, error) {
+	whereQuery, args, _ := gameFiltersWhere(filters, facetYears)
+	query := fmt.Sprintf(`
+	select extract(year from %s)::int as release_year, count(*)
+	from game
+	where true%s
+	group by release_year
+	order by release_year desc`,
+		GameReleaseDateFieldName,
+		whereQuery,
+	)
+	rows, err := gr.conn.GetPool().Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var counts []model.YearFacetCount
+	for rows.Next() {
+		var count model.YearFacetCount
+		if err = rows.Scan(&count.Year, &count.Count); err != nil {
+			return nil, err
+		}
+		counts = append(counts, count)
+	}
+	return counts, rows.Err()
+}
@@ -0,0 +1,162 @@
+package gamerepo
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/model/dto"
+	gamegenrerepo "github.com/sariya23/game_service/internal/storage/postgresql/game_genre_repo"
+	gametagrepo "github.com/sariya23/game_service/internal/storage/postgresql/game_tag_repo"
+	"github.com/sariya23/game_service/internal/storage/postgresql/genrerepo"
+	"github.com/sariya23/game_service/internal/storage/postgresql/tagrepo"
+)
+
+// gameFacet - измерение фасетного поиска. Фильтр по своему измерению
+// в подсчете фасета не участвует.
+type gameFacet int
+
+const (
+	facetNone gameFacet = iota
+	facetTags
+	facetGenres
+	facetYears
+)
+
+// gameFiltersWhere собирает условия для "from game where true" по фильтрам
+// GameList, кроме фильтра измерения skip. Удаленные игры отсекаются всегда. rankExpr - выражение ts_rank,
+// пустое без поиска.
+func gameFiltersWhere(filters dto.GameFilters, skip gameFacet) (whereQuery string, args []interface{}, rankExpr string) {
+	args = []interface{}{}
+	whereQuery = fmt.Sprintf(" and %s is null", GameDeletedAtFieldName)
+	var linkQuery string
+	if skip != facetTags {
+		linkQuery, args = gameLinkFilter(args, gameTagLink, filters.Tags, filters.TagsMatch, false)
+		whereQuery = whereQuery + linkQuery
+	}
+	if skip != facetGenres {
+		linkQuery, args = gameLinkFilter(args, gameGenreLink, filters.Genres, filters.GenresMatch, false)
+		whereQuery = whereQuery + linkQuery
+	}
+	linkQuery, args = gameLinkFilter(args, gameTagLink, filters.ExcludeTags, dto.MatchAny, true)
+	whereQuery = whereQuery + linkQuery
+	linkQuery, args = gameLinkFilter(args, gameGenreLink, filters.ExcludeGenres, dto.MatchAny, true)
+	whereQuery = whereQuery + linkQuery
+	// Год и десятилетие - полуинтервалы дат, чтобы сравнение шло
+	// по release_date и использовало индекс.
+	if filters.ReleaseYear > 0 && skip != facetYears {
+		from := time.Date(int(filters.ReleaseYear), time.January, 1, 0, 0, 0, 0, time.UTC)
+		args = append(args, from, from.AddDate(1, 0, 0))
+		whereQuery = whereQuery + fmt.Sprintf(" and %s >= $%d and %s < $%d", GameReleaseDateFieldName, len(args)-1, GameReleaseDateFieldName, len(args))
+	}
+	if filters.Decade > 0 {
+		from := time.Date(int(filters.Decade), time.January, 1, 0, 0, 0, 0, time.UTC)
+		args = append(args, from, from.AddDate(10, 0, 0))
+		whereQuery = whereQuery + fmt.Sprintf(" and %s >= $%d and %s < $%d", GameReleaseDateFieldName, len(args)-1, GameReleaseDateFieldName, len(args))
+	}
+	if !filters.ReleasedAfter.IsZero() {
+		args = append(args, filters.ReleasedAfter)
+		whereQuery = whereQuery + fmt.Sprintf(" and %s >= $%d", GameReleaseDateFieldName, len(args))
+	}
+	if !filters.ReleasedBefore.IsZero() {
+		args = append(args, filters.ReleasedBefore)
+		whereQuery = whereQuery + fmt.Sprintf(" and %s <= $%d", GameReleaseDateFieldName, len(args))
+	}
+	if filters.UpcomingOnly {
+		whereQuery = whereQuery + fmt.Sprintf(" and %s > current_date", GameReleaseDateFieldName)
+	}
+	if len(filters.Statuses) > 0 {
+		args = append(args, statusIDs(filters.Statuses))
+		whereQuery = whereQuery + fmt.Sprintf(" and %s = any($%d)", GameGameStatusIDFieldName, len(args))
+	}
+	if filters.Query != "" {
+		args = append(args, filters.Query)
+		tsQuery := searchTSQuery(len(args))
+		rankExpr = fmt.Sprintf("ts_rank(%s, %s)", GameSearchVectorFieldName, tsQuery)
+		whereQuery = whereQuery + fmt.Sprintf(" and %s @@ %s", GameSearchVectorFieldName, tsQuery)
+	}
+	return whereQuery, args, rankExpr
+}
+
+func statusIDs(statuses []game.GameStatusType) []int32 {
+	ids := make([]int32, 0, len(statuses))
+	for _, s := range statuses {
+		ids = append(ids, int32(s))
+	}
+	return ids
+}
+
+// gameLink - связь игры с тэгами или жанрами. Имена в фильтре сравниваются
+// по normalize_name и ищутся также среди алиасов. Если задан parentID,
+// имя в фильтре захватывает и всех потомков по дереву.
+type gameLink struct {
+	linkTable, linkGameID, linkID string
+	table, name, normalizedName   string
+	aliasTable, aliasName         string
+	parentID                      string
+}
+
+var (
+	gameTagLink = gameLink{
+		linkTable:      "game_tag",
+		linkGameID:     gametagrepo.GameTagGameIDFieldName,
+		linkID:         gametagrepo.GameTagTagIDFieldName,
+		table:          "tag",
+		name:           tagrepo.TagTagNameFieldName,
+		normalizedName: tagrepo.TagNormalizedNameFieldName,
+		aliasTable:     "tag_alias",
+		aliasName:      tagrepo.TagAliasNormalizedNameFieldName,
+	}
+	gameGenreLink = gameLink{
+		linkTable:      "game_genre",
+		linkGameID:     gamegenrerepo.GameGenreGameIDFieldName,
+		linkID:         gamegenrerepo.GameGenreGenreIDFieldName,
+		table:          "genre",
+		name:           genrerepo.GenreGenreNameFieldName,
+		normalizedName: genrerepo.GenreNormalizedNameFieldName,
+		aliasTable:     "genre_alias",
+		aliasName:      genrerepo.GenreAliasNormalizedNameFieldName,
+		parentID:       genrerepo.GenreParentGenreIDFieldName,
+	}
+)
+
+// gameLinkFilter - условие на тэги или жанры игры. CTE matched сопоставляет
+// каждому имени из фильтра тэг или жанр (с потомками, если есть дерево), запомнив
+// исходное имя. Повторы имен с точностью до регистра схлопываются. Для MatchAll
+// у игры должно быть совпадение по каждому имени. exclude отбрасывает игры
+// хотя бы с одним из имен.
+func gameLinkFilter(args []interface{}, link gameLink, names []string, mode dto.MatchMode, exclude bool) (string, []interface{}) {
+	if len(names) == 0 {
+		return "", args
+	}
+	args = append(args, names)
+	subQuery := fmt.Sprintf(`with recursive wanted(root_name) as (
+		select distinct normalize_name(n) from unnest($%d::varchar[]) as n
+	), matched(root_name, %s) as (
+		select w.root_name, coalesce(
+			(select %s from %s where %s = w.root_name),
+			(select %s from %s where %s = w.root_name)
+		) from wanted w`,
+		len(args),
+		link.linkID,
+		link.linkID, link.table, link.normalizedName,
+		link.linkID, link.aliasTable, link.aliasName,
+	)
+	if link.parentID != "" {
+		subQuery = subQuery + fmt.Sprintf(`
+		union
+		select m.root_name, t.%s from %s t join matched m on t.%s = m.%s`,
+			link.linkID, link.table, link.parentID, link.linkID,
+		)
+	}
+	subQuery = subQuery + fmt.Sprintf(`
+	) select %s from %s join matched using(%s)`, link.linkGameID, link.linkTable, link.linkID)
+	if mode == dto.MatchAll {
+		subQuery = subQuery + fmt.Sprintf(" group by %s having count(distinct root_name) = (select count(*) from wanted)", link.linkGameID)
+	}
+	op := "in"
+	if exclude {
+		op = "not in"
+	}
+	return fmt.Sprintf(" and %s %s (%s)", GameGameIDFieldName, op, subQuery), args
+}
@@ -8,77 +8,103 @@ import (
 	"github.com/sariya23/game_service/internal/lib/logger"
 	"github.com/sariya23/game_service/internal/model"
 	"github.com/sariya23/game_service/internal/model/dto"
-	gamegenrerepo "github.com/sariya23/game_service/internal/storage/postgresql/game_genre_repo"
-	gametagrepo "github.com/sariya23/game_service/internal/storage/postgresql/game_tag_repo"
-	"github.com/sariya23/game_service/internal/storage/postgresql/genrerepo"
-	"github.com/sariya23/game_service/internal/storage/postgresql/tagrepo"
+	"github.com/sariya23/game_service/internal/outerror"
 )
 
-func (gr *GameRepository) GameList(ctx context.Context, filters dto.GameFilters, limit uint32) ([]model.ShortGameNoImageURL, error) {
+// GameList возвращает страницу игр после курсора after (nil - первая страница).
+// Вторым значением возвращается курсор следующей страницы или nil, если
+// страница последняя.
+func (gr *GameRepository) GameList(
+	ctx context.Context,
+	filters dto.GameFilters,
+	limit uint32,
+	after *dto.GameListCursor,
+) ([]model.ShortGameNoImageURL, *dto.GameListCursor, error) {
 	const operationPlace = "postgresql.GetTopGames"
 	log := gr.log.With("operationPlave", operationPlace)
 	log = logger.EnrichRequestID(ctx, log)
-	baseQuery := fmt.Sprintf("select %s, %s, %s, %s, %s from game where true",
-		GameGameIDFieldName,
-		GameTitleFieldName,
-		GameDescriptionFieldName,
-		GameReleaseDateFieldName,
-		GameImageKeyFieldName,
-	)
-	args := []interface{}{}
-	if len(filters.Tags) > 0 {
-		args = append(args, filters.Tags)
-		baseQuery = baseQuery + fmt.Sprintf(" and %s in (select %s from game_tag join tag using(%s) where %s=any($%d))",
-			GameGameIDFieldName,
-			gametagrepo.GameTagGameIDFieldName,
-			gametagrepo.GameTagTagIDFieldName,
-			tagrepo.TagTagNameFieldName,
-			len(args))
+	whereQuery, args, rankExpr := gameFiltersWhere(filters, facetNone)
+	rankSelect := ""
+	if rankExpr != "" {
+		rankSelect = ", " + rankExpr
 	}
-	if len(filters.Genres) > 0 {
-		args = append(args, filters.Genres)
-		baseQuery = baseQuery + fmt.Sprintf(" and %s in (select %s from game_genre join genre using(%s) where %s=any($%d))",
-			GameGameIDFieldName,
-			gamegenrerepo.GameGenreGameIDFieldName,
-			gamegenrerepo.GameGenreGenreIDFieldName,
-			genrerepo.GenreGenreNameFieldName,
-			len(args))
+	order, ok := gameListOrderFor(filters.Sort, rankExpr)
+	if !ok {
+		log.Error("unsupported sort", slog.Int("sort", int(filters.Sort)))
+		return nil, nil, fmt.Errorf("%s: %w", operationPlace, outerror.ErrUnknownGameSort)
 	}
-	if filters.ReleaseYear > 0 {
-		args = append(args, filters.ReleaseYear)
-		baseQuery = baseQuery + fmt.Sprintf(" and extract(year from %s) = $%d", GameReleaseDateFieldName, len(args))
+	if after != nil {
+		var keyset string
+		keyset, args = order.keyset(*after, args)
+		whereQuery = whereQuery + " and " + keyset
 	}
-	baseQuery = baseQuery + fmt.Sprintf(" order by %s, %s limit %d",
+	// Берем на одну строку больше, чтобы понять, есть ли следующая страница.
+	query := fmt.Sprintf("select %s, %s, %s, %s, %s, %s%s from game where true%s order by %s limit %d",
+		GameGameIDFieldName,
 		GameTitleFieldName,
+		GameDescriptionFieldName,
 		GameReleaseDateFieldName,
-		limit,
+		GameImageKeyFieldName,
+		GameImageVariantWidthsFieldName,
+		rankSelect,
+		whereQuery,
+		order.orderBy(),
+		limit+1,
 	)
 	var games []model.ShortGameNoImageURL
-	gameRows, err := gr.conn.GetPool().Query(ctx, baseQuery, args...)
+	var ranks []float32
+	gameRows, err := gr.conn.GetPool().Query(ctx, query, args...)
 	if err != nil {
 		log.Error("cannot execute query to get games", slog.String("err", err.Error()))
-		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+		return nil, nil, fmt.Errorf("%s: %w", operationPlace, err)
 	}
 	defer gameRows.Close()
 	for gameRows.Next() {
 		var gameDB dto.ShortGameDB
-		err = gameRows.Scan(
+		var rank float32
+		dest := []any{
 			&gameDB.GameID,
 			&gameDB.Title,
 			&gameDB.Description,
 			&gameDB.ReleaseDate,
 			&gameDB.ImageKey,
-		)
+			&gameDB.ImageVariantWidths,
+		}
+		if rankSelect != "" {
+			dest = append(dest, &rank)
+		}
+		err = gameRows.Scan(dest...)
 		if err != nil {
 			log.Error("cannot scan game id", slog.String("err", err.Error()))
-			return nil, fmt.Errorf("%s: %w", operationPlace, err)
-		}
-		if gameRows.Err() != nil {
-			log.Error("cannot prepare next row", slog.String("err", gameRows.Err().Error()))
-			return nil, fmt.Errorf("%s: %w", operationPlace, err)
+			return nil, nil, fmt.Errorf("%s: %w", operationPlace, err)
 		}
 		shortGameNoImageURL := gameDB.ToShortGameNoImageURL()
 		games = append(games, shortGameNoImageURL)
+		ranks = append(ranks, rank)
+	}
+	if err = gameRows.Err(); err != nil {
+		log.Error("cannot prepare next row", slog.String("err", err.Error()))
+		return nil, nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if len(games) <= int(limit) {
+		return games, nil, nil
 	}
-	return games, nil
+	games = games[:limit]
+	last := games[len(games)-1]
+	next := &dto.GameListCursor{
+		Sort:        filters.Sort,
+		Title:       last.Title,
+		ReleaseDate: last.ReleaseDate,
+		GameID:      last.GameID,
+	}
+	if rankSelect != "" {
+		next.Rank = &ranks[len(games)-1]
+	}
+	return games, next, nil
+}
+
+// searchTSQuery - запрос для search_vector из аргумента $argNum. Строка
+// разбирается в обеих конфигурациях, чтобы находились и "ведьмак", и "witcher".
+func searchTSQuery(argNum int) string {
+	return fmt.Sprintf("(websearch_to_tsquery('russian', $%d) || websearch_to_tsquery('english', $%d))", argNum, argNum)
 }
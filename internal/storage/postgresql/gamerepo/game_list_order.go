@@ -0,0 +1,91 @@
+package gamerepo
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sariya23/game_service/internal/model/dto"
+)
+
+// gameListOrder - разрешенный порядок выдачи GameList. Все колонки идут
+// в одном направлении и заканчиваются game_id, поэтому keyset записывается
+// сравнением строк и использует индекс по тем же колонкам.
+type gameListOrder struct {
+	columns []string
+	desc    bool
+	values  func(cursor dto.GameListCursor) []any
+}
+
+// gameListOrderFor возвращает порядок для режима sort. rankExpr - выражение
+// ts_rank текущего запроса, пустое без поиска.
+func gameListOrderFor(sort dto.GameSort, rankExpr string) (gameListOrder, bool) {
+	switch sort {
+	case dto.GameSortTitle:
+		return gameListOrder{
+			columns: []string{GameTitleFieldName, GameReleaseDateFieldName, GameGameIDFieldName},
+			values: func(c dto.GameListCursor) []any {
+				return []any{c.Title, c.ReleaseDate, c.GameID}
+			},
+		}, true
+	case dto.GameSortNewest, dto.GameSortOldest:
+		return gameListOrder{
+			columns: []string{GameReleaseDateFieldName, GameGameIDFieldName},
+			desc:    sort == dto.GameSortNewest,
+			values: func(c dto.GameListCursor) []any {
+				return []any{c.ReleaseDate, c.GameID}
+			},
+		}, true
+	case dto.GameSortRecentlyAdded:
+		return gameListOrder{
+			columns: []string{GameGameIDFieldName},
+			desc:    true,
+			values: func(c dto.GameListCursor) []any {
+				return []any{c.GameID}
+			},
+		}, true
+	case dto.GameSortRelevance:
+		if rankExpr == "" {
+			return gameListOrder{}, false
+		}
+		return gameListOrder{
+			columns: []string{rankExpr, GameGameIDFieldName},
+			desc:    true,
+			values: func(c dto.GameListCursor) []any {
+				var rank float32
+				if c.Rank != nil {
+					rank = *c.Rank
+				}
+				return []any{rank, c.GameID}
+			},
+		}, true
+	default:
+		return gameListOrder{}, false
+	}
+}
+
+// orderBy - выражение для order by.
+func (o gameListOrder) orderBy() string {
+	direction := "asc"
+	if o.desc {
+		direction = "desc"
+	}
+	parts := make([]string, 0, len(o.columns))
+	for _, c := range o.columns {
+		parts = append(parts, c+" "+direction)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// keyset - условие "строго после курсора". Значения курсора дописываются в args.
+func (o gameListOrder) keyset(cursor dto.GameListCursor, args []any) (string, []any) {
+	placeholders := make([]string, 0, len(o.columns))
+	for _, v := range o.values(cursor) {
+		args = append(args, v)
+		placeholders = append(placeholders, fmt.Sprintf("$%d", len(args)))
+	}
+	op := ">"
+	if o.desc {
+		op = "<"
+	}
+	return fmt.Sprintf("(%s) %s (%s)", strings.Join(o.columns, ", "), op, strings.Join(placeholders, ", ")), args
+}
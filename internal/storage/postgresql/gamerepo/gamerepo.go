@@ -7,12 +7,16 @@ import (
 )
 
 const (
-	GameGameIDFieldName       = "game_id"
-	GameTitleFieldName        = "title"
-	GameDescriptionFieldName  = "description"
-	GameReleaseDateFieldName  = "release_date"
-	GameImageKeyFieldName     = "image_key"
-	GameGameStatusIDFieldName = "game_status_id"
+	GameGameIDFieldName             = "game_id"
+	GameTitleFieldName              = "title"
+	GameDescriptionFieldName        = "description"
+	GameReleaseDateFieldName        = "release_date"
+	GameImageKeyFieldName           = "image_key"
+	GameImageVariantWidthsFieldName = "image_variant_widths"
+	GameGameStatusIDFieldName       = "game_status_id"
+	GameSearchVectorFieldName       = "search_vector"
+	GamePublishAtFieldName          = "publish_at"
+	GameDeletedAtFieldName          = "deleted_at"
 )
 
 type GameRepository struct {
@@ -22,14 +22,16 @@ func (gr *GameRepository) GetGameByID(ctx context.Context, gameID int64) (*model
 	log := gr.log.With("operationPlace", operationPlace)
 	log = logger.EnrichRequestID(ctx, log)
 	getGameMainInfoQuery := fmt.Sprintf(
-		"select %s, %s, %s, %s, %s, %s from game where %s=$1",
+		"select %s, %s, %s, %s, %s, %s, %s from game where %s=$1 and %s is null",
 		GameGameIDFieldName,
 		GameTitleFieldName,
 		GameDescriptionFieldName,
 		GameReleaseDateFieldName,
 		GameImageKeyFieldName,
+		GameImageVariantWidthsFieldName,
 		GameGameStatusIDFieldName,
 		GameGameIDFieldName,
+		GameDeletedAtFieldName,
 	)
 	getGameGenresQuery := fmt.Sprintf(`
 	select %s, %s
@@ -59,6 +61,7 @@ func (gr *GameRepository) GetGameByID(ctx context.Context, gameID int64) (*model
 		&gameDB.Description,
 		&gameDB.ReleaseDate,
 		&gameDB.ImageKey,
+		&gameDB.ImageVariantWidths,
 		&gameDB.GameStatus,
 	)
 	if err != nil {
@@ -22,7 +22,7 @@ func (gr *GameRepository) GetGameByTitleAndReleaseYear(ctx context.Context, titl
 	log = log.With("release_year", releaseYear)
 	log = log.With("operationPlace", operationPlace)
 	log = logger.EnrichRequestID(ctx, log)
-	getGameQuery := fmt.Sprintf("select %s, %s, %s, %s, %s, %s from game where %s=$1 and extract(year from %s)=$2",
+	getGameQuery := fmt.Sprintf("select %s, %s, %s, %s, %s, %s from game where %s=$1 and extract(year from %s)=$2 and %s is null",
 		GameGameIDFieldName,
 		GameTitleFieldName,
 		GameDescriptionFieldName,
@@ -31,6 +31,7 @@ func (gr *GameRepository) GetGameByTitleAndReleaseYear(ctx context.Context, titl
 		GameGameStatusIDFieldName,
 		GameTitleFieldName,
 		GameReleaseDateFieldName,
+		GameDeletedAtFieldName,
 	)
 	getGameGenresQuery := fmt.Sprintf(`
 	select %s, %s
@@ -0,0 +1,50 @@
+package gamerepo
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/model"
+	gamestatushistoryrepo "github.com/sariya23/game_service/internal/storage/postgresql/game_status_history_repo"
+)
+
+// GetGameStatusHistory возвращает смены статуса игры от старых к новым.
+func (gr *GameRepository) GetGameStatusHistory(ctx context.Context, gameID int64) ([]model.GameStatusHistoryEntry, error) {
+	const operationPlace = "postgresql.gamerepo.GetGameStatusHistory"
+	log := gr.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	historyQuery := fmt.Sprintf("select %s, %s, %s, %s, %s from %s where %s=$1 order by %s, %s",
+		gamestatushistoryrepo.GameStatusHistoryOldStatusIDFieldName,
+		gamestatushistoryrepo.GameStatusHistoryNewStatusIDFieldName,
+		gamestatushistoryrepo.GameStatusHistoryActorFieldName,
+		gamestatushistoryrepo.GameStatusHistoryRequestIDFieldName,
+		gamestatushistoryrepo.GameStatusHistoryCreatedAtFieldName,
+		gamestatushistoryrepo.GameStatusHistoryTable,
+		gamestatushistoryrepo.GameStatusHistoryGameIDFieldName,
+		gamestatushistoryrepo.GameStatusHistoryCreatedAtFieldName,
+		gamestatushistoryrepo.GameStatusHistoryGameStatusHistoryIDFieldName,
+	)
+	rows, err := gr.conn.GetPool().Query(ctx, historyQuery, gameID)
+	if err != nil {
+		log.Error("cannot execute query to get status history", slog.Int64("gameID", gameID), slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer rows.Close()
+	var history []model.GameStatusHistoryEntry
+	for rows.Next() {
+		var entry model.GameStatusHistoryEntry
+		err = rows.Scan(&entry.OldStatus, &entry.NewStatus, &entry.Actor, &entry.RequestID, &entry.ChangedAt)
+		if err != nil {
+			log.Error("cannot scan status history", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		history = append(history, entry)
+	}
+	if err = rows.Err(); err != nil {
+		log.Error("cannot prepare next row", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return history, nil
+}
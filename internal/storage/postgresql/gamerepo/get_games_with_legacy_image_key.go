@@ -0,0 +1,49 @@
+package gamerepo
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/model/dto"
+)
+
+// GetGamesWithLegacyImageKey возвращает игры, у которых ключ обложки
+// не начинается с keyPrefix, то есть был сохранен в старом формате.
+// Удаленные игры пропускаются: их обложки не меняются до восстановления.
+func (gr *GameRepository) GetGamesWithLegacyImageKey(ctx context.Context, keyPrefix string) ([]dto.GameImageKey, error) {
+	const operationPlace = "postgresql.gamerepo.GetGamesWithLegacyImageKey"
+	log := gr.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	getGamesQuery := fmt.Sprintf(
+		"select %s, %s from game where %s is not null and not starts_with(%s, $1) and %s is null order by %s",
+		GameGameIDFieldName,
+		GameImageKeyFieldName,
+		GameImageKeyFieldName,
+		GameImageKeyFieldName,
+		GameDeletedAtFieldName,
+		GameGameIDFieldName,
+	)
+	gameRows, err := gr.conn.GetPool().Query(ctx, getGamesQuery, keyPrefix)
+	if err != nil {
+		log.Error("cannot execute query to get games", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer gameRows.Close()
+	var games []dto.GameImageKey
+	for gameRows.Next() {
+		var game dto.GameImageKey
+		err = gameRows.Scan(&game.GameID, &game.ImageKey)
+		if err != nil {
+			log.Error("cannot scan game image key", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		games = append(games, game)
+	}
+	if err = gameRows.Err(); err != nil {
+		log.Error("cannot prepare next row", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return games, nil
+}
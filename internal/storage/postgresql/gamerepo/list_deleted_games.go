@@ -0,0 +1,59 @@
+package gamerepo
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/model"
+)
+
+// ListDeletedGames возвращает мягко удаленные игры, недавно удаленные первыми.
+func (gr *GameRepository) ListDeletedGames(ctx context.Context, limit uint32) ([]model.DeletedGame, error) {
+	const operationPlace = "postgresql.gamerepo.ListDeletedGames"
+	log := gr.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	listDeletedQuery := fmt.Sprintf(`
+	select %s, %s, %s, %s, %s
+	from game
+	where %s is not null
+	order by %s desc, %s desc
+	limit $1`,
+		GameGameIDFieldName,
+		GameTitleFieldName,
+		GameReleaseDateFieldName,
+		GameGameStatusIDFieldName,
+		GameDeletedAtFieldName,
+		GameDeletedAtFieldName,
+		GameDeletedAtFieldName,
+		GameGameIDFieldName,
+	)
+	rows, err := gr.conn.GetPool().Query(ctx, listDeletedQuery, limit)
+	if err != nil {
+		log.Error("cannot get deleted games", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer rows.Close()
+	var games []model.DeletedGame
+	for rows.Next() {
+		var deletedGame model.DeletedGame
+		err = rows.Scan(
+			&deletedGame.GameID,
+			&deletedGame.Title,
+			&deletedGame.ReleaseDate,
+			&deletedGame.GameStatus,
+			&deletedGame.DeletedAt,
+		)
+		if err != nil {
+			log.Error("cannot scan deleted game", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		games = append(games, deletedGame)
+	}
+	if err = rows.Err(); err != nil {
+		log.Error("cannot prepare next row", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return games, nil
+}
@@ -0,0 +1,75 @@
+package gamerepo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+
+	"github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/model"
+	gamemoderationrepo "github.com/sariya23/game_service/internal/storage/postgresql/game_moderation_repo"
+)
+
+// ListPendingGames возвращает очередь модерации: игры в статусе PENDING
+// в порядке последней отправки на проверку, самые давние первыми.
+func (gr *GameRepository) ListPendingGames(ctx context.Context, limit uint32) ([]model.PendingGame, error) {
+	const operationPlace = "postgresql.gamerepo.ListPendingGames"
+	log := gr.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	listPendingQuery := fmt.Sprintf(`
+	select g.%s, g.%s, g.%s, m.%s, m.%s
+	from game g
+	left join lateral (
+		select %s, %s from %s
+		where %s = g.%s and %s = $1
+		order by %s desc
+		limit 1
+	) m on true
+	where g.%s = $2 and g.%s is null
+	order by m.%s nulls first, g.%s
+	limit $3`,
+		GameGameIDFieldName,
+		GameTitleFieldName,
+		GameReleaseDateFieldName,
+		gamemoderationrepo.GameModerationActorFieldName,
+		gamemoderationrepo.GameModerationCreatedAtFieldName,
+		gamemoderationrepo.GameModerationActorFieldName,
+		gamemoderationrepo.GameModerationCreatedAtFieldName,
+		gamemoderationrepo.GameModerationTable,
+		gamemoderationrepo.GameModerationGameIDFieldName,
+		GameGameIDFieldName,
+		gamemoderationrepo.GameModerationDecisionFieldName,
+		gamemoderationrepo.GameModerationCreatedAtFieldName,
+		GameGameStatusIDFieldName,
+		GameDeletedAtFieldName,
+		gamemoderationrepo.GameModerationCreatedAtFieldName,
+		GameGameIDFieldName,
+	)
+	rows, err := gr.conn.GetPool().Query(ctx, listPendingQuery, model.ModerationSubmit, game.GameStatusType_PENDING, limit)
+	if err != nil {
+		log.Error("cannot execute query to list pending games", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer rows.Close()
+	games := make([]model.PendingGame, 0, limit)
+	for rows.Next() {
+		var pending model.PendingGame
+		var submittedBy sql.NullString
+		var submittedAt sql.NullTime
+		err = rows.Scan(&pending.GameID, &pending.Title, &pending.ReleaseDate, &submittedBy, &submittedAt)
+		if err != nil {
+			log.Error("cannot scan pending game", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		pending.SubmittedBy = submittedBy.String
+		pending.SubmittedAt = submittedAt.Time
+		games = append(games, pending)
+	}
+	if err = rows.Err(); err != nil {
+		log.Error("cannot prepare next row", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return games, nil
+}
@@ -0,0 +1,74 @@
+package gamerepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/model/dto"
+	"github.com/sariya23/game_service/internal/outerror"
+	gamemoderationrepo "github.com/sariya23/game_service/internal/storage/postgresql/game_moderation_repo"
+)
+
+// ModerateGame переводит игру из moderation.FromStatus в moderation.ToStatus
+// и записывает решение в game_moderation и смену статуса в историю.
+// Если игра успела сменить статус, возвращается ErrInvalidNewGameStatus.
+func (gr *GameRepository) ModerateGame(ctx context.Context, moderation dto.GameModeration) error {
+	const operationPlace = "postgresql.gamerepo.ModerateGame"
+	log := gr.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+
+	tx, err := gr.conn.GetPool().Begin(ctx)
+	if err != nil {
+		log.Error(fmt.Sprintf("cannot start transaction, unexpected error = %v", err))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			log.Error("cannot rollback transaction, unexpected error", slog.String("error", rollbackErr.Error()))
+		}
+	}()
+
+	err = changeGameStatusTx(ctx, tx, dto.GameStatusChange{
+		GameID:         moderation.GameID,
+		ExpectedStatus: &moderation.FromStatus,
+		NewStatus:      moderation.ToStatus,
+		Actor:          moderation.Actor,
+		RequestID:      moderation.RequestID,
+	})
+	if err != nil {
+		if errors.Is(err, outerror.ErrGameNotFound) || errors.Is(err, outerror.ErrInvalidNewGameStatus) {
+			log.Warn("cannot moderate game", slog.Int64("gameID", moderation.GameID), slog.Any("expected", moderation.FromStatus), slog.String("err", err.Error()))
+		} else {
+			log.Error(fmt.Sprintf("cannot update game status, unexpected error = %v", err))
+		}
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if err = saveModerationTx(ctx, tx, moderation); err != nil {
+		log.Error(fmt.Sprintf("cannot save moderation decision, unexpected error = %v", err))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		log.Error(fmt.Sprintf("cannot commit transaction, unexpected error = %v", err))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return nil
+}
+
+// saveModerationTx записывает решение модерации в game_moderation
+// в транзакции tx, в которой меняется статус игры.
+func saveModerationTx(ctx context.Context, tx pgx.Tx, moderation dto.GameModeration) error {
+	insertModerationQuery := fmt.Sprintf("insert into %s (%s, %s, %s, %s) values ($1, $2, nullif($3, ''), $4)",
+		gamemoderationrepo.GameModerationTable,
+		gamemoderationrepo.GameModerationGameIDFieldName,
+		gamemoderationrepo.GameModerationDecisionFieldName,
+		gamemoderationrepo.GameModerationReasonFieldName,
+		gamemoderationrepo.GameModerationActorFieldName,
+	)
+	_, err := tx.Exec(ctx, insertModerationQuery, moderation.GameID, moderation.Decision, moderation.Reason, moderation.Actor)
+	return err
+}
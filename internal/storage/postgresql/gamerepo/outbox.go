@@ -0,0 +1,32 @@
+package gamerepo
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/sariya23/game_service/internal/model"
+	outboxrepo "github.com/sariya23/game_service/internal/storage/postgresql/outbox_repo"
+)
+
+// insertOutboxEventTx пишет событие в outbox в транзакции изменения игры,
+// чтобы событие появилось тогда и только тогда, когда изменение закоммичено.
+// Там же отправляется NOTIFY с outbox_id: Postgres доставит его слушателям
+// только после коммита.
+func insertOutboxEventTx(ctx context.Context, tx pgx.Tx, event model.OutboxEvent) error {
+	insertEventQuery := fmt.Sprintf("insert into %s (%s, %s, %s) values ($1, $2, $3) returning %s",
+		outboxrepo.OutboxTable,
+		outboxrepo.OutboxGameIDFieldName,
+		outboxrepo.OutboxEventTypeFieldName,
+		outboxrepo.OutboxPayloadFieldName,
+		outboxrepo.OutboxOutboxIDFieldName,
+	)
+	var outboxID int64
+	err := tx.QueryRow(ctx, insertEventQuery, event.GameID, event.EventType, event.Payload).Scan(&outboxID)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, "select pg_notify($1, $2)", outboxrepo.OutboxNotifyChannel, strconv.FormatInt(outboxID, 10))
+	return err
+}
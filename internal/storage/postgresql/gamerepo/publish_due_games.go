@@ -0,0 +1,181 @@
+package gamerepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/model"
+	"github.com/sariya23/game_service/internal/model/dto"
+	gamegenrerepo "github.com/sariya23/game_service/internal/storage/postgresql/game_genre_repo"
+	"github.com/sariya23/game_service/internal/storage/postgresql/genrerepo"
+)
+
+// PublishDueGames в одной транзакции забирает до limit игр в PENDING,
+// у которых наступил publish_at, и переводит в PUBLISH те, что прошли check.
+// Строки берутся через for update skip locked, поэтому несколько реплик
+// не публикуют одну игру дважды. У игр, не прошедших check, расписание
+// сбрасывается. Публикация записывается в game_moderation как одобрение
+// от actor. Возвращает id опубликованных игр.
+func (gr *GameRepository) PublishDueGames(
+	ctx context.Context,
+	now time.Time,
+	limit uint32,
+	check func(model.GameNoImageURL) error,
+	actor string,
+	requestID string,
+) ([]int64, error) {
+	const operationPlace = "postgresql.gamerepo.PublishDueGames"
+	log := gr.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	claimDueGamesQuery := fmt.Sprintf(`
+	select %s, %s, %s, %s, %s, %s
+	from game
+	where %s=$1 and %s<=$2 and %s is null
+	order by %s, %s
+	limit $3
+	for update skip locked`,
+		GameGameIDFieldName,
+		GameTitleFieldName,
+		GameDescriptionFieldName,
+		GameReleaseDateFieldName,
+		GameImageKeyFieldName,
+		GameGameStatusIDFieldName,
+		GameGameStatusIDFieldName,
+		GamePublishAtFieldName,
+		GameDeletedAtFieldName,
+		GamePublishAtFieldName,
+		GameGameIDFieldName,
+	)
+	getGenresQuery := fmt.Sprintf(`
+	select %s, %s, %s
+	from game_genre join genre using(%s)
+	where %s=any($1)`,
+		gamegenrerepo.GameGenreGameIDFieldName,
+		genrerepo.GenreGenreIDFieldName,
+		genrerepo.GenreGenreNameFieldName,
+		genrerepo.GenreGenreIDFieldName,
+		gamegenrerepo.GameGenreGameIDFieldName,
+	)
+	unscheduleQuery := fmt.Sprintf("update game set %s=null where %s=$1",
+		GamePublishAtFieldName,
+		GameGameIDFieldName,
+	)
+
+	tx, err := gr.conn.GetPool().Begin(ctx)
+	if err != nil {
+		log.Error(fmt.Sprintf("cannot start transaction, unexpected error = %v", err))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			log.Error("cannot rollback transaction, unexpected error", slog.String("error", rollbackErr.Error()))
+		}
+	}()
+
+	rows, err := tx.Query(ctx, claimDueGamesQuery, game.GameStatusType_PENDING, now, limit)
+	if err != nil {
+		log.Error("cannot claim due games", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	var dueGames []model.GameNoImageURL
+	for rows.Next() {
+		var gameDB dto.GameDB
+		err = rows.Scan(
+			&gameDB.GameID,
+			&gameDB.Title,
+			&gameDB.Description,
+			&gameDB.ReleaseDate,
+			&gameDB.ImageKey,
+			&gameDB.GameStatus,
+		)
+		if err != nil {
+			rows.Close()
+			log.Error("cannot scan due game", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		dueGames = append(dueGames, gameDB.ToGameNoImageURL())
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		log.Error("cannot prepare next row", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if len(dueGames) == 0 {
+		return nil, nil
+	}
+
+	gameIDs := make([]int64, 0, len(dueGames))
+	for _, dueGame := range dueGames {
+		gameIDs = append(gameIDs, dueGame.GameID)
+	}
+	genreRows, err := tx.Query(ctx, getGenresQuery, gameIDs)
+	if err != nil {
+		log.Error("cannot get genres of due games", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	genresByGameID := make(map[int64][]model.Genre, len(dueGames))
+	for genreRows.Next() {
+		var gameID int64
+		var genre model.Genre
+		if err = genreRows.Scan(&gameID, &genre.GenreID, &genre.GenreName); err != nil {
+			genreRows.Close()
+			log.Error("cannot scan genre", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		genresByGameID[gameID] = append(genresByGameID[gameID], genre)
+	}
+	genreRows.Close()
+	if err = genreRows.Err(); err != nil {
+		log.Error("cannot prepare next row", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+
+	var published []int64
+	for _, dueGame := range dueGames {
+		dueGame.Genres = genresByGameID[dueGame.GameID]
+		if err = check(dueGame); err != nil {
+			log.Warn("game cannot be published, schedule is reset",
+				slog.Int64("gameID", dueGame.GameID),
+				slog.String("err", err.Error()),
+			)
+			if _, err = tx.Exec(ctx, unscheduleQuery, dueGame.GameID); err != nil {
+				log.Error("cannot reset publish schedule", slog.Int64("gameID", dueGame.GameID), slog.String("err", err.Error()))
+				return nil, fmt.Errorf("%s: %w", operationPlace, err)
+			}
+			continue
+		}
+		err = changeGameStatusTx(ctx, tx, dto.GameStatusChange{
+			GameID:         dueGame.GameID,
+			ExpectedStatus: &dueGame.GameStatus,
+			NewStatus:      game.GameStatusType_PUBLISH,
+			Actor:          actor,
+			RequestID:      requestID,
+		})
+		if err != nil {
+			log.Error("cannot publish game", slog.Int64("gameID", dueGame.GameID), slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		err = saveModerationTx(ctx, tx, dto.GameModeration{
+			GameID:   dueGame.GameID,
+			Decision: model.ModerationApprove,
+			Actor:    actor,
+		})
+		if err != nil {
+			log.Error("cannot save moderation decision", slog.Int64("gameID", dueGame.GameID), slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		published = append(published, dueGame.GameID)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		log.Error(fmt.Sprintf("cannot commit transaction, unexpected error = %v", err))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return published, nil
+}
@@ -0,0 +1,64 @@
+package gamerepo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/model/dto"
+)
+
+// PurgeDeletedGames окончательно удаляет до limit игр, мягко удаленных раньше
+// deletedBefore. Жанры и теги игры удаляются каскадно, а история статусов
+// и история модерации остаются для аудита. Строки берутся через skip locked,
+// чтобы реплики не чистили одно и то же. Возвращает удаленные игры
+// с ключами обложек, которые нужно убрать из S3.
+func (gr *GameRepository) PurgeDeletedGames(ctx context.Context, deletedBefore time.Time, limit uint32) ([]dto.DeletedGame, error) {
+	const operationPlace = "postgresql.gamerepo.PurgeDeletedGames"
+	log := gr.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	purgeQuery := fmt.Sprintf(`
+	delete from game
+	where %s in (
+		select %s from game
+		where %s < $1
+		order by %s
+		limit $2
+		for update skip locked
+	)
+	returning %s, extract(year from %s), %s, %s`,
+		GameGameIDFieldName,
+		GameGameIDFieldName,
+		GameDeletedAtFieldName,
+		GameDeletedAtFieldName,
+		GameGameIDFieldName,
+		GameReleaseDateFieldName,
+		GameTitleFieldName,
+		GameImageKeyFieldName,
+	)
+	rows, err := gr.conn.GetPool().Query(ctx, purgeQuery, deletedBefore, limit)
+	if err != nil {
+		log.Error("cannot purge deleted games", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer rows.Close()
+	var purged []dto.DeletedGame
+	for rows.Next() {
+		var deletedGame dto.DeletedGame
+		var imageKey sql.NullString
+		if err = rows.Scan(&deletedGame.GameID, &deletedGame.ReleaseYear, &deletedGame.Title, &imageKey); err != nil {
+			log.Error("cannot scan purged game", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		deletedGame.ImageKey = imageKey.String
+		purged = append(purged, deletedGame)
+	}
+	if err = rows.Err(); err != nil {
+		log.Error("cannot prepare next row", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return purged, nil
+}
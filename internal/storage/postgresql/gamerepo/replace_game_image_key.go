@@ -0,0 +1,67 @@
+package gamerepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/outerror"
+)
+
+// ReplaceGameImageKey меняет ключ обложки и возвращает ключ, который был заменен.
+// Строка игры блокируется, поэтому при параллельных заменах каждая видит ключ,
+// записанный предыдущей, и удалять можно ровно возвращенный ключ.
+// Вместе с ключом записываются ширины сохраненных уменьшенных копий.
+// Пустой newImageKey убирает обложку.
+func (gr *GameRepository) ReplaceGameImageKey(ctx context.Context, gameID int64, newImageKey string, variantWidths []int) (oldImageKey string, err error) {
+	const operationPlace = "postgresql.gamerepo.ReplaceGameImageKey"
+	log := gr.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	lockGameQuery := fmt.Sprintf("select %s from game where %s=$1 and %s is null for update",
+		GameImageKeyFieldName,
+		GameGameIDFieldName,
+		GameDeletedAtFieldName,
+	)
+	replaceImageKeyQuery := fmt.Sprintf("update game set %s=nullif($1, ''), %s=coalesce($3::integer[], '{}') where %s=$2",
+		GameImageKeyFieldName,
+		GameImageVariantWidthsFieldName,
+		GameGameIDFieldName,
+	)
+
+	tx, err := gr.conn.GetPool().Begin(ctx)
+	if err != nil {
+		log.Error(fmt.Sprintf("cannot start transaction, unexpected error = %v", err))
+		return "", fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			log.Error("cannot rollback transaction, unexpected error", slog.String("error", rollbackErr.Error()))
+		}
+	}()
+
+	var currentImageKey sql.NullString
+	err = tx.QueryRow(ctx, lockGameQuery, gameID).Scan(&currentImageKey)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			log.Warn("game not found to replace image key", slog.Int64("gameID", gameID))
+			return "", fmt.Errorf("%s: %w", operationPlace, outerror.ErrGameNotFound)
+		}
+		log.Error("cannot lock game", slog.Int64("gameID", gameID), slog.String("err", err.Error()))
+		return "", fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	_, err = tx.Exec(ctx, replaceImageKeyQuery, newImageKey, gameID, variantWidths)
+	if err != nil {
+		log.Error("cannot replace game image key", slog.Int64("gameID", gameID), slog.String("err", err.Error()))
+		return "", fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		log.Error(fmt.Sprintf("cannot commit transaction, unexpected error = %v", err))
+		return "", fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return currentImageKey.String, nil
+}
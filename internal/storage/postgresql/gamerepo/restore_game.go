@@ -0,0 +1,93 @@
+package gamerepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/events"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/outerror"
+)
+
+// RestoreGame снимает мягкое удаление и пишет GameRestored в outbox.
+// Если за это время появилась живая игра с тем же названием и годом выпуска,
+// возвращается ErrGameAlreadyExist.
+func (gr *GameRepository) RestoreGame(ctx context.Context, gameID int64, actor, requestID string) error {
+	const operationPlace = "postgresql.gamerepo.RestoreGame"
+	log := gr.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	lockDeletedGameQuery := fmt.Sprintf("select %s, %s, %s from game where %s=$1 and %s is not null for update",
+		GameTitleFieldName,
+		GameReleaseDateFieldName,
+		GameGameStatusIDFieldName,
+		GameGameIDFieldName,
+		GameDeletedAtFieldName,
+	)
+	duplicateExistsQuery := fmt.Sprintf(
+		"select exists(select 1 from game where %s=$1 and extract(year from %s)=$2 and %s is null)",
+		GameTitleFieldName,
+		GameReleaseDateFieldName,
+		GameDeletedAtFieldName,
+	)
+	restoreGameQuery := fmt.Sprintf("update game set %s=null where %s=$1",
+		GameDeletedAtFieldName,
+		GameGameIDFieldName,
+	)
+
+	tx, err := gr.conn.GetPool().Begin(ctx)
+	if err != nil {
+		log.Error(fmt.Sprintf("cannot start transaction, unexpected error = %v", err))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			log.Error("cannot rollback transaction, unexpected error", slog.String("error", rollbackErr.Error()))
+		}
+	}()
+
+	var title string
+	var releaseDate time.Time
+	var gameStatus int32
+	err = tx.QueryRow(ctx, lockDeletedGameQuery, gameID).Scan(&title, &releaseDate, &gameStatus)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			log.Warn("deleted game not found", slog.Int64("gameID", gameID))
+			return fmt.Errorf("%s: %w", operationPlace, outerror.ErrGameNotFound)
+		}
+		log.Error("cannot get deleted game", slog.Int64("gameID", gameID), slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	var duplicateExists bool
+	if err = tx.QueryRow(ctx, duplicateExistsQuery, title, releaseDate.Year()).Scan(&duplicateExists); err != nil {
+		log.Error("cannot check duplicate game", slog.Int64("gameID", gameID), slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if duplicateExists {
+		log.Warn("game with same title and release year exists", slog.Int64("gameID", gameID), slog.String("title", title))
+		return fmt.Errorf("%s: %w", operationPlace, outerror.ErrGameAlreadyExist)
+	}
+	if _, err = tx.Exec(ctx, restoreGameQuery, gameID); err != nil {
+		log.Error("cannot restore game", slog.Int64("gameID", gameID), slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	event, err := events.NewGameRestored(gameID, title, releaseDate, game.GameStatusType(gameStatus), actor, requestID)
+	if err == nil {
+		err = insertOutboxEventTx(ctx, tx, event)
+	}
+	if err != nil {
+		log.Error("cannot save GameRestored event", slog.Int64("gameID", gameID), slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		log.Error(fmt.Sprintf("cannot commit transaction, unexpected error = %v", err))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	log.Info("game restored", slog.Int64("gameID", gameID))
+	return nil
+}
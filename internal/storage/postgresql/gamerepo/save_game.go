@@ -6,6 +6,8 @@ import (
 	"log/slog"
 
 	"github.com/jackc/pgx/v5"
+	game_api "github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/events"
 	"github.com/sariya23/game_service/internal/lib/logger"
 	"github.com/sariya23/game_service/internal/model/dto"
 )
@@ -23,13 +25,14 @@ func (gr *GameRepository) SaveGame(ctx context.Context, game dto.AddGameService)
 	saveMainGameInfoQuery := fmt.Sprintf(`
 		insert into game (%s, %s, %s, %s) values 
 		(@title, @description, @release_date, @image_key)
-		returning %s
+		returning %s, %s
 	`,
 		GameTitleFieldName,
 		GameDescriptionFieldName,
 		GameReleaseDateFieldName,
 		GameImageKeyFieldName,
-		GameGameIDFieldName)
+		GameGameIDFieldName,
+		GameGameStatusIDFieldName)
 	addTagsForGameQuery := "insert into game_tag values ($1, $2)"
 	addGenresForGameQuery := "insert into game_genre values ($1, $2)"
 
@@ -46,8 +49,9 @@ func (gr *GameRepository) SaveGame(ctx context.Context, game dto.AddGameService)
 	}()
 
 	var savedGameID int64
+	var savedGameStatus game_api.GameStatusType
 	saveGameRow := tx.QueryRow(ctx, saveMainGameInfoQuery, saveGameArgs)
-	err = saveGameRow.Scan(&savedGameID)
+	err = saveGameRow.Scan(&savedGameID, &savedGameStatus)
 	if err != nil {
 		log.Error(fmt.Sprintf("cannot save game, unexpected error = %v", err))
 		return 0, fmt.Errorf("%s: %w", operationPlace, err)
@@ -68,6 +72,14 @@ func (gr *GameRepository) SaveGame(ctx context.Context, game dto.AddGameService)
 			return 0, fmt.Errorf("%s: %w", operationPlace, err)
 		}
 	}
+	event, err := events.NewGameCreated(savedGameID, game.Title, game.ReleaseDate, savedGameStatus)
+	if err == nil {
+		err = insertOutboxEventTx(ctx, tx, event)
+	}
+	if err != nil {
+		log.Error(fmt.Sprintf("cannot save GameCreated event, unexpected error = %v", err), slog.Int64("gameID", savedGameID))
+		return 0, fmt.Errorf("%s: %w", operationPlace, err)
+	}
 	err = tx.Commit(ctx)
 	if err != nil {
 		log.Error(fmt.Sprintf("cannot commit, err = %v", err))
@@ -0,0 +1,37 @@
+package gamerepo
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/outerror"
+)
+
+// SchedulePublish задает время автоматической публикации игры в статусе PENDING.
+// nil снимает публикацию с расписания. Если игра уже не в PENDING,
+// возвращается ErrGameNotPending.
+func (gr *GameRepository) SchedulePublish(ctx context.Context, gameID int64, publishAt *time.Time) error {
+	const operationPlace = "postgresql.gamerepo.SchedulePublish"
+	log := gr.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	schedulePublishQuery := fmt.Sprintf("update game set %s=$1 where %s=$2 and %s=$3 and %s is null",
+		GamePublishAtFieldName,
+		GameGameIDFieldName,
+		GameGameStatusIDFieldName,
+		GameDeletedAtFieldName,
+	)
+	tag, err := gr.conn.GetPool().Exec(ctx, schedulePublishQuery, publishAt, gameID, game.GameStatusType_PENDING)
+	if err != nil {
+		log.Error("cannot schedule publish", slog.Int64("gameID", gameID), slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if tag.RowsAffected() == 0 {
+		log.Warn("game is not pending", slog.Int64("gameID", gameID))
+		return fmt.Errorf("%s: %w", operationPlace, outerror.ErrGameNotPending)
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package gamerepo
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/model"
+)
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// SuggestGames подбирает игры для автодополнения. Сначала идут названия,
+// начинающиеся с prefix, затем похожие по триграммам - так находятся
+// и названия с опечатками. Пустой statuses - игры в любом статусе.
+func (gr *GameRepository) SuggestGames(
+	ctx context.Context,
+	prefix string,
+	limit uint32,
+	statuses []game.GameStatusType,
+) ([]model.GameSuggestion, error) {
+	const operationPlace = "postgresql.gamerepo.SuggestGames"
+	log := gr.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	suggestQuery := fmt.Sprintf(`
+	select %s, %s
+	from game
+	where (%s ilike $1 or $2 <%% %s) and (cardinality($4::int[]) = 0 or %s = any($4)) and %s is null
+	order by %s ilike $1 desc, word_similarity($2, %s) desc, %s
+	limit $3`,
+		GameGameIDFieldName,
+		GameTitleFieldName,
+		GameTitleFieldName,
+		GameTitleFieldName,
+		GameGameStatusIDFieldName,
+		GameDeletedAtFieldName,
+		GameTitleFieldName,
+		GameTitleFieldName,
+		GameTitleFieldName,
+	)
+	prefixPattern := likeEscaper.Replace(prefix) + "%"
+	suggestionRows, err := gr.conn.GetPool().Query(ctx, suggestQuery, prefixPattern, prefix, limit, statusIDs(statuses))
+	if err != nil {
+		log.Error("cannot execute query to suggest games", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer suggestionRows.Close()
+	suggestions := make([]model.GameSuggestion, 0, limit)
+	for suggestionRows.Next() {
+		var suggestion model.GameSuggestion
+		err = suggestionRows.Scan(&suggestion.GameID, &suggestion.Title)
+		if err != nil {
+			log.Error("cannot scan suggestion", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		suggestions = append(suggestions, suggestion)
+	}
+	if err = suggestionRows.Err(); err != nil {
+		log.Error("cannot prepare next row", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return suggestions, nil
+}
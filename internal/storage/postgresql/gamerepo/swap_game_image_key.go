@@ -0,0 +1,31 @@
+package gamerepo
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/sariya23/game_service/internal/lib/logger"
+)
+
+// SwapGameImageKey меняет ключ обложки и список сохраненных уменьшенных копий,
+// только если у игры все еще oldImageKey и она не удалена. Возвращает false,
+// если ключ успели заменить или игру удалили.
+func (gr *GameRepository) SwapGameImageKey(ctx context.Context, gameID int64, oldImageKey, newImageKey string, variantWidths []int) (bool, error) {
+	const operationPlace = "postgresql.gamerepo.SwapGameImageKey"
+	log := gr.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	swapImageKeyQuery := fmt.Sprintf("update game set %s=$1, %s=coalesce($4::integer[], '{}') where %s=$2 and %s=$3 and %s is null",
+		GameImageKeyFieldName,
+		GameImageVariantWidthsFieldName,
+		GameGameIDFieldName,
+		GameImageKeyFieldName,
+		GameDeletedAtFieldName,
+	)
+	tag, err := gr.conn.GetPool().Exec(ctx, swapImageKeyQuery, newImageKey, gameID, oldImageKey, variantWidths)
+	if err != nil {
+		log.Error("cannot swap game image key", slog.Int64("gameID", gameID), slog.String("err", err.Error()))
+		return false, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return tag.RowsAffected() == 1, nil
+}
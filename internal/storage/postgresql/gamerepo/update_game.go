@@ -0,0 +1,116 @@
+package gamerepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+	game_api "github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/events"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/model/dto"
+	"github.com/sariya23/game_service/internal/outerror"
+	gamegenrerepo "github.com/sariya23/game_service/internal/storage/postgresql/game_genre_repo"
+	gametagrepo "github.com/sariya23/game_service/internal/storage/postgresql/game_tag_repo"
+)
+
+// UpdateGame обновляет основную информацию об игре и, если нужно,
+// перезаписывает ее связи с тэгами и жанрами. Все в одной транзакции.
+func (gr *GameRepository) UpdateGame(ctx context.Context, game dto.UpdateGameService) error {
+	const operationPlace = "postgresql.gamerepo.UpdateGame"
+	log := gr.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	updateGameArgs := pgx.NamedArgs{
+		"game_id":      game.GameID,
+		"title":        game.Title,
+		"description":  game.Description,
+		"release_date": game.ReleaseDate,
+	}
+	updateMainGameInfoQuery := fmt.Sprintf(`
+		update game set %s=@title, %s=@description, %s=@release_date
+		where %s=@game_id and %s is null
+		returning %s
+	`,
+		GameTitleFieldName,
+		GameDescriptionFieldName,
+		GameReleaseDateFieldName,
+		GameGameIDFieldName,
+		GameDeletedAtFieldName,
+		GameGameStatusIDFieldName,
+	)
+	deleteTagsForGameQuery := fmt.Sprintf("delete from game_tag where %s=$1", gametagrepo.GameTagGameIDFieldName)
+	deleteGenresForGameQuery := fmt.Sprintf("delete from game_genre where %s=$1", gamegenrerepo.GameGenreGameIDFieldName)
+	addTagsForGameQuery := "insert into game_tag values ($1, $2)"
+	addGenresForGameQuery := "insert into game_genre values ($1, $2)"
+
+	tx, err := gr.conn.GetPool().Begin(ctx)
+	if err != nil {
+		log.Error(fmt.Sprintf("cannot start transaction, unexpected error = %v", err))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			log.Error("cannot rollback transaction, unexpected error", slog.String("error", rollbackErr.Error()))
+		}
+	}()
+
+	var gameStatus game_api.GameStatusType
+	err = tx.QueryRow(ctx, updateMainGameInfoQuery, updateGameArgs).Scan(&gameStatus)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			log.Warn("game to update not found", slog.Int64("gameID", game.GameID))
+			return fmt.Errorf("%s: %w", operationPlace, outerror.ErrGameNotFound)
+		}
+		log.Error(fmt.Sprintf("cannot update game, unexpected error = %v", err))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+
+	if game.UpdateTags {
+		_, err = tx.Exec(ctx, deleteTagsForGameQuery, game.GameID)
+		if err != nil {
+			log.Error(fmt.Sprintf("cannot unlink tags from game, unexpected error = %v", err), slog.Int64("gameID", game.GameID))
+			return fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		for _, tagID := range game.TagIDs {
+			_, err = tx.Exec(ctx, addTagsForGameQuery, game.GameID, tagID)
+			if err != nil {
+				log.Error(fmt.Sprintf("cannot link tag with game, unexpected error = %v", err), slog.Int64("tagID", tagID), slog.Int64("gameID", game.GameID))
+				return fmt.Errorf("%s: %w", operationPlace, err)
+			}
+		}
+	}
+
+	if game.UpdateGenres {
+		_, err = tx.Exec(ctx, deleteGenresForGameQuery, game.GameID)
+		if err != nil {
+			log.Error(fmt.Sprintf("cannot unlink genres from game, unexpected error = %v", err), slog.Int64("gameID", game.GameID))
+			return fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		for _, genreID := range game.GenreIDs {
+			_, err = tx.Exec(ctx, addGenresForGameQuery, game.GameID, genreID)
+			if err != nil {
+				log.Error(fmt.Sprintf("cannot link genre with game, unexpected error = %v", err), slog.Int64("genreID", genreID), slog.Int64("gameID", game.GameID))
+				return fmt.Errorf("%s: %w", operationPlace, err)
+			}
+		}
+	}
+
+	event, err := events.NewGameUpdated(game.GameID, game.Title, game.ReleaseDate, gameStatus)
+	if err == nil {
+		err = insertOutboxEventTx(ctx, tx, event)
+	}
+	if err != nil {
+		log.Error(fmt.Sprintf("cannot save GameUpdated event, unexpected error = %v", err), slog.Int64("gameID", game.GameID))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		log.Error(fmt.Sprintf("cannot commit, err = %v", err))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package gamerepo
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/outerror"
+)
+
+// UpdateGameImageKey меняет ключ обложки игры в s3 и список сохраненных
+// уменьшенных копий. Пустой imageKey означает, что обложки у игры нет.
+func (gr *GameRepository) UpdateGameImageKey(ctx context.Context, gameID int64, imageKey string, variantWidths []int) error {
+	const operationPlace = "postgresql.gamerepo.UpdateGameImageKey"
+	log := gr.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	updateImageKeyQuery := fmt.Sprintf("update game set %s=nullif($1, ''), %s=coalesce($3::integer[], '{}') where %s=$2 and %s is null",
+		GameImageKeyFieldName,
+		GameImageVariantWidthsFieldName,
+		GameGameIDFieldName,
+		GameDeletedAtFieldName,
+	)
+	tag, err := gr.conn.GetPool().Exec(ctx, updateImageKeyQuery, imageKey, gameID, variantWidths)
+	if err != nil {
+		log.Error("cannot update game image key", slog.Int64("gameID", gameID), slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if tag.RowsAffected() == 0 {
+		log.Warn("game not found to update image key", slog.Int64("gameID", gameID))
+		return fmt.Errorf("%s: %w", operationPlace, outerror.ErrGameNotFound)
+	}
+	return nil
+}
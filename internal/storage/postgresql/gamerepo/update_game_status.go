@@ -2,20 +2,95 @@ package gamerepo
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 
-	game_api "github.com/sariya23/api_game_service/gen/game"
+	"github.com/jackc/pgx/v5"
+	"github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/events"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/model/dto"
+	"github.com/sariya23/game_service/internal/outerror"
+	gamestatushistoryrepo "github.com/sariya23/game_service/internal/storage/postgresql/game_status_history_repo"
 )
 
-func (gr *GameRepository) UpdateGameStatus(ctx context.Context, gameID int64, newStatus game_api.GameStatusType) error {
+// UpdateGameStatus меняет статус игры и пишет смену в game_status_history
+// в одной транзакции.
+func (gr *GameRepository) UpdateGameStatus(ctx context.Context, change dto.GameStatusChange) error {
 	const operationPlace = "postgresql.UpdateGameStatus"
 	log := gr.log.With("operationPlace", operationPlace)
-	queryUpdateStatusQuery := fmt.Sprintf("update game set %s=$1 where %s=$2", GameGameStatusIDFieldName, GameGameIDFieldName)
-	_, err := gr.conn.GetPool().Exec(ctx, queryUpdateStatusQuery, newStatus, gameID)
+	log = logger.EnrichRequestID(ctx, log)
+	tx, err := gr.conn.GetPool().Begin(ctx)
 	if err != nil {
-		log.Error("cannot update game status", slog.Int64("gameID", gameID), slog.Any("newStatus", newStatus))
+		log.Error(fmt.Sprintf("cannot start transaction, unexpected error = %v", err))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			log.Error("cannot rollback transaction, unexpected error", slog.String("error", rollbackErr.Error()))
+		}
+	}()
+	if err = changeGameStatusTx(ctx, tx, change); err != nil {
+		if errors.Is(err, outerror.ErrGameNotFound) || errors.Is(err, outerror.ErrInvalidNewGameStatus) {
+			log.Warn("cannot update game status", slog.Int64("gameID", change.GameID), slog.String("err", err.Error()))
+		} else {
+			log.Error("cannot update game status", slog.Int64("gameID", change.GameID), slog.Any("newStatus", change.NewStatus), slog.String("err", err.Error()))
+		}
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		log.Error(fmt.Sprintf("cannot commit transaction, unexpected error = %v", err))
 		return fmt.Errorf("%s: %w", operationPlace, err)
 	}
 	return nil
 }
+
+// changeGameStatusTx блокирует строку игры, проверяет ожидаемый статус,
+// меняет его и пишет запись в историю и GameStatusChanged в outbox.
+// Все смены статуса идут через нее.
+// Запланированная публикация при смене статуса сбрасывается.
+func changeGameStatusTx(ctx context.Context, tx pgx.Tx, change dto.GameStatusChange) error {
+	lockGameQuery := fmt.Sprintf("select %s from game where %s=$1 and %s is null for update",
+		GameGameStatusIDFieldName,
+		GameGameIDFieldName,
+		GameDeletedAtFieldName,
+	)
+	updateStatusQuery := fmt.Sprintf("update game set %s=$1, %s=null where %s=$2",
+		GameGameStatusIDFieldName,
+		GamePublishAtFieldName,
+		GameGameIDFieldName,
+	)
+	insertHistoryQuery := fmt.Sprintf("insert into %s (%s, %s, %s, %s, %s) values ($1, $2, $3, $4, $5)",
+		gamestatushistoryrepo.GameStatusHistoryTable,
+		gamestatushistoryrepo.GameStatusHistoryGameIDFieldName,
+		gamestatushistoryrepo.GameStatusHistoryOldStatusIDFieldName,
+		gamestatushistoryrepo.GameStatusHistoryNewStatusIDFieldName,
+		gamestatushistoryrepo.GameStatusHistoryActorFieldName,
+		gamestatushistoryrepo.GameStatusHistoryRequestIDFieldName,
+	)
+	var oldStatus int32
+	err := tx.QueryRow(ctx, lockGameQuery, change.GameID).Scan(&oldStatus)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return outerror.ErrGameNotFound
+		}
+		return err
+	}
+	if change.ExpectedStatus != nil && int32(*change.ExpectedStatus) != oldStatus {
+		return outerror.ErrInvalidNewGameStatus
+	}
+	if _, err = tx.Exec(ctx, updateStatusQuery, change.NewStatus, change.GameID); err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, insertHistoryQuery, change.GameID, oldStatus, change.NewStatus, change.Actor, change.RequestID)
+	if err != nil {
+		return err
+	}
+	event, err := events.NewGameStatusChanged(change.GameID, game.GameStatusType(oldStatus), change.NewStatus, change.Actor, change.RequestID)
+	if err != nil {
+		return err
+	}
+	return insertOutboxEventTx(ctx, tx, event)
+}
@@ -0,0 +1,59 @@
+package genrerepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/model"
+	"github.com/sariya23/game_service/internal/outerror"
+	"github.com/sariya23/game_service/internal/storage/db"
+)
+
+// CreateGenre создает жанр. Если жанр или алиас с таким именем уже есть,
+// возвращается ErrGenreAlreadyExist.
+func (gr *GenreRepository) CreateGenre(ctx context.Context, genreName string) (model.Genre, error) {
+	const operationPlace = "postgresql.genrerepo.CreateGenre"
+	log := gr.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	createGenreQuery := fmt.Sprintf("insert into genre (%s) values ($1) returning %s", GenreGenreNameFieldName, GenreGenreIDFieldName)
+
+	tx, err := gr.conn.GetPool().Begin(ctx)
+	if err != nil {
+		log.Error(fmt.Sprintf("cannot start transaction, unexpected error = %v", err))
+		return model.Genre{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			log.Error("cannot rollback transaction, unexpected error", slog.String("error", rollbackErr.Error()))
+		}
+	}()
+	taken, err := genreAliasTakenTx(ctx, tx, genreName, 0)
+	if err != nil {
+		log.Error("cannot check genre aliases", slog.String("genreName", genreName), slog.String("err", err.Error()))
+		return model.Genre{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if taken {
+		log.Warn("genre alias already exists", slog.String("genreName", genreName))
+		return model.Genre{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrGenreAlreadyExist)
+	}
+	genre := model.Genre{GenreName: genreName}
+	err = tx.QueryRow(ctx, createGenreQuery, genreName).Scan(&genre.GenreID)
+	if err != nil {
+		if db.IsUniqueViolation(err) {
+			log.Warn("genre already exists", slog.String("genreName", genreName))
+			return model.Genre{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrGenreAlreadyExist)
+		}
+		log.Error("cannot create genre", slog.String("genreName", genreName), slog.String("err", err.Error()))
+		return model.Genre{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		log.Error(fmt.Sprintf("cannot commit transaction, unexpected error = %v", err))
+		return model.Genre{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return genre, nil
+}
@@ -0,0 +1,68 @@
+package genrerepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/outerror"
+)
+
+// DeleteGenre удаляет жанр. Если жанр привязан к играм, без force возвращается
+// ErrGenreInUse, с force связи удаляются вместе с жанром. Возвращает число
+// игр, у которых жанр был отвязан.
+func (gr *GenreRepository) DeleteGenre(ctx context.Context, genreID int64, force bool) (int64, error) {
+	const operationPlace = "postgresql.genrerepo.DeleteGenre"
+	log := gr.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	lockGenreQuery := fmt.Sprintf("select %s from genre where %s=$1 for update", GenreGenreIDFieldName, GenreGenreIDFieldName)
+	countLinksQuery := fmt.Sprintf("select count(*) from game_genre where %s=$1", GenreGenreIDFieldName)
+	deleteLinksQuery := fmt.Sprintf("delete from game_genre where %s=$1", GenreGenreIDFieldName)
+	deleteGenreQuery := fmt.Sprintf("delete from genre where %s=$1", GenreGenreIDFieldName)
+
+	tx, err := gr.conn.GetPool().Begin(ctx)
+	if err != nil {
+		log.Error(fmt.Sprintf("cannot start transaction, unexpected error = %v", err))
+		return 0, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			log.Error("cannot rollback transaction, unexpected error", slog.String("error", rollbackErr.Error()))
+		}
+	}()
+	var lockedID int64
+	if err = tx.QueryRow(ctx, lockGenreQuery, genreID).Scan(&lockedID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			log.Warn("genre not found", slog.Int64("genreID", genreID))
+			return 0, fmt.Errorf("%s: %w", operationPlace, outerror.ErrGenreNotFound)
+		}
+		log.Error("cannot lock genre", slog.Int64("genreID", genreID), slog.String("err", err.Error()))
+		return 0, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	var links int64
+	if err = tx.QueryRow(ctx, countLinksQuery, genreID).Scan(&links); err != nil {
+		log.Error("cannot count genre links", slog.Int64("genreID", genreID), slog.String("err", err.Error()))
+		return 0, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if links > 0 && !force {
+		log.Warn("genre is linked to games", slog.Int64("genreID", genreID), slog.Int64("games", links))
+		return 0, fmt.Errorf("%s: %w", operationPlace, outerror.ErrGenreInUse)
+	}
+	if _, err = tx.Exec(ctx, deleteLinksQuery, genreID); err != nil {
+		log.Error("cannot delete genre links", slog.Int64("genreID", genreID), slog.String("err", err.Error()))
+		return 0, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if _, err = tx.Exec(ctx, deleteGenreQuery, genreID); err != nil {
+		log.Error("cannot delete genre", slog.Int64("genreID", genreID), slog.String("err", err.Error()))
+		return 0, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		log.Error(fmt.Sprintf("cannot commit transaction, unexpected error = %v", err))
+		return 0, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return links, nil
+}
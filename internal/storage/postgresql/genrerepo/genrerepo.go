@@ -1,14 +1,35 @@
 package genrerepo
 
 import (
+	"context"
+	"fmt"
 	"log/slog"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/sariya23/game_service/internal/storage/db"
 )
 
 const (
-	GenreGenreIDFieldName   = "genre_id"
-	GenreGenreNameFieldName = "genre_name"
+	GenreGenreIDFieldName       = "genre_id"
+	GenreGenreNameFieldName     = "genre_name"
+	GenreParentGenreIDFieldName = "parent_genre_id"
+	// GenreNormalizedNameFieldName - normalize_name(genre_name), по нему ищутся жанры.
+	GenreNormalizedNameFieldName = "normalized_name"
+)
+
+// genreHierarchyLockKey - ключ advisory-блокировки, под которой меняется
+// дерево жанров. Без нее две встречные смены родителя могли бы создать цикл.
+const genreHierarchyLockKey = 7208245301
+
+// genreNamesLockKey - ключ advisory-блокировки, под которой меняются имена
+// и алиасы жанров. Уникальность имени проверяется сразу по двум таблицам,
+// и без блокировки встречные запросы могли бы занять одно имя.
+const genreNamesLockKey = 7208245303
+
+const (
+	GenreAliasAliasNameFieldName      = "alias_name"
+	GenreAliasGenreIDFieldName        = "genre_id"
+	GenreAliasNormalizedNameFieldName = "normalized_name"
 )
 
 type GenreRepository struct {
@@ -22,3 +43,20 @@ func NewGenreRepository(conn *db.Database, log *slog.Logger) *GenreRepository {
 		log:  log,
 	}
 }
+
+// genreAliasTakenTx берет блокировку имен и проверяет, занято ли имя
+// алиасом. Алиасы genreID не учитываются, 0 - проверить все.
+func genreAliasTakenTx(ctx context.Context, tx pgx.Tx, name string, genreID int64) (bool, error) {
+	aliasTakenQuery := fmt.Sprintf("select exists(select 1 from genre_alias where %s = normalize_name($1) and %s <> $2)",
+		GenreAliasNormalizedNameFieldName,
+		GenreAliasGenreIDFieldName,
+	)
+	if _, err := tx.Exec(ctx, "select pg_advisory_xact_lock($1)", genreNamesLockKey); err != nil {
+		return false, err
+	}
+	var taken bool
+	if err := tx.QueryRow(ctx, aliasTakenQuery, name, genreID).Scan(&taken); err != nil {
+		return false, err
+	}
+	return taken, nil
+}
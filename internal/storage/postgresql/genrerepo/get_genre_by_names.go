@@ -10,33 +10,71 @@ import (
 	"github.com/sariya23/game_service/internal/outerror"
 )
 
+// GetGenreByNames возвращает жанры по именам без учета регистра и лишних пробелов.
+// Имя ищется сначала среди жанров, потом среди алиасов слитых жанров. Жанр,
+// найденный по нескольким именам, возвращается один раз. Если какие-то имена
+// не найдены, возвращается UnknownNamesError с ErrGenreNotFound и этими именами.
 func (gr *GenreRepository) GetGenreByNames(ctx context.Context, genres []string) ([]model.Genre, error) {
 	const operationPlace = "postgresql.GetGenres"
 	log := gr.log.With("operationPlace", operationPlace)
 	log = logger.EnrichRequestID(ctx, log)
-	getGenresQuery := fmt.Sprintf("select %s, %s from genre where %s=any($1)", GenreGenreIDFieldName, GenreGenreNameFieldName, GenreGenreNameFieldName)
+	getGenresQuery := fmt.Sprintf(`
+	with wanted as (
+		select btrim(n.name) as name, normalize_name(n.name) as normalized, n.ord
+		from unnest($1::varchar[]) with ordinality as n(name, ord)
+	)
+	select w.name, w.normalized, g.%s, g.%s from wanted w
+	left join genre g on g.%s = coalesce(
+		(select %s from genre where %s = w.normalized),
+		(select %s from genre_alias where %s = w.normalized)
+	)
+	order by w.ord`,
+		GenreGenreIDFieldName,
+		GenreGenreNameFieldName,
+		GenreGenreIDFieldName,
+		GenreGenreIDFieldName,
+		GenreNormalizedNameFieldName,
+		GenreAliasGenreIDFieldName,
+		GenreAliasNormalizedNameFieldName,
+	)
 	genreRows, err := gr.conn.GetPool().Query(ctx, getGenresQuery, genres)
 	if err != nil {
-		log.Error(fmt.Sprintf("Cannot get genres, uncaught error: %v", err))
+		log.Error(fmt.Sprintf("Cannot get genres from request, uncaught error: %v", err))
 		return nil, fmt.Errorf("%s: %w", operationPlace, err)
 	}
 	defer genreRows.Close()
 	genreModels := make([]model.Genre, 0, len(genres))
+	seenGenres := make(map[int64]struct{}, len(genres))
+	seenUnknown := make(map[string]struct{})
+	var unknown []string
 	for genreRows.Next() {
-		var modelGenre model.Genre
-		err = genreRows.Scan(&modelGenre.GenreID, &modelGenre.GenreName)
+		var name, normalized string
+		var genreID *int64
+		var genreName *string
+		err = genreRows.Scan(&name, &normalized, &genreID, &genreName)
 		if err != nil {
 			log.Error("cannot scan genre", slog.String("err", err.Error()))
 			return nil, fmt.Errorf("%s: %w", operationPlace, err)
 		}
-		if rowErr := genreRows.Err(); rowErr != nil {
-			log.Error("cannot prepare next row", slog.String("err", rowErr.Error()))
-			return nil, fmt.Errorf("%s: %w", operationPlace, err)
+		if genreID == nil {
+			if _, ok := seenUnknown[normalized]; !ok {
+				seenUnknown[normalized] = struct{}{}
+				unknown = append(unknown, name)
+			}
+			continue
+		}
+		if _, ok := seenGenres[*genreID]; ok {
+			continue
 		}
-		genreModels = append(genreModels, modelGenre)
+		seenGenres[*genreID] = struct{}{}
+		genreModels = append(genreModels, model.Genre{GenreID: *genreID, GenreName: *genreName})
+	}
+	if rowErr := genreRows.Err(); rowErr != nil {
+		log.Error("cannot prepare next row", slog.String("err", rowErr.Error()))
+		return nil, fmt.Errorf("%s: %w", operationPlace, rowErr)
 	}
-	if len(genreModels) != len(genres) {
-		return nil, fmt.Errorf("%s: %w", operationPlace, outerror.ErrGenreNotFound)
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("%s: %w", operationPlace, &outerror.UnknownNamesError{Err: outerror.ErrGenreNotFound, Names: unknown})
 	}
 	return genreModels, nil
 }
@@ -0,0 +1,56 @@
+package genrerepo
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/model"
+)
+
+// maxGenreDepth страхует рекурсию, если в дереве все-таки окажется цикл.
+const maxGenreDepth = 32
+
+// GetGenrePaths возвращает для каждого жанра путь от корня до него.
+func (gr *GenreRepository) GetGenrePaths(ctx context.Context, genreIDs []int64) (map[int64]model.GenrePath, error) {
+	const operationPlace = "postgresql.genrerepo.GetGenrePaths"
+	log := gr.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	getPathsQuery := fmt.Sprintf(`
+	with recursive path(leaf_id, %s, %s, %s, depth) as (
+		select %s, %s, %s, %s, 0 from genre where %s = any($1)
+		union all
+		select p.leaf_id, g.%s, g.%s, g.%s, p.depth + 1
+		from genre g join path p on g.%s = p.%s
+		where p.depth < $2
+	)
+	select leaf_id, %s, %s from path order by leaf_id, depth desc`,
+		GenreGenreIDFieldName, GenreGenreNameFieldName, GenreParentGenreIDFieldName,
+		GenreGenreIDFieldName, GenreGenreIDFieldName, GenreGenreNameFieldName, GenreParentGenreIDFieldName, GenreGenreIDFieldName,
+		GenreGenreIDFieldName, GenreGenreNameFieldName, GenreParentGenreIDFieldName,
+		GenreGenreIDFieldName, GenreParentGenreIDFieldName,
+		GenreGenreIDFieldName, GenreGenreNameFieldName,
+	)
+	rows, err := gr.conn.GetPool().Query(ctx, getPathsQuery, genreIDs, maxGenreDepth)
+	if err != nil {
+		log.Error("cannot get genre paths", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer rows.Close()
+	paths := make(map[int64]model.GenrePath, len(genreIDs))
+	for rows.Next() {
+		var leafID int64
+		var genre model.Genre
+		if err = rows.Scan(&leafID, &genre.GenreID, &genre.GenreName); err != nil {
+			log.Error("cannot scan genre path", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		paths[leafID] = append(paths[leafID], genre)
+	}
+	if err = rows.Err(); err != nil {
+		log.Error("cannot prepare next row", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return paths, nil
+}
@@ -0,0 +1,144 @@
+package genrerepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/outerror"
+)
+
+// MergeGenres сливает жанры sourceIDs в targetID: связи с играми переносятся
+// без дублей, исходные жанры удаляются, а их имена и алиасы становятся
+// алиасами целевого, поджанры исходных переходят к целевому. Слить жанр
+// в его же поджанр нельзя, тогда возвращается ErrGenreCycle.
+// Возвращает число игр, получивших целевой жанр.
+func (gr *GenreRepository) MergeGenres(ctx context.Context, sourceIDs []int64, targetID int64) (int64, error) {
+	const operationPlace = "postgresql.genrerepo.MergeGenres"
+	log := gr.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	lockGenresQuery := fmt.Sprintf("select %s from genre where %s = any($1) order by %s for update",
+		GenreGenreIDFieldName,
+		GenreGenreIDFieldName,
+		GenreGenreIDFieldName,
+	)
+	targetIsDescendantQuery := fmt.Sprintf(`
+	with recursive descendants(%s) as (
+		select %s from genre where %s = any($1)
+		union
+		select g.%s from genre g join descendants d on g.%s = d.%s
+	)
+	select exists(select 1 from descendants where %s = $2)`,
+		GenreGenreIDFieldName,
+		GenreGenreIDFieldName, GenreParentGenreIDFieldName,
+		GenreGenreIDFieldName, GenreParentGenreIDFieldName, GenreGenreIDFieldName,
+		GenreGenreIDFieldName,
+	)
+	reparentChildrenQuery := fmt.Sprintf("update genre set %s = $1 where %s = any($2)",
+		GenreParentGenreIDFieldName,
+		GenreParentGenreIDFieldName,
+	)
+	relinkGamesQuery := fmt.Sprintf(`
+	insert into game_genre (game_id, %s)
+	select distinct game_id, $1 from game_genre where %s = any($2)
+	on conflict do nothing`,
+		GenreGenreIDFieldName,
+		GenreGenreIDFieldName,
+	)
+	deleteLinksQuery := fmt.Sprintf("delete from game_genre where %s = any($1)", GenreGenreIDFieldName)
+	moveAliasesQuery := fmt.Sprintf("update genre_alias set %s = $1 where %s = any($2)",
+		GenreAliasGenreIDFieldName,
+		GenreAliasGenreIDFieldName,
+	)
+	saveAliasesQuery := fmt.Sprintf(`
+	insert into genre_alias (%s, %s)
+	select %s, $1 from genre where %s = any($2)
+	on conflict (%s) do update set %s = excluded.%s`,
+		GenreAliasAliasNameFieldName,
+		GenreAliasGenreIDFieldName,
+		GenreGenreNameFieldName,
+		GenreGenreIDFieldName,
+		GenreAliasNormalizedNameFieldName,
+		GenreAliasGenreIDFieldName,
+		GenreAliasGenreIDFieldName,
+	)
+	deleteGenresQuery := fmt.Sprintf("delete from genre where %s = any($1)", GenreGenreIDFieldName)
+
+	tx, err := gr.conn.GetPool().Begin(ctx)
+	if err != nil {
+		log.Error(fmt.Sprintf("cannot start transaction, unexpected error = %v", err))
+		return 0, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			log.Error("cannot rollback transaction, unexpected error", slog.String("error", rollbackErr.Error()))
+		}
+	}()
+	if _, err = tx.Exec(ctx, "select pg_advisory_xact_lock($1)", genreHierarchyLockKey); err != nil {
+		log.Error("cannot lock genre hierarchy", slog.String("err", err.Error()))
+		return 0, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if _, err = tx.Exec(ctx, "select pg_advisory_xact_lock($1)", genreNamesLockKey); err != nil {
+		log.Error("cannot lock genre names", slog.String("err", err.Error()))
+		return 0, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	// Блокировки берутся по возрастанию id, чтобы встречные слияния не взаимоблокировались.
+	allIDs := append([]int64{targetID}, sourceIDs...)
+	rows, err := tx.Query(ctx, lockGenresQuery, allIDs)
+	if err != nil {
+		log.Error("cannot lock genres", slog.String("err", err.Error()))
+		return 0, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	locked, err := pgx.CollectRows(rows, pgx.RowTo[int64])
+	if err != nil {
+		log.Error("cannot lock genres", slog.String("err", err.Error()))
+		return 0, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if len(locked) != len(allIDs) {
+		log.Warn("genre not found", slog.Any("genreIDs", allIDs))
+		return 0, fmt.Errorf("%s: %w", operationPlace, outerror.ErrGenreNotFound)
+	}
+	var targetIsDescendant bool
+	if err = tx.QueryRow(ctx, targetIsDescendantQuery, sourceIDs, targetID).Scan(&targetIsDescendant); err != nil {
+		log.Error("cannot check genre hierarchy", slog.String("err", err.Error()))
+		return 0, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if targetIsDescendant {
+		log.Warn("target genre is a sub-genre of source", slog.Any("sourceIDs", sourceIDs), slog.Int64("targetID", targetID))
+		return 0, fmt.Errorf("%s: %w", operationPlace, outerror.ErrGenreCycle)
+	}
+	if _, err = tx.Exec(ctx, reparentChildrenQuery, targetID, sourceIDs); err != nil {
+		log.Error("cannot move sub-genres", slog.String("err", err.Error()))
+		return 0, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	commandTag, err := tx.Exec(ctx, relinkGamesQuery, targetID, sourceIDs)
+	if err != nil {
+		log.Error("cannot relink games", slog.String("err", err.Error()))
+		return 0, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if _, err = tx.Exec(ctx, deleteLinksQuery, sourceIDs); err != nil {
+		log.Error("cannot delete source links", slog.String("err", err.Error()))
+		return 0, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if _, err = tx.Exec(ctx, moveAliasesQuery, targetID, sourceIDs); err != nil {
+		log.Error("cannot move aliases", slog.String("err", err.Error()))
+		return 0, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if _, err = tx.Exec(ctx, saveAliasesQuery, targetID, sourceIDs); err != nil {
+		log.Error("cannot save aliases", slog.String("err", err.Error()))
+		return 0, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if _, err = tx.Exec(ctx, deleteGenresQuery, sourceIDs); err != nil {
+		log.Error("cannot delete source genres", slog.String("err", err.Error()))
+		return 0, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		log.Error(fmt.Sprintf("cannot commit transaction, unexpected error = %v", err))
+		return 0, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return commandTag.RowsAffected(), nil
+}
@@ -0,0 +1,61 @@
+package genrerepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/outerror"
+	"github.com/sariya23/game_service/internal/storage/db"
+)
+
+// RenameGenre меняет имя жанра. Связи с играми сохраняются. Имя не должно
+// совпадать с другим жанром или чужим алиасом, иначе ErrGenreAlreadyExist.
+func (gr *GenreRepository) RenameGenre(ctx context.Context, genreID int64, genreName string) error {
+	const operationPlace = "postgresql.genrerepo.RenameGenre"
+	log := gr.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	renameGenreQuery := fmt.Sprintf("update genre set %s=$1 where %s=$2", GenreGenreNameFieldName, GenreGenreIDFieldName)
+
+	tx, err := gr.conn.GetPool().Begin(ctx)
+	if err != nil {
+		log.Error(fmt.Sprintf("cannot start transaction, unexpected error = %v", err))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			log.Error("cannot rollback transaction, unexpected error", slog.String("error", rollbackErr.Error()))
+		}
+	}()
+	taken, err := genreAliasTakenTx(ctx, tx, genreName, genreID)
+	if err != nil {
+		log.Error("cannot check genre aliases", slog.String("genreName", genreName), slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if taken {
+		log.Warn("genre alias already exists", slog.String("genreName", genreName))
+		return fmt.Errorf("%s: %w", operationPlace, outerror.ErrGenreAlreadyExist)
+	}
+	commandTag, err := tx.Exec(ctx, renameGenreQuery, genreName, genreID)
+	if err != nil {
+		if db.IsUniqueViolation(err) {
+			log.Warn("genre already exists", slog.String("genreName", genreName))
+			return fmt.Errorf("%s: %w", operationPlace, outerror.ErrGenreAlreadyExist)
+		}
+		log.Error("cannot rename genre", slog.Int64("genreID", genreID), slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if commandTag.RowsAffected() == 0 {
+		log.Warn("genre not found", slog.Int64("genreID", genreID))
+		return fmt.Errorf("%s: %w", operationPlace, outerror.ErrGenreNotFound)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		log.Error(fmt.Sprintf("cannot commit transaction, unexpected error = %v", err))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return nil
+}
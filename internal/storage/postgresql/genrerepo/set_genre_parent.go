@@ -0,0 +1,85 @@
+package genrerepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/outerror"
+)
+
+// SetGenreParent делает parentID родителем жанра genreID, nil делает жанр корневым.
+// Если parentID - сам жанр или его потомок, возвращается ErrGenreCycle.
+func (gr *GenreRepository) SetGenreParent(ctx context.Context, genreID int64, parentID *int64) error {
+	const operationPlace = "postgresql.genrerepo.SetGenreParent"
+	log := gr.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	countGenresQuery := fmt.Sprintf("select count(*) from genre where %s = any($1)", GenreGenreIDFieldName)
+	// Поднимаемся от нового родителя к корню: если по пути встретился
+	// сам жанр, новая связь замкнет цикл.
+	cycleQuery := fmt.Sprintf(`
+	with recursive ancestors(%s, %s) as (
+		select %s, %s from genre where %s = $1
+		union
+		select g.%s, g.%s from genre g join ancestors a on g.%s = a.%s
+	)
+	select exists(select 1 from ancestors where %s = $2)`,
+		GenreGenreIDFieldName, GenreParentGenreIDFieldName,
+		GenreGenreIDFieldName, GenreParentGenreIDFieldName, GenreGenreIDFieldName,
+		GenreGenreIDFieldName, GenreParentGenreIDFieldName, GenreGenreIDFieldName, GenreParentGenreIDFieldName,
+		GenreGenreIDFieldName,
+	)
+	setParentQuery := fmt.Sprintf("update genre set %s = $1 where %s = $2", GenreParentGenreIDFieldName, GenreGenreIDFieldName)
+
+	tx, err := gr.conn.GetPool().Begin(ctx)
+	if err != nil {
+		log.Error(fmt.Sprintf("cannot start transaction, unexpected error = %v", err))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			log.Error("cannot rollback transaction, unexpected error", slog.String("error", rollbackErr.Error()))
+		}
+	}()
+	if _, err = tx.Exec(ctx, "select pg_advisory_xact_lock($1)", genreHierarchyLockKey); err != nil {
+		log.Error("cannot lock genre hierarchy", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	ids := []int64{genreID}
+	if parentID != nil {
+		ids = append(ids, *parentID)
+	}
+	var found int
+	if err = tx.QueryRow(ctx, countGenresQuery, ids).Scan(&found); err != nil {
+		log.Error("cannot check genres", slog.Int64("genreID", genreID), slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if found != len(ids) {
+		log.Warn("genre not found", slog.Any("genreIDs", ids))
+		return fmt.Errorf("%s: %w", operationPlace, outerror.ErrGenreNotFound)
+	}
+	if parentID != nil {
+		var cycle bool
+		if err = tx.QueryRow(ctx, cycleQuery, *parentID, genreID).Scan(&cycle); err != nil {
+			log.Error("cannot check genre cycle", slog.Int64("genreID", genreID), slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		if cycle {
+			log.Warn("genre cycle", slog.Int64("genreID", genreID), slog.Int64("parentID", *parentID))
+			return fmt.Errorf("%s: %w", operationPlace, outerror.ErrGenreCycle)
+		}
+	}
+	if _, err = tx.Exec(ctx, setParentQuery, parentID, genreID); err != nil {
+		log.Error("cannot set genre parent", slog.Int64("genreID", genreID), slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		log.Error(fmt.Sprintf("cannot commit transaction, unexpected error = %v", err))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package outboxrepo
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/model"
+)
+
+// ListEventsAfter возвращает до limit событий с outbox_id больше afterID
+// по возрастанию outbox_id.
+func (ob *OutboxRepository) ListEventsAfter(ctx context.Context, afterID int64, limit uint32) ([]model.OutboxEvent, error) {
+	const operationPlace = "postgresql.outboxrepo.ListEventsAfter"
+	log := ob.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	listEventsQuery := fmt.Sprintf(`
+	select %s, %s, %s, %s, %s, %s
+	from %s
+	where %s > $1
+	order by %s
+	limit $2`,
+		OutboxOutboxIDFieldName,
+		OutboxGameIDFieldName,
+		OutboxEventTypeFieldName,
+		OutboxPayloadFieldName,
+		OutboxAttemptsFieldName,
+		OutboxCreatedAtFieldName,
+		OutboxTable,
+		OutboxOutboxIDFieldName,
+		OutboxOutboxIDFieldName,
+	)
+	rows, err := ob.conn.GetPool().Query(ctx, listEventsQuery, afterID, limit)
+	if err != nil {
+		log.Error("cannot get outbox events", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer rows.Close()
+	var outboxEvents []model.OutboxEvent
+	for rows.Next() {
+		var event model.OutboxEvent
+		err = rows.Scan(&event.OutboxID, &event.GameID, &event.EventType, &event.Payload, &event.Attempts, &event.CreatedAt)
+		if err != nil {
+			log.Error("cannot scan outbox event", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		outboxEvents = append(outboxEvents, event)
+	}
+	if err = rows.Err(); err != nil {
+		log.Error("cannot prepare next row", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return outboxEvents, nil
+}
+
+// ListRecentEvents возвращает до limit событий с outbox_id в (afterID, beforeID),
+// созданных не раньше createdSince, по возрастанию outbox_id. Нужен, чтобы
+// найти события, закоммиченные позже событий с большим outbox_id.
+func (ob *OutboxRepository) ListRecentEvents(ctx context.Context, afterID, beforeID int64, createdSince time.Time, limit uint32) ([]model.OutboxEvent, error) {
+	const operationPlace = "postgresql.outboxrepo.ListRecentEvents"
+	log := ob.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	listRecentEventsQuery := fmt.Sprintf(`
+	select %s, %s, %s, %s, %s, %s
+	from %s
+	where %s >= $1 and %s > $2 and %s < $3
+	order by %s
+	limit $4`,
+		OutboxOutboxIDFieldName,
+		OutboxGameIDFieldName,
+		OutboxEventTypeFieldName,
+		OutboxPayloadFieldName,
+		OutboxAttemptsFieldName,
+		OutboxCreatedAtFieldName,
+		OutboxTable,
+		OutboxCreatedAtFieldName,
+		OutboxOutboxIDFieldName,
+		OutboxOutboxIDFieldName,
+		OutboxOutboxIDFieldName,
+	)
+	rows, err := ob.conn.GetPool().Query(ctx, listRecentEventsQuery, createdSince, afterID, beforeID, limit)
+	if err != nil {
+		log.Error("cannot get recent outbox events", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	outboxEvents, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.OutboxEvent, error) {
+		var event model.OutboxEvent
+		err := row.Scan(&event.OutboxID, &event.GameID, &event.EventType, &event.Payload, &event.Attempts, &event.CreatedAt)
+		return event, err
+	})
+	if err != nil {
+		log.Error("cannot scan recent outbox events", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return outboxEvents, nil
+}
+
+// LastEventID возвращает наибольший outbox_id или 0, если событий нет.
+func (ob *OutboxRepository) LastEventID(ctx context.Context) (int64, error) {
+	const operationPlace = "postgresql.outboxrepo.LastEventID"
+	log := ob.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	lastEventIDQuery := fmt.Sprintf("select coalesce(max(%s), 0) from %s", OutboxOutboxIDFieldName, OutboxTable)
+	var lastID int64
+	if err := ob.conn.GetPool().QueryRow(ctx, lastEventIDQuery).Scan(&lastID); err != nil {
+		log.Error("cannot get last outbox id", slog.String("err", err.Error()))
+		return 0, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return lastID, nil
+}
@@ -0,0 +1,46 @@
+package outboxrepo
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/sariya23/game_service/internal/lib/logger"
+)
+
+// ListenEvents занимает соединение из пула, подписывается на OutboxNotifyChannel
+// и вызывает onNotify с outbox_id каждого закоммиченного события.
+// onConnected вызывается после LISTEN, до первого уведомления.
+// Блокируется до отмены ctx или обрыва соединения.
+func (ob *OutboxRepository) ListenEvents(ctx context.Context, onConnected func(), onNotify func(outboxID int64)) error {
+	const operationPlace = "postgresql.outboxrepo.ListenEvents"
+	log := ob.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	conn, err := ob.conn.GetPool().Acquire(ctx)
+	if err != nil {
+		log.Error("cannot acquire connection", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	// Соединение в состоянии LISTEN нельзя возвращать в пул.
+	listenConn := conn.Hijack()
+	defer listenConn.Close(context.Background())
+	if _, err = listenConn.Exec(ctx, "listen "+pgx.Identifier{OutboxNotifyChannel}.Sanitize()); err != nil {
+		log.Error("cannot listen channel", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	onConnected()
+	for {
+		notification, err := listenConn.WaitForNotification(ctx)
+		if err != nil {
+			return fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		outboxID, err := strconv.ParseInt(notification.Payload, 10, 64)
+		if err != nil {
+			log.Warn("unexpected notification payload", slog.String("payload", notification.Payload))
+			continue
+		}
+		onNotify(outboxID)
+	}
+}
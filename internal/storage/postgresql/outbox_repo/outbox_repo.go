@@ -0,0 +1,37 @@
+package outboxrepo
+
+import (
+	"log/slog"
+
+	"github.com/sariya23/game_service/internal/storage/db"
+)
+
+const (
+	OutboxTable                  = "outbox"
+	OutboxOutboxIDFieldName      = "outbox_id"
+	OutboxGameIDFieldName        = "game_id"
+	OutboxEventTypeFieldName     = "event_type"
+	OutboxPayloadFieldName       = "payload"
+	OutboxCreatedAtFieldName     = "created_at"
+	OutboxPublishedAtFieldName   = "published_at"
+	OutboxAttemptsFieldName      = "attempts"
+	OutboxNextAttemptAtFieldName = "next_attempt_at"
+	OutboxLastErrorFieldName     = "last_error"
+	OutboxDeadAtFieldName        = "dead_at"
+
+	// OutboxNotifyChannel - канал LISTEN/NOTIFY, в который пишется outbox_id
+	// каждого нового события.
+	OutboxNotifyChannel = "game_events"
+)
+
+type OutboxRepository struct {
+	conn *db.Database
+	log  *slog.Logger
+}
+
+func NewOutboxRepository(conn *db.Database, log *slog.Logger) *OutboxRepository {
+	return &OutboxRepository{
+		conn: conn,
+		log:  log,
+	}
+}
@@ -0,0 +1,169 @@
+package outboxrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/model"
+)
+
+// RelayBatch в одной транзакции забирает до limit событий и передает их в publish.
+// Берется только самое раннее недоставленное событие каждой игры, поэтому
+// события одной игры доставляются по порядку, а следующее ждет, пока
+// не доставлено предыдущее. Строки берутся через for update skip locked,
+// чтобы реплики не доставляли одно событие параллельно. После ошибки
+// следующая попытка откладывается на retryDelay(attempts). Событие, не
+// доставленное за maxAttempts попыток, помечается dead_at и попадает в dead,
+// чтобы не держать очередь своей игры.
+func (ob *OutboxRepository) RelayBatch(
+	ctx context.Context,
+	limit uint32,
+	maxAttempts int,
+	publish func(model.OutboxEvent) error,
+	retryDelay func(attempt int) time.Duration,
+) (published int, failed int, dead []model.OutboxEvent, err error) {
+	const operationPlace = "postgresql.outboxrepo.RelayBatch"
+	log := ob.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	claimEventsQuery := fmt.Sprintf(`
+	select o.%s, o.%s, o.%s, o.%s, o.%s, o.%s
+	from %s o
+	where o.%s is null and o.%s is null and o.%s <= now()
+		and not exists (
+			select 1 from %s prev
+			where prev.%s = o.%s and prev.%s is null and prev.%s is null and prev.%s < o.%s
+		)
+	order by o.%s
+	limit $1
+	for update skip locked`,
+		OutboxOutboxIDFieldName,
+		OutboxGameIDFieldName,
+		OutboxEventTypeFieldName,
+		OutboxPayloadFieldName,
+		OutboxAttemptsFieldName,
+		OutboxCreatedAtFieldName,
+		OutboxTable,
+		OutboxPublishedAtFieldName,
+		OutboxDeadAtFieldName,
+		OutboxNextAttemptAtFieldName,
+		OutboxTable,
+		OutboxGameIDFieldName,
+		OutboxGameIDFieldName,
+		OutboxPublishedAtFieldName,
+		OutboxDeadAtFieldName,
+		OutboxOutboxIDFieldName,
+		OutboxOutboxIDFieldName,
+		OutboxOutboxIDFieldName,
+	)
+	markPublishedQuery := fmt.Sprintf("update %s set %s=now(), %s=%s+1, %s='' where %s=$1",
+		OutboxTable,
+		OutboxPublishedAtFieldName,
+		OutboxAttemptsFieldName,
+		OutboxAttemptsFieldName,
+		OutboxLastErrorFieldName,
+		OutboxOutboxIDFieldName,
+	)
+	markDeadQuery := fmt.Sprintf("update %s set %s=now(), %s=%s+1, %s=$1 where %s=$2",
+		OutboxTable,
+		OutboxDeadAtFieldName,
+		OutboxAttemptsFieldName,
+		OutboxAttemptsFieldName,
+		OutboxLastErrorFieldName,
+		OutboxOutboxIDFieldName,
+	)
+	markFailedQuery := fmt.Sprintf("update %s set %s=%s+1, %s=$1, %s=$2 where %s=$3",
+		OutboxTable,
+		OutboxAttemptsFieldName,
+		OutboxAttemptsFieldName,
+		OutboxNextAttemptAtFieldName,
+		OutboxLastErrorFieldName,
+		OutboxOutboxIDFieldName,
+	)
+
+	tx, err := ob.conn.GetPool().Begin(ctx)
+	if err != nil {
+		log.Error(fmt.Sprintf("cannot start transaction, unexpected error = %v", err))
+		return 0, 0, nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			log.Error("cannot rollback transaction, unexpected error", slog.String("error", rollbackErr.Error()))
+		}
+	}()
+
+	rows, err := tx.Query(ctx, claimEventsQuery, limit)
+	if err != nil {
+		log.Error("cannot claim outbox events", slog.String("err", err.Error()))
+		return 0, 0, nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	var claimed []model.OutboxEvent
+	for rows.Next() {
+		var event model.OutboxEvent
+		err = rows.Scan(&event.OutboxID, &event.GameID, &event.EventType, &event.Payload, &event.Attempts, &event.CreatedAt)
+		if err != nil {
+			rows.Close()
+			log.Error("cannot scan outbox event", slog.String("err", err.Error()))
+			return 0, 0, nil, fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		claimed = append(claimed, event)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		log.Error("cannot prepare next row", slog.String("err", err.Error()))
+		return 0, 0, nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if len(claimed) == 0 {
+		return 0, 0, nil, nil
+	}
+
+	for _, event := range claimed {
+		if publishErr := publish(event); publishErr != nil {
+			if event.Attempts+1 >= maxAttempts {
+				if _, err = tx.Exec(ctx, markDeadQuery, publishErr.Error(), event.OutboxID); err != nil {
+					log.Error("cannot mark outbox event as dead", slog.Int64("outboxID", event.OutboxID), slog.String("err", err.Error()))
+					return 0, 0, nil, fmt.Errorf("%s: %w", operationPlace, err)
+				}
+				log.Error("outbox event is dead, giving up",
+					slog.Int64("outboxID", event.OutboxID),
+					slog.Int64("gameID", event.GameID),
+					slog.String("eventType", event.EventType),
+					slog.Int("attempts", event.Attempts+1),
+					slog.String("err", publishErr.Error()),
+				)
+				event.Attempts++
+				dead = append(dead, event)
+				continue
+			}
+			failed++
+			nextAttemptAt := time.Now().Add(retryDelay(event.Attempts + 1))
+			log.Warn("cannot publish outbox event",
+				slog.Int64("outboxID", event.OutboxID),
+				slog.Int64("gameID", event.GameID),
+				slog.Int("attempt", event.Attempts+1),
+				slog.Time("nextAttemptAt", nextAttemptAt),
+				slog.String("err", publishErr.Error()),
+			)
+			if _, err = tx.Exec(ctx, markFailedQuery, nextAttemptAt, publishErr.Error(), event.OutboxID); err != nil {
+				log.Error("cannot mark outbox event as failed", slog.Int64("outboxID", event.OutboxID), slog.String("err", err.Error()))
+				return 0, 0, nil, fmt.Errorf("%s: %w", operationPlace, err)
+			}
+			continue
+		}
+		if _, err = tx.Exec(ctx, markPublishedQuery, event.OutboxID); err != nil {
+			log.Error("cannot mark outbox event as published", slog.Int64("outboxID", event.OutboxID), slog.String("err", err.Error()))
+			return 0, 0, nil, fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		published++
+	}
+	if err = tx.Commit(ctx); err != nil {
+		log.Error(fmt.Sprintf("cannot commit transaction, unexpected error = %v", err))
+		return 0, 0, nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return published, failed, dead, nil
+}
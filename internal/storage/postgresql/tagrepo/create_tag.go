@@ -0,0 +1,59 @@
+package tagrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/model"
+	"github.com/sariya23/game_service/internal/outerror"
+	"github.com/sariya23/game_service/internal/storage/db"
+)
+
+// CreateTag создает тэг. Если тэг или алиас с таким именем уже есть,
+// возвращается ErrTagAlreadyExist.
+func (tr *TagRepository) CreateTag(ctx context.Context, tagName string) (model.Tag, error) {
+	const operationPlace = "postgresql.tagrepo.CreateTag"
+	log := tr.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	createTagQuery := fmt.Sprintf("insert into tag (%s) values ($1) returning %s", TagTagNameFieldName, TagTagIDFieldName)
+
+	tx, err := tr.conn.GetPool().Begin(ctx)
+	if err != nil {
+		log.Error(fmt.Sprintf("cannot start transaction, unexpected error = %v", err))
+		return model.Tag{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			log.Error("cannot rollback transaction, unexpected error", slog.String("error", rollbackErr.Error()))
+		}
+	}()
+	taken, err := tagAliasTakenTx(ctx, tx, tagName, 0)
+	if err != nil {
+		log.Error("cannot check tag aliases", slog.String("tagName", tagName), slog.String("err", err.Error()))
+		return model.Tag{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if taken {
+		log.Warn("tag alias already exists", slog.String("tagName", tagName))
+		return model.Tag{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrTagAlreadyExist)
+	}
+	tag := model.Tag{TagName: tagName}
+	err = tx.QueryRow(ctx, createTagQuery, tagName).Scan(&tag.TagID)
+	if err != nil {
+		if db.IsUniqueViolation(err) {
+			log.Warn("tag already exists", slog.String("tagName", tagName))
+			return model.Tag{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrTagAlreadyExist)
+		}
+		log.Error("cannot create tag", slog.String("tagName", tagName), slog.String("err", err.Error()))
+		return model.Tag{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		log.Error(fmt.Sprintf("cannot commit transaction, unexpected error = %v", err))
+		return model.Tag{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return tag, nil
+}
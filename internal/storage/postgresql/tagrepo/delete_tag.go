@@ -0,0 +1,68 @@
+package tagrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/outerror"
+)
+
+// DeleteTag удаляет тэг. Если тэг привязан к играм, без force возвращается
+// ErrTagInUse, с force связи удаляются вместе с тэгом. Возвращает число
+// игр, у которых тэг был отвязан.
+func (tr *TagRepository) DeleteTag(ctx context.Context, tagID int64, force bool) (int64, error) {
+	const operationPlace = "postgresql.tagrepo.DeleteTag"
+	log := tr.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	lockTagQuery := fmt.Sprintf("select %s from tag where %s=$1 for update", TagTagIDFieldName, TagTagIDFieldName)
+	countLinksQuery := fmt.Sprintf("select count(*) from game_tag where %s=$1", TagTagIDFieldName)
+	deleteLinksQuery := fmt.Sprintf("delete from game_tag where %s=$1", TagTagIDFieldName)
+	deleteTagQuery := fmt.Sprintf("delete from tag where %s=$1", TagTagIDFieldName)
+
+	tx, err := tr.conn.GetPool().Begin(ctx)
+	if err != nil {
+		log.Error(fmt.Sprintf("cannot start transaction, unexpected error = %v", err))
+		return 0, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			log.Error("cannot rollback transaction, unexpected error", slog.String("error", rollbackErr.Error()))
+		}
+	}()
+	var lockedID int64
+	if err = tx.QueryRow(ctx, lockTagQuery, tagID).Scan(&lockedID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			log.Warn("tag not found", slog.Int64("tagID", tagID))
+			return 0, fmt.Errorf("%s: %w", operationPlace, outerror.ErrTagNotFound)
+		}
+		log.Error("cannot lock tag", slog.Int64("tagID", tagID), slog.String("err", err.Error()))
+		return 0, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	var links int64
+	if err = tx.QueryRow(ctx, countLinksQuery, tagID).Scan(&links); err != nil {
+		log.Error("cannot count tag links", slog.Int64("tagID", tagID), slog.String("err", err.Error()))
+		return 0, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if links > 0 && !force {
+		log.Warn("tag is linked to games", slog.Int64("tagID", tagID), slog.Int64("games", links))
+		return 0, fmt.Errorf("%s: %w", operationPlace, outerror.ErrTagInUse)
+	}
+	if _, err = tx.Exec(ctx, deleteLinksQuery, tagID); err != nil {
+		log.Error("cannot delete tag links", slog.Int64("tagID", tagID), slog.String("err", err.Error()))
+		return 0, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if _, err = tx.Exec(ctx, deleteTagQuery, tagID); err != nil {
+		log.Error("cannot delete tag", slog.Int64("tagID", tagID), slog.String("err", err.Error()))
+		return 0, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		log.Error(fmt.Sprintf("cannot commit transaction, unexpected error = %v", err))
+		return 0, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return links, nil
+}
@@ -10,11 +10,33 @@ import (
 	"github.com/sariya23/game_service/internal/outerror"
 )
 
+// GetTagByNames возвращает тэги по именам без учета регистра и лишних пробелов.
+// Имя ищется сначала среди тэгов, потом среди алиасов слитых тэгов. Тэг,
+// найденный по нескольким именам, возвращается один раз. Если какие-то имена
+// не найдены, возвращается UnknownNamesError с ErrTagNotFound и этими именами.
 func (tr *TagRepository) GetTagByNames(ctx context.Context, tags []string) ([]model.Tag, error) {
 	const operationPlace = "postgresql.GetTags"
 	log := tr.log.With("operationPlace", operationPlace)
 	log = logger.EnrichRequestID(ctx, log)
-	getTagsQuery := fmt.Sprintf("select %s, %s from tag where %s=any($1)", TagTagIDFieldName, TagTagNameFieldName, TagTagNameFieldName)
+	getTagsQuery := fmt.Sprintf(`
+	with wanted as (
+		select btrim(n.name) as name, normalize_name(n.name) as normalized, n.ord
+		from unnest($1::varchar[]) with ordinality as n(name, ord)
+	)
+	select w.name, w.normalized, t.%s, t.%s from wanted w
+	left join tag t on t.%s = coalesce(
+		(select %s from tag where %s = w.normalized),
+		(select %s from tag_alias where %s = w.normalized)
+	)
+	order by w.ord`,
+		TagTagIDFieldName,
+		TagTagNameFieldName,
+		TagTagIDFieldName,
+		TagTagIDFieldName,
+		TagNormalizedNameFieldName,
+		TagAliasTagIDFieldName,
+		TagAliasNormalizedNameFieldName,
+	)
 	tagRows, err := tr.conn.GetPool().Query(ctx, getTagsQuery, tags)
 	if err != nil {
 		log.Error(fmt.Sprintf("Cannot get tags from request, uncaught error: %v", err))
@@ -22,21 +44,37 @@ func (tr *TagRepository) GetTagByNames(ctx context.Context, tags []string) ([]mo
 	}
 	defer tagRows.Close()
 	tagModels := make([]model.Tag, 0, len(tags))
+	seenTags := make(map[int64]struct{}, len(tags))
+	seenUnknown := make(map[string]struct{})
+	var unknown []string
 	for tagRows.Next() {
-		var modelTag model.Tag
-		err = tagRows.Scan(&modelTag.TagID, &modelTag.TagName)
+		var name, normalized string
+		var tagID *int64
+		var tagName *string
+		err = tagRows.Scan(&name, &normalized, &tagID, &tagName)
 		if err != nil {
 			log.Error("cannot scan tag", slog.String("err", err.Error()))
 			return nil, fmt.Errorf("%s: %w", operationPlace, err)
 		}
-		if rowErr := tagRows.Err(); rowErr != nil {
-			log.Error("cannot prepare next row", slog.String("err", rowErr.Error()))
-			return nil, fmt.Errorf("%s: %w", operationPlace, err)
+		if tagID == nil {
+			if _, ok := seenUnknown[normalized]; !ok {
+				seenUnknown[normalized] = struct{}{}
+				unknown = append(unknown, name)
+			}
+			continue
+		}
+		if _, ok := seenTags[*tagID]; ok {
+			continue
 		}
-		tagModels = append(tagModels, modelTag)
+		seenTags[*tagID] = struct{}{}
+		tagModels = append(tagModels, model.Tag{TagID: *tagID, TagName: *tagName})
+	}
+	if rowErr := tagRows.Err(); rowErr != nil {
+		log.Error("cannot prepare next row", slog.String("err", rowErr.Error()))
+		return nil, fmt.Errorf("%s: %w", operationPlace, rowErr)
 	}
-	if len(tagModels) != len(tags) {
-		return nil, fmt.Errorf("%s: %w", operationPlace, outerror.ErrTagNotFound)
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("%s: %w", operationPlace, &outerror.UnknownNamesError{Err: outerror.ErrTagNotFound, Names: unknown})
 	}
 	return tagModels, nil
 }
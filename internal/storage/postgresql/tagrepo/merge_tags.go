@@ -0,0 +1,109 @@
+package tagrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/outerror"
+)
+
+// MergeTags сливает тэги sourceIDs в targetID: связи с играми переносятся
+// без дублей, исходные тэги удаляются, а их имена и алиасы становятся
+// алиасами целевого. Возвращает число игр, получивших целевой тэг.
+func (tr *TagRepository) MergeTags(ctx context.Context, sourceIDs []int64, targetID int64) (int64, error) {
+	const operationPlace = "postgresql.tagrepo.MergeTags"
+	log := tr.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	lockTagsQuery := fmt.Sprintf("select %s from tag where %s = any($1) order by %s for update",
+		TagTagIDFieldName,
+		TagTagIDFieldName,
+		TagTagIDFieldName,
+	)
+	relinkGamesQuery := fmt.Sprintf(`
+	insert into game_tag (game_id, %s)
+	select distinct game_id, $1 from game_tag where %s = any($2)
+	on conflict do nothing`,
+		TagTagIDFieldName,
+		TagTagIDFieldName,
+	)
+	deleteLinksQuery := fmt.Sprintf("delete from game_tag where %s = any($1)", TagTagIDFieldName)
+	moveAliasesQuery := fmt.Sprintf("update tag_alias set %s = $1 where %s = any($2)",
+		TagAliasTagIDFieldName,
+		TagAliasTagIDFieldName,
+	)
+	saveAliasesQuery := fmt.Sprintf(`
+	insert into tag_alias (%s, %s)
+	select %s, $1 from tag where %s = any($2)
+	on conflict (%s) do update set %s = excluded.%s`,
+		TagAliasAliasNameFieldName,
+		TagAliasTagIDFieldName,
+		TagTagNameFieldName,
+		TagTagIDFieldName,
+		TagAliasNormalizedNameFieldName,
+		TagAliasTagIDFieldName,
+		TagAliasTagIDFieldName,
+	)
+	deleteTagsQuery := fmt.Sprintf("delete from tag where %s = any($1)", TagTagIDFieldName)
+
+	tx, err := tr.conn.GetPool().Begin(ctx)
+	if err != nil {
+		log.Error(fmt.Sprintf("cannot start transaction, unexpected error = %v", err))
+		return 0, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			log.Error("cannot rollback transaction, unexpected error", slog.String("error", rollbackErr.Error()))
+		}
+	}()
+	if _, err = tx.Exec(ctx, "select pg_advisory_xact_lock($1)", tagNamesLockKey); err != nil {
+		log.Error("cannot lock tag names", slog.String("err", err.Error()))
+		return 0, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	// Блокировки берутся по возрастанию id, чтобы встречные слияния не взаимоблокировались.
+	allIDs := append([]int64{targetID}, sourceIDs...)
+	rows, err := tx.Query(ctx, lockTagsQuery, allIDs)
+	if err != nil {
+		log.Error("cannot lock tags", slog.String("err", err.Error()))
+		return 0, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	locked, err := pgx.CollectRows(rows, pgx.RowTo[int64])
+	if err != nil {
+		log.Error("cannot lock tags", slog.String("err", err.Error()))
+		return 0, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if len(locked) != len(allIDs) {
+		log.Warn("tag not found", slog.Any("tagIDs", allIDs))
+		return 0, fmt.Errorf("%s: %w", operationPlace, outerror.ErrTagNotFound)
+	}
+	commandTag, err := tx.Exec(ctx, relinkGamesQuery, targetID, sourceIDs)
+	if err != nil {
+		log.Error("cannot relink games", slog.String("err", err.Error()))
+		return 0, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if _, err = tx.Exec(ctx, deleteLinksQuery, sourceIDs); err != nil {
+		log.Error("cannot delete source links", slog.String("err", err.Error()))
+		return 0, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if _, err = tx.Exec(ctx, moveAliasesQuery, targetID, sourceIDs); err != nil {
+		log.Error("cannot move aliases", slog.String("err", err.Error()))
+		return 0, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if _, err = tx.Exec(ctx, saveAliasesQuery, targetID, sourceIDs); err != nil {
+		log.Error("cannot save aliases", slog.String("err", err.Error()))
+		return 0, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if _, err = tx.Exec(ctx, deleteTagsQuery, sourceIDs); err != nil {
+		log.Error("cannot delete source tags", slog.String("err", err.Error()))
+		return 0, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		log.Error(fmt.Sprintf("cannot commit transaction, unexpected error = %v", err))
+		return 0, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return commandTag.RowsAffected(), nil
+}
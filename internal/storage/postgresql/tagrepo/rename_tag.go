@@ -0,0 +1,61 @@
+package tagrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/outerror"
+	"github.com/sariya23/game_service/internal/storage/db"
+)
+
+// RenameTag меняет имя тэга. Связи с играми сохраняются. Имя не должно
+// совпадать с другим тэгом или чужим алиасом, иначе ErrTagAlreadyExist.
+func (tr *TagRepository) RenameTag(ctx context.Context, tagID int64, tagName string) error {
+	const operationPlace = "postgresql.tagrepo.RenameTag"
+	log := tr.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	renameTagQuery := fmt.Sprintf("update tag set %s=$1 where %s=$2", TagTagNameFieldName, TagTagIDFieldName)
+
+	tx, err := tr.conn.GetPool().Begin(ctx)
+	if err != nil {
+		log.Error(fmt.Sprintf("cannot start transaction, unexpected error = %v", err))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			log.Error("cannot rollback transaction, unexpected error", slog.String("error", rollbackErr.Error()))
+		}
+	}()
+	taken, err := tagAliasTakenTx(ctx, tx, tagName, tagID)
+	if err != nil {
+		log.Error("cannot check tag aliases", slog.String("tagName", tagName), slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if taken {
+		log.Warn("tag alias already exists", slog.String("tagName", tagName))
+		return fmt.Errorf("%s: %w", operationPlace, outerror.ErrTagAlreadyExist)
+	}
+	commandTag, err := tx.Exec(ctx, renameTagQuery, tagName, tagID)
+	if err != nil {
+		if db.IsUniqueViolation(err) {
+			log.Warn("tag already exists", slog.String("tagName", tagName))
+			return fmt.Errorf("%s: %w", operationPlace, outerror.ErrTagAlreadyExist)
+		}
+		log.Error("cannot rename tag", slog.Int64("tagID", tagID), slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if commandTag.RowsAffected() == 0 {
+		log.Warn("tag not found", slog.Int64("tagID", tagID))
+		return fmt.Errorf("%s: %w", operationPlace, outerror.ErrTagNotFound)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		log.Error(fmt.Sprintf("cannot commit transaction, unexpected error = %v", err))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return nil
+}
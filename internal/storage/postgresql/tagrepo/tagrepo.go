@@ -1,14 +1,30 @@
 package tagrepo
 
 import (
+	"context"
+	"fmt"
 	"log/slog"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/sariya23/game_service/internal/storage/db"
 )
 
 const (
 	TagTagIDFieldName   = "tag_id"
 	TagTagNameFieldName = "tag_name"
+	// TagNormalizedNameFieldName - normalize_name(tag_name), по нему ищутся тэги.
+	TagNormalizedNameFieldName = "normalized_name"
+)
+
+// tagNamesLockKey - ключ advisory-блокировки, под которой меняются имена
+// и алиасы тэгов. Уникальность имени проверяется сразу по двум таблицам,
+// и без блокировки встречные запросы могли бы занять одно имя.
+const tagNamesLockKey = 7208245302
+
+const (
+	TagAliasAliasNameFieldName      = "alias_name"
+	TagAliasTagIDFieldName          = "tag_id"
+	TagAliasNormalizedNameFieldName = "normalized_name"
 )
 
 type TagRepository struct {
@@ -22,3 +38,20 @@ func NewTagRepository(conn *db.Database, log *slog.Logger) *TagRepository {
 		log:  log,
 	}
 }
+
+// tagAliasTakenTx берет блокировку имен и проверяет, занято ли имя
+// алиасом. Алиасы tagID не учитываются, 0 - проверить все.
+func tagAliasTakenTx(ctx context.Context, tx pgx.Tx, name string, tagID int64) (bool, error) {
+	aliasTakenQuery := fmt.Sprintf("select exists(select 1 from tag_alias where %s = normalize_name($1) and %s <> $2)",
+		TagAliasNormalizedNameFieldName,
+		TagAliasTagIDFieldName,
+	)
+	if _, err := tx.Exec(ctx, "select pg_advisory_xact_lock($1)", tagNamesLockKey); err != nil {
+		return false, err
+	}
+	var taken bool
+	if err := tx.QueryRow(ctx, aliasTakenQuery, name, tagID).Scan(&taken); err != nil {
+		return false, err
+	}
+	return taken, nil
+}
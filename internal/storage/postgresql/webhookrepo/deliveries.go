@@ -0,0 +1,197 @@
+package webhookrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/model"
+	"github.com/sariya23/game_service/internal/model/dto"
+)
+
+// EnqueueDeliveries создает доставку события во все подходящие webhook.
+// Повтор того же события не создает дублей. Возвращает число новых доставок.
+func (wr *WebhookRepository) EnqueueDeliveries(ctx context.Context, event model.OutboxEvent) (int64, error) {
+	const operationPlace = "postgresql.webhookrepo.EnqueueDeliveries"
+	log := wr.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	enqueueQuery := fmt.Sprintf(`
+	insert into %s (%s, %s, %s, %s)
+	select %s, $1, $2, $3 from %s
+	where cardinality(%s) = 0 or $2 = any(%s)
+	on conflict (%s, %s) do nothing`,
+		WebhookDeliveryTable,
+		WebhookDeliveryWebhookIDFieldName,
+		WebhookDeliveryOutboxIDFieldName,
+		WebhookDeliveryEventTypeFieldName,
+		WebhookDeliveryPayloadFieldName,
+		WebhookWebhookIDFieldName,
+		WebhookTable,
+		WebhookEventTypesFieldName,
+		WebhookEventTypesFieldName,
+		WebhookDeliveryWebhookIDFieldName,
+		WebhookDeliveryOutboxIDFieldName,
+	)
+	tag, err := wr.conn.GetPool().Exec(ctx, enqueueQuery, event.OutboxID, event.EventType, event.Payload)
+	if err != nil {
+		log.Error("cannot enqueue webhook deliveries", slog.Int64("outboxID", event.OutboxID), slog.String("err", err.Error()))
+		return 0, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+// ClaimDeliveries забирает до limit доставок, время которых наступило,
+// и сдвигает их следующую попытку на lease. Если воркер упадет во время
+// отправки, доставка вернется в очередь после lease. Строки берутся через
+// skip locked, чтобы реплики не отправляли одно и то же.
+func (wr *WebhookRepository) ClaimDeliveries(ctx context.Context, limit uint32, lease time.Duration) ([]model.WebhookDelivery, error) {
+	const operationPlace = "postgresql.webhookrepo.ClaimDeliveries"
+	log := wr.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	claimQuery := fmt.Sprintf(`
+	with claimed as (
+		update %s set %s = $1
+		where %s in (
+			select %s from %s
+			where %s = $2 and %s <= now()
+			order by %s
+			limit $3
+			for update skip locked
+		)
+		returning %s, %s, %s, %s, %s, %s
+	)
+	select c.%s, c.%s, w.%s, w.%s, c.%s, c.%s, c.%s, c.%s
+	from claimed c join %s w using(%s)
+	order by c.%s`,
+		WebhookDeliveryTable,
+		WebhookDeliveryNextAttemptAtFieldName,
+		WebhookDeliveryWebhookDeliveryIDFieldName,
+		WebhookDeliveryWebhookDeliveryIDFieldName,
+		WebhookDeliveryTable,
+		WebhookDeliveryStatusFieldName,
+		WebhookDeliveryNextAttemptAtFieldName,
+		WebhookDeliveryWebhookDeliveryIDFieldName,
+		WebhookDeliveryWebhookDeliveryIDFieldName,
+		WebhookDeliveryWebhookIDFieldName,
+		WebhookDeliveryOutboxIDFieldName,
+		WebhookDeliveryEventTypeFieldName,
+		WebhookDeliveryPayloadFieldName,
+		WebhookDeliveryAttemptsFieldName,
+		WebhookDeliveryWebhookDeliveryIDFieldName,
+		WebhookDeliveryWebhookIDFieldName,
+		WebhookURLFieldName,
+		WebhookSecretFieldName,
+		WebhookDeliveryOutboxIDFieldName,
+		WebhookDeliveryEventTypeFieldName,
+		WebhookDeliveryPayloadFieldName,
+		WebhookDeliveryAttemptsFieldName,
+		WebhookTable,
+		WebhookWebhookIDFieldName,
+		WebhookDeliveryWebhookDeliveryIDFieldName,
+	)
+	rows, err := wr.conn.GetPool().Query(ctx, claimQuery, time.Now().Add(lease), model.WebhookDeliveryPending, limit)
+	if err != nil {
+		log.Error("cannot claim webhook deliveries", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer rows.Close()
+	var deliveries []model.WebhookDelivery
+	for rows.Next() {
+		var delivery model.WebhookDelivery
+		err = rows.Scan(
+			&delivery.DeliveryID,
+			&delivery.WebhookID,
+			&delivery.URL,
+			&delivery.Secret,
+			&delivery.OutboxID,
+			&delivery.EventType,
+			&delivery.Payload,
+			&delivery.Attempts,
+		)
+		if err != nil {
+			log.Error("cannot scan webhook delivery", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	if err = rows.Err(); err != nil {
+		log.Error("cannot prepare next row", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return deliveries, nil
+}
+
+// RecordAttempt сохраняет попытку доставки и обновляет состояние доставки
+// в одной транзакции.
+func (wr *WebhookRepository) RecordAttempt(ctx context.Context, attempt dto.WebhookAttempt) error {
+	const operationPlace = "postgresql.webhookrepo.RecordAttempt"
+	log := wr.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	insertAttemptQuery := fmt.Sprintf("insert into %s (%s, %s, %s, %s, %s) values ($1, $2, $3, $4, $5)",
+		WebhookDeliveryAttemptTable,
+		WebhookDeliveryAttemptWebhookDeliveryIDFieldName,
+		WebhookDeliveryAttemptAttemptFieldName,
+		WebhookDeliveryAttemptStatusCodeFieldName,
+		WebhookDeliveryAttemptErrorFieldName,
+		WebhookDeliveryAttemptDurationMsFieldName,
+	)
+	updateDeliveryQuery := fmt.Sprintf(`
+	update %s set %s = $1, %s = $2, %s = $3,
+		%s = case when $1 = '%s' then now() end
+	where %s = $4`,
+		WebhookDeliveryTable,
+		WebhookDeliveryStatusFieldName,
+		WebhookDeliveryAttemptsFieldName,
+		WebhookDeliveryNextAttemptAtFieldName,
+		WebhookDeliveryDeliveredAtFieldName,
+		model.WebhookDeliveryDelivered,
+		WebhookDeliveryWebhookDeliveryIDFieldName,
+	)
+	status := model.WebhookDeliveryPending
+	switch {
+	case attempt.Delivered:
+		status = model.WebhookDeliveryDelivered
+	case attempt.GiveUp:
+		status = model.WebhookDeliveryFailed
+	}
+	nextAttemptAt := attempt.NextAttemptAt
+	if nextAttemptAt.IsZero() {
+		nextAttemptAt = time.Now()
+	}
+
+	tx, err := wr.conn.GetPool().Begin(ctx)
+	if err != nil {
+		log.Error(fmt.Sprintf("cannot start transaction, unexpected error = %v", err))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			log.Error("cannot rollback transaction, unexpected error", slog.String("error", rollbackErr.Error()))
+		}
+	}()
+	_, err = tx.Exec(ctx, insertAttemptQuery,
+		attempt.DeliveryID,
+		attempt.Attempt,
+		attempt.StatusCode,
+		attempt.Error,
+		attempt.Duration.Milliseconds(),
+	)
+	if err != nil {
+		log.Error("cannot save delivery attempt", slog.Int64("deliveryID", attempt.DeliveryID), slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if _, err = tx.Exec(ctx, updateDeliveryQuery, status, attempt.Attempt, nextAttemptAt, attempt.DeliveryID); err != nil {
+		log.Error("cannot update delivery", slog.Int64("deliveryID", attempt.DeliveryID), slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		log.Error(fmt.Sprintf("cannot commit transaction, unexpected error = %v", err))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return nil
+}
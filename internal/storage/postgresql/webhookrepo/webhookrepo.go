@@ -0,0 +1,51 @@
+package webhookrepo
+
+import (
+	"log/slog"
+
+	"github.com/sariya23/game_service/internal/storage/db"
+)
+
+const (
+	WebhookTable               = "webhook"
+	WebhookWebhookIDFieldName  = "webhook_id"
+	WebhookURLFieldName        = "url"
+	WebhookSecretFieldName     = "secret"
+	WebhookEventTypesFieldName = "event_types"
+	WebhookCreatedByFieldName  = "created_by"
+	WebhookCreatedAtFieldName  = "created_at"
+)
+
+const (
+	WebhookDeliveryTable                      = "webhook_delivery"
+	WebhookDeliveryWebhookDeliveryIDFieldName = "webhook_delivery_id"
+	WebhookDeliveryWebhookIDFieldName         = "webhook_id"
+	WebhookDeliveryOutboxIDFieldName          = "outbox_id"
+	WebhookDeliveryEventTypeFieldName         = "event_type"
+	WebhookDeliveryPayloadFieldName           = "payload"
+	WebhookDeliveryStatusFieldName            = "status"
+	WebhookDeliveryAttemptsFieldName          = "attempts"
+	WebhookDeliveryNextAttemptAtFieldName     = "next_attempt_at"
+	WebhookDeliveryDeliveredAtFieldName       = "delivered_at"
+)
+
+const (
+	WebhookDeliveryAttemptTable                      = "webhook_delivery_attempt"
+	WebhookDeliveryAttemptWebhookDeliveryIDFieldName = "webhook_delivery_id"
+	WebhookDeliveryAttemptAttemptFieldName           = "attempt"
+	WebhookDeliveryAttemptStatusCodeFieldName        = "status_code"
+	WebhookDeliveryAttemptErrorFieldName             = "error"
+	WebhookDeliveryAttemptDurationMsFieldName        = "duration_ms"
+)
+
+type WebhookRepository struct {
+	conn *db.Database
+	log  *slog.Logger
+}
+
+func NewWebhookRepository(conn *db.Database, log *slog.Logger) *WebhookRepository {
+	return &WebhookRepository{
+		conn: conn,
+		log:  log,
+	}
+}
@@ -0,0 +1,92 @@
+package webhookrepo
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/sariya23/game_service/internal/lib/logger"
+	"github.com/sariya23/game_service/internal/model"
+	"github.com/sariya23/game_service/internal/outerror"
+)
+
+// CreateWebhook сохраняет подписку и возвращает ее с id и временем создания.
+func (wr *WebhookRepository) CreateWebhook(ctx context.Context, webhook model.Webhook) (model.Webhook, error) {
+	const operationPlace = "postgresql.webhookrepo.CreateWebhook"
+	log := wr.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	createWebhookQuery := fmt.Sprintf("insert into %s (%s, %s, %s, %s) values ($1, $2, $3, $4) returning %s, %s",
+		WebhookTable,
+		WebhookURLFieldName,
+		WebhookSecretFieldName,
+		WebhookEventTypesFieldName,
+		WebhookCreatedByFieldName,
+		WebhookWebhookIDFieldName,
+		WebhookCreatedAtFieldName,
+	)
+	if webhook.EventTypes == nil {
+		webhook.EventTypes = []string{}
+	}
+	err := wr.conn.GetPool().QueryRow(ctx, createWebhookQuery, webhook.URL, webhook.Secret, webhook.EventTypes, webhook.CreatedBy).
+		Scan(&webhook.WebhookID, &webhook.CreatedAt)
+	if err != nil {
+		log.Error("cannot create webhook", slog.String("err", err.Error()))
+		return model.Webhook{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return webhook, nil
+}
+
+// ListWebhooks возвращает все подписки без секретов.
+func (wr *WebhookRepository) ListWebhooks(ctx context.Context) ([]model.Webhook, error) {
+	const operationPlace = "postgresql.webhookrepo.ListWebhooks"
+	log := wr.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	listWebhooksQuery := fmt.Sprintf("select %s, %s, %s, %s, %s from %s order by %s",
+		WebhookWebhookIDFieldName,
+		WebhookURLFieldName,
+		WebhookEventTypesFieldName,
+		WebhookCreatedByFieldName,
+		WebhookCreatedAtFieldName,
+		WebhookTable,
+		WebhookWebhookIDFieldName,
+	)
+	rows, err := wr.conn.GetPool().Query(ctx, listWebhooksQuery)
+	if err != nil {
+		log.Error("cannot list webhooks", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer rows.Close()
+	var webhooks []model.Webhook
+	for rows.Next() {
+		var webhook model.Webhook
+		err = rows.Scan(&webhook.WebhookID, &webhook.URL, &webhook.EventTypes, &webhook.CreatedBy, &webhook.CreatedAt)
+		if err != nil {
+			log.Error("cannot scan webhook", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		webhooks = append(webhooks, webhook)
+	}
+	if err = rows.Err(); err != nil {
+		log.Error("cannot prepare next row", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return webhooks, nil
+}
+
+// DeleteWebhook удаляет подписку вместе с ее доставками.
+func (wr *WebhookRepository) DeleteWebhook(ctx context.Context, webhookID int64) error {
+	const operationPlace = "postgresql.webhookrepo.DeleteWebhook"
+	log := wr.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
+	deleteWebhookQuery := fmt.Sprintf("delete from %s where %s=$1", WebhookTable, WebhookWebhookIDFieldName)
+	tag, err := wr.conn.GetPool().Exec(ctx, deleteWebhookQuery, webhookID)
+	if err != nil {
+		log.Error("cannot delete webhook", slog.Int64("webhookID", webhookID), slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if tag.RowsAffected() == 0 {
+		log.Warn("webhook not found", slog.Int64("webhookID", webhookID))
+		return fmt.Errorf("%s: %w", operationPlace, outerror.ErrWebhookNotFound)
+	}
+	return nil
+}
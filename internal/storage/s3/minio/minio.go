@@ -3,9 +3,12 @@ package minioclient
 import (
 	"bytes"
 	"context"
+	"crypto/rand"
 	"fmt"
 	"io"
 	"log/slog"
+	"path"
+	"strings"
 	"time"
 
 	"github.com/minio/minio-go/v7"
@@ -98,7 +101,7 @@ func (m Minio) createBucket(ctx context.Context) error {
 	return nil
 }
 
-func (m Minio) SaveObject(ctx context.Context, name string, data io.Reader) (string, error) {
+func (m Minio) SaveObject(ctx context.Context, name string, data io.Reader, contentType string) (string, error) {
 	const operationPlace = "minioclient.SaveObject"
 	log := m.log.With("operationPlace", operationPlace)
 	log = logger.EnrichRequestID(ctx, log)
@@ -113,7 +116,7 @@ func (m Minio) SaveObject(ctx context.Context, name string, data io.Reader) (str
 		return "", fmt.Errorf("%s: empty data", operationPlace)
 	}
 	reader := bytes.NewReader(buf.Bytes())
-	info, err := m.client.PutObject(ctx, m.BucketName, name, reader, int64(buf.Len()), minio.PutObjectOptions{})
+	info, err := m.client.PutObject(ctx, m.BucketName, name, reader, int64(buf.Len()), minio.PutObjectOptions{ContentType: contentType})
 	if err != nil {
 		log.Error(fmt.Sprintf("cannot save object in s3; err=%v", err))
 		return "", fmt.Errorf("%s: %w", operationPlace, err)
@@ -122,10 +125,28 @@ func (m Minio) SaveObject(ctx context.Context, name string, data io.Reader) (str
 	return info.Key, nil
 }
 
-func (m Minio) DeleteObject(ctx context.Context, name string) error {
+func (m Minio) GetObject(ctx context.Context, name string) ([]byte, error) {
 	const operationPlace = "minioclient.GetObject"
 	log := m.log.With("operationPlace", operationPlace)
 	log = logger.EnrichRequestID(ctx, log)
+	object, err := m.client.GetObject(ctx, m.BucketName, name, minio.GetObjectOptions{})
+	if err != nil {
+		log.Error(fmt.Sprintf("cannot get object from s3; err=%v", err))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer object.Close()
+	data, err := io.ReadAll(object)
+	if err != nil {
+		log.Error(fmt.Sprintf("cannot read object from s3; err=%v", err))
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return data, nil
+}
+
+func (m Minio) DeleteObject(ctx context.Context, name string) error {
+	const operationPlace = "minioclient.DeleteObject"
+	log := m.log.With("operationPlace", operationPlace)
+	log = logger.EnrichRequestID(ctx, log)
 	err := m.client.RemoveObject(ctx, m.BucketName, name, minio.RemoveObjectOptions{})
 	if err != nil {
 		log.Error(fmt.Sprintf("unexpected error; err=%v", err))
@@ -134,8 +155,44 @@ func (m Minio) DeleteObject(ctx context.Context, name string) error {
 	return nil
 }
 
-func GameKey(gameTitle string, gameReleaseYear int) string {
-	return fmt.Sprintf("%s_%d", gameTitle, gameReleaseYear)
+const GameKeyPrefix = "games/"
+
+// GameCoverKey - новый неизменяемый ключ обложки игры вида
+// games/{id}/cover/{upload}.{ext}. Каждая загрузка получает свой ключ, даже
+// с тем же содержимым, поэтому закешированные по URL обложки не устаревают,
+// а удаление замененного ключа не задевает чужую загрузку.
+func GameCoverKey(gameID int64, contentType string) string {
+	return fmt.Sprintf("%s%d/cover/%s.%s", GameKeyPrefix, gameID, strings.ToLower(rand.Text()), coverExtension(contentType))
+}
+
+// CoverVariantWidths - ширины уменьшенных копий обложки. Первая - миниатюра
+// для списка игр.
+var CoverVariantWidths = []int{160, 480}
+
+// CoverVariantKey - ключ уменьшенной копии обложки, лежит рядом с оригиналом:
+// games/{id}/cover/{upload}_w{width}.{ext}. Копии GIF хранятся в PNG.
+func CoverVariantKey(imageKey string, width int) string {
+	ext := path.Ext(imageKey)
+	base := strings.TrimSuffix(imageKey, ext)
+	if ext == ".gif" {
+		ext = ".png"
+	}
+	return fmt.Sprintf("%s_w%d%s", base, width, ext)
+}
+
+func coverExtension(contentType string) string {
+	switch contentType {
+	case "image/png":
+		return "png"
+	case "image/jpeg":
+		return "jpg"
+	case "image/gif":
+		return "gif"
+	case "image/webp":
+		return "webp"
+	default:
+		return "bin"
+	}
 }
 
 func (m Minio) GeneratePresignedURL(ctx context.Context, objectName string) (string, error) {
@@ -0,0 +1,93 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"syscall"
+
+	"github.com/sariya23/game_service/internal/outerror"
+)
+
+// forbiddenPrefixes - адреса, которые не покрываются методами netip.Addr,
+// но тоже ведут во внутреннюю или служебную сеть.
+var forbiddenPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+	netip.MustParsePrefix("64:ff9b:1::/48"),
+	netip.MustParsePrefix("2002::/16"),
+}
+
+// Guard не дает отправлять webhook во внутреннюю сеть: адрес проверяется
+// при создании подписки и при каждом соединении, так что DNS, сменивший
+// ответ после проверки, и редиректы тоже не помогут.
+type Guard struct {
+	resolver     *net.Resolver
+	allowPrivate bool
+}
+
+// NewGuard создает проверку адресов. allowPrivate разрешает любые адреса,
+// нужен для локальной разработки и тестов.
+func NewGuard(allowPrivate bool) *Guard {
+	return &Guard{resolver: net.DefaultResolver, allowPrivate: allowPrivate}
+}
+
+// PublicAddr сообщает, что адрес доступен из интернета: не loopback,
+// не частная, link-local или служебная сеть.
+func PublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsValid() || addr.IsUnspecified() || addr.IsLoopback() || addr.IsPrivate() ||
+		addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() || addr.IsInterfaceLocalMulticast() ||
+		addr.IsMulticast() {
+		return false
+	}
+	for _, prefix := range forbiddenPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// CheckURL разрешает хост rawURL и возвращает ErrWebhookAddressForbidden,
+// если хотя бы один его адрес не публичный.
+func (g *Guard) CheckURL(ctx context.Context, rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("parse url: %w", err)
+	}
+	if g.allowPrivate {
+		return nil
+	}
+	addrs, err := g.resolver.LookupNetIP(ctx, "ip", parsed.Hostname())
+	if err != nil {
+		return fmt.Errorf("resolve %s: %w", parsed.Hostname(), outerror.ErrWebhookAddressForbidden)
+	}
+	for _, addr := range addrs {
+		if !PublicAddr(addr) {
+			return fmt.Errorf("%s resolves to %s: %w", parsed.Hostname(), addr, outerror.ErrWebhookAddressForbidden)
+		}
+	}
+	return nil
+}
+
+// control вызывается net.Dialer перед соединением с уже разрешенным адресом.
+func (g *Guard) control(_, address string, _ syscall.RawConn) error {
+	if g.allowPrivate {
+		return nil
+	}
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("parse address %s: %w", address, outerror.ErrWebhookAddressForbidden)
+	}
+	if !PublicAddr(addrPort.Addr()) {
+		return fmt.Errorf("dial %s: %w", address, outerror.ErrWebhookAddressForbidden)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package webhook
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/sariya23/game_service/internal/outerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublicAddr(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		addr     string
+		expected bool
+	}{
+		{addr: "93.184.216.34", expected: true},
+		{addr: "2606:2800:220:1:248:1893:25c8:1946", expected: true},
+		{addr: "127.0.0.1", expected: false},
+		{addr: "::1", expected: false},
+		{addr: "10.1.2.3", expected: false},
+		{addr: "172.16.0.1", expected: false},
+		{addr: "192.168.1.1", expected: false},
+		{addr: "169.254.169.254", expected: false},
+		{addr: "fe80::1", expected: false},
+		{addr: "fd00::1", expected: false},
+		{addr: "0.0.0.0", expected: false},
+		{addr: "100.64.0.1", expected: false},
+		{addr: "224.0.0.1", expected: false},
+		{addr: "::ffff:127.0.0.1", expected: false},
+		{addr: "::ffff:10.0.0.1", expected: false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.addr, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, PublicAddr(netip.MustParseAddr(tc.addr)))
+		})
+	}
+}
+
+func TestGuard_CheckURL(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	require.ErrorIs(t, NewGuard(false).CheckURL(ctx, "http://127.0.0.1:8080/hook"), outerror.ErrWebhookAddressForbidden)
+	require.ErrorIs(t, NewGuard(false).CheckURL(ctx, "http://[fd00::1]/hook"), outerror.ErrWebhookAddressForbidden)
+	require.ErrorIs(t, NewGuard(false).CheckURL(ctx, "http://localhost/hook"), outerror.ErrWebhookAddressForbidden)
+	require.NoError(t, NewGuard(false).CheckURL(ctx, "https://93.184.216.34/hook"))
+	require.NoError(t, NewGuard(true).CheckURL(ctx, "http://127.0.0.1:8080/hook"))
+}
@@ -0,0 +1,90 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/sariya23/game_service/internal/model"
+)
+
+// Заголовки запроса с событием.
+const (
+	SignatureHeader = "X-GameHub-Signature"
+	EventHeader     = "X-GameHub-Event"
+	DeliveryHeader  = "X-GameHub-Delivery"
+	TimestampHeader = "X-GameHub-Timestamp"
+)
+
+const signaturePrefix = "sha256="
+
+// Sender отправляет события в webhook по HTTP.
+type Sender struct {
+	client *http.Client
+	now    func() time.Time
+}
+
+// NewSender создает отправителя, который соединяется только с адресами,
+// разрешенными guard. Прокси из окружения не используется: иначе проверялся
+// бы адрес прокси, а не получателя.
+func NewSender(timeout time.Duration, guard *Guard) *Sender {
+	dialer := &net.Dialer{Timeout: timeout, Control: guard.control}
+	return &Sender{
+		client: &http.Client{
+			Timeout:   timeout,
+			Transport: &http.Transport{Proxy: nil, DialContext: dialer.DialContext},
+		},
+		now: time.Now,
+	}
+}
+
+// Sign возвращает подпись тела запроса: hex(HMAC-SHA256(secret, timestamp + "." + body)).
+// Время входит в подпись, чтобы перехваченный запрос нельзя было повторить позже.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify проверяет значение заголовка SignatureHeader.
+// Нужна получателям и тестам.
+func Verify(secret string, timestamp int64, body []byte, signature string) bool {
+	expected := signaturePrefix + Sign(secret, timestamp, body)
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
+
+// Send отправляет POST с payload события. Возвращает код ответа,
+// если он был получен. Любой ответ вне 2xx считается ошибкой.
+func (s *Sender) Send(ctx context.Context, delivery model.WebhookDelivery) (int, error) {
+	timestamp := s.now().Unix()
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("build request: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(EventHeader, delivery.EventType)
+	request.Header.Set(DeliveryHeader, strconv.FormatInt(delivery.OutboxID, 10))
+	request.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	request.Header.Set(SignatureHeader, signaturePrefix+Sign(delivery.Secret, timestamp, delivery.Payload))
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(response.Body, 64<<10))
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return response.StatusCode, fmt.Errorf("unexpected status code %d", response.StatusCode)
+	}
+	return response.StatusCode, nil
+}
@@ -0,0 +1,116 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sariya23/game_service/internal/model"
+	"github.com/sariya23/game_service/internal/outerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSign(t *testing.T) {
+	t.Parallel()
+	body := []byte(`{"game_id":1}`)
+	signature := Sign("secret", 1700000000, body)
+
+	assert.Len(t, signature, 64)
+	assert.Equal(t, signature, Sign("secret", 1700000000, body))
+	assert.NotEqual(t, signature, Sign("other", 1700000000, body))
+	assert.NotEqual(t, signature, Sign("secret", 1700000001, body))
+	assert.True(t, Verify("secret", 1700000000, body, "sha256="+signature))
+	assert.False(t, Verify("secret", 1700000000, []byte(`{"game_id":2}`), "sha256="+signature))
+}
+
+func TestSender_Send(t *testing.T) {
+	t.Parallel()
+	delivery := model.WebhookDelivery{
+		URL:       "",
+		Secret:    "secret",
+		OutboxID:  42,
+		EventType: "GameCreated",
+		Payload:   []byte(`{"game_id":1}`),
+	}
+	t.Run("Запрос подписан и содержит событие", func(t *testing.T) {
+		t.Parallel()
+		var received *http.Request
+		var body []byte
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received = r
+			body, _ = io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+		sender := NewSender(time.Second, NewGuard(true))
+		sender.now = func() time.Time { return time.Unix(1700000000, 0) }
+		d := delivery
+		d.URL = server.URL
+
+		code, err := sender.Send(context.Background(), d)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, code)
+		assert.Equal(t, http.MethodPost, received.Method)
+		assert.Equal(t, "application/json", received.Header.Get("Content-Type"))
+		assert.Equal(t, "GameCreated", received.Header.Get(EventHeader))
+		assert.Equal(t, "42", received.Header.Get(DeliveryHeader))
+		assert.Equal(t, "1700000000", received.Header.Get(TimestampHeader))
+		assert.Equal(t, d.Payload, body)
+		timestamp, err := strconv.ParseInt(received.Header.Get(TimestampHeader), 10, 64)
+		require.NoError(t, err)
+		assert.True(t, Verify("secret", timestamp, body, received.Header.Get(SignatureHeader)))
+	})
+	t.Run("Ответ вне 2xx - ошибка", func(t *testing.T) {
+		t.Parallel()
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+		d := delivery
+		d.URL = server.URL
+
+		code, err := NewSender(time.Second, NewGuard(true)).Send(context.Background(), d)
+
+		require.Error(t, err)
+		assert.Equal(t, http.StatusInternalServerError, code)
+	})
+	t.Run("Таймаут - ошибка без кода", func(t *testing.T) {
+		t.Parallel()
+		release := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-release
+		}))
+		defer server.Close()
+		defer close(release)
+		d := delivery
+		d.URL = server.URL
+
+		code, err := NewSender(50*time.Millisecond, NewGuard(true)).Send(context.Background(), d)
+
+		require.Error(t, err)
+		assert.Zero(t, code)
+	})
+	t.Run("Внутренний адрес не разрешен", func(t *testing.T) {
+		t.Parallel()
+		var called atomic.Bool
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called.Store(true)
+		}))
+		defer server.Close()
+		d := delivery
+		d.URL = server.URL
+
+		code, err := NewSender(time.Second, NewGuard(false)).Send(context.Background(), d)
+
+		require.ErrorIs(t, err, outerror.ErrWebhookAddressForbidden)
+		assert.Zero(t, code)
+		assert.False(t, called.Load())
+	})
+}
@@ -0,0 +1,94 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/sariya23/game_service/internal/interceptors"
+	"github.com/sariya23/game_service/internal/lib/generate"
+)
+
+// Periodic раз в interval вызывает job, пока не вызван Stop.
+// Каждый запуск получает свой request id, чтобы его логи можно было связать.
+type Periodic struct {
+	log      *slog.Logger
+	name     string
+	interval time.Duration
+	job      func(ctx context.Context) error
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+	// mu связывает старт Run и Stop: Run либо успевает отметить запуск
+	// до Stop, и тогда Stop ждет done, либо видит stopped и не запускается.
+	mu      sync.Mutex
+	running bool
+	stopped bool
+}
+
+func NewPeriodic(log *slog.Logger, name string, interval time.Duration, job func(ctx context.Context) error) *Periodic {
+	if interval <= 0 {
+		panic(fmt.Sprintf("worker %s: interval must be positive, got %s", name, interval))
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Periodic{
+		log:      log.With("worker", name),
+		name:     name,
+		interval: interval,
+		job:      job,
+		ctx:      ctx,
+		cancel:   cancel,
+		done:     make(chan struct{}),
+	}
+}
+
+// Run блокируется до вызова Stop. Первый запуск job происходит сразу.
+// После Stop Run возвращается, не запуская job.
+func (p *Periodic) Run() {
+	p.mu.Lock()
+	if p.stopped || p.running {
+		p.mu.Unlock()
+		return
+	}
+	p.running = true
+	p.mu.Unlock()
+	defer close(p.done)
+	p.log.Info("worker started", slog.Duration("interval", p.interval))
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		p.tick()
+		select {
+		case <-p.ctx.Done():
+			p.log.Info("worker stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Stop останавливает воркер и ждет завершения текущего запуска job.
+// Если Run еще не начался, возвращается сразу, а поздний Run ничего не запустит.
+func (p *Periodic) Stop() {
+	p.mu.Lock()
+	p.stopped = true
+	running := p.running
+	p.mu.Unlock()
+	p.cancel()
+	if running {
+		<-p.done
+	}
+}
+
+func (p *Periodic) tick() {
+	if p.ctx.Err() != nil {
+		return
+	}
+	ctx := context.WithValue(p.ctx, interceptors.RequestIDKey, generate.GenerateRequestID())
+	if err := p.job(ctx); err != nil && p.ctx.Err() == nil {
+		p.log.Error("worker job failed", slog.String("err", err.Error()))
+	}
+}
@@ -0,0 +1,71 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sariya23/game_service/internal/interceptors"
+	"github.com/sariya23/game_service/internal/lib/mockslog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeriodic(t *testing.T) {
+	t.Parallel()
+	t.Run("job runs until Stop and gets request id", func(t *testing.T) {
+		t.Parallel()
+		var calls atomic.Int32
+		requestIDs := make(chan string, 100)
+		p := NewPeriodic(mockslog.NewDiscardLogger(), "test", time.Millisecond, func(ctx context.Context) error {
+			calls.Add(1)
+			requestID, _ := ctx.Value(interceptors.RequestIDKey).(string)
+			requestIDs <- requestID
+			return errors.New("job error")
+		})
+		go p.Run()
+		require.Eventually(t, func() bool { return calls.Load() >= 3 }, time.Second, time.Millisecond)
+		p.Stop()
+		stoppedAt := calls.Load()
+		time.Sleep(10 * time.Millisecond)
+		assert.Equal(t, stoppedAt, calls.Load())
+		first, second := <-requestIDs, <-requestIDs
+		assert.NotEmpty(t, first)
+		assert.NotEqual(t, first, second)
+	})
+	t.Run("Stop without Run returns", func(t *testing.T) {
+		t.Parallel()
+		p := NewPeriodic(mockslog.NewDiscardLogger(), "test", time.Millisecond, func(ctx context.Context) error {
+			return nil
+		})
+		p.Stop()
+	})
+	t.Run("Run after Stop does not call job", func(t *testing.T) {
+		t.Parallel()
+		var calls atomic.Int32
+		p := NewPeriodic(mockslog.NewDiscardLogger(), "test", time.Millisecond, func(ctx context.Context) error {
+			calls.Add(1)
+			return nil
+		})
+		p.Stop()
+		p.Run()
+		assert.Zero(t, calls.Load())
+	})
+	t.Run("Stop racing with Run waits for the started job", func(t *testing.T) {
+		t.Parallel()
+		for range 100 {
+			var inJob atomic.Bool
+			p := NewPeriodic(mockslog.NewDiscardLogger(), "test", time.Millisecond, func(ctx context.Context) error {
+				inJob.Store(true)
+				time.Sleep(time.Millisecond)
+				inJob.Store(false)
+				return nil
+			})
+			go p.Run()
+			p.Stop()
+			assert.False(t, inJob.Load())
+		}
+	})
+}
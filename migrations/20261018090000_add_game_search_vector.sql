@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table game
+add column search_vector tsvector generated always as (
+    setweight(to_tsvector('russian'::regconfig, coalesce(title, '')), 'A') ||
+    setweight(to_tsvector('english'::regconfig, coalesce(title, '')), 'A') ||
+    setweight(to_tsvector('russian'::regconfig, coalesce(description, '')), 'B') ||
+    setweight(to_tsvector('english'::regconfig, coalesce(description, '')), 'B')
+) stored;
+
+create index if not exists game_search_vector_idx on game using gin (search_vector);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists game_search_vector_idx;
+
+alter table game
+drop column search_vector;
+-- +goose StatementEnd
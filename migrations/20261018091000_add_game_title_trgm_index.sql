@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+create extension if not exists pg_trgm;
+
+create index if not exists game_title_trgm_idx on game using gin (title gin_trgm_ops);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists game_title_trgm_idx;
+-- +goose StatementEnd
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+create index if not exists game_title_release_date_game_id_idx on game (title, release_date, game_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists game_title_release_date_game_id_idx;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- newest/oldest: (release_date, game_id), в обе стороны одним индексом.
+-- recently added идет по первичному ключу, title - по индексу из keyset-пагинации,
+-- relevance сортирует только строки, найденные по GIN индексу search_vector.
+create index if not exists game_release_date_game_id_idx on game (release_date, game_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists game_release_date_game_id_idx;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists game_moderation (
+    game_moderation_id bigint generated always as identity primary key,
+    game_id bigint not null references game(game_id) on delete cascade,
+    decision varchar(16) not null check (decision in ('submit', 'approve', 'reject')),
+    reason text,
+    actor varchar(255) not null,
+    created_at timestamptz not null default now()
+);
+
+create index if not exists game_moderation_game_id_created_at_idx on game_moderation (game_id, created_at desc);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists game_moderation;
+-- +goose StatementEnd
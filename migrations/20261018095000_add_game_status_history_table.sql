@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Без внешнего ключа на game: история нужна и после удаления игры.
+create table if not exists game_status_history (
+    game_status_history_id bigint generated always as identity primary key,
+    game_id bigint not null,
+    old_status_id smallint not null references game_status(game_status_id),
+    new_status_id smallint not null references game_status(game_status_id),
+    actor varchar(255) not null default '',
+    request_id varchar(255) not null default '',
+    created_at timestamptz not null default now()
+);
+
+create index if not exists game_status_history_game_id_created_at_idx on game_status_history (game_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists game_status_history;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Время автоматической публикации игры из PENDING.
+alter table game add column if not exists publish_at timestamptz;
+
+-- Планировщик выбирает только запланированные игры в очереди модерации.
+create index if not exists game_publish_at_pending_idx on game (publish_at)
+    where publish_at is not null and game_status_id = 1;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists game_publish_at_pending_idx;
+alter table game drop column if exists publish_at;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Мягкое удаление: игра скрыта из чтения, но ее можно восстановить
+-- до очистки по сроку хранения.
+alter table game add column if not exists deleted_at timestamptz;
+
+create index if not exists game_deleted_at_idx on game (deleted_at) where deleted_at is not null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists game_deleted_at_idx;
+alter table game drop column if exists deleted_at;
+-- +goose StatementEnd
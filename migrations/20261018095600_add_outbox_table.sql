@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Transactional outbox: события пишутся в одной транзакции с изменением игры,
+-- relay доставляет их во внешние сервисы. Без внешнего ключа на game:
+-- событие удаления должно пережить очистку игры.
+create table if not exists outbox (
+    outbox_id bigint generated always as identity primary key,
+    game_id bigint not null,
+    event_type varchar(64) not null,
+    payload jsonb not null,
+    created_at timestamptz not null default now(),
+    published_at timestamptz,
+    attempts int not null default 0,
+    next_attempt_at timestamptz not null default now(),
+    last_error text not null default ''
+);
+
+-- Relay берет первое недоставленное событие каждой игры.
+create index if not exists outbox_unpublished_game_id_idx on outbox (game_id, outbox_id) where published_at is null;
+create index if not exists outbox_unpublished_next_attempt_idx on outbox (next_attempt_at) where published_at is null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists outbox;
+-- +goose StatementEnd
@@ -0,0 +1,49 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Подписки партнеров на события игр. Пустой event_types - все события.
+create table if not exists webhook (
+    webhook_id bigint generated always as identity primary key,
+    url text not null,
+    secret varchar(255) not null,
+    event_types varchar(64)[] not null default '{}',
+    created_by varchar(255) not null default '',
+    created_at timestamptz not null default now()
+);
+
+-- Доставка одного события outbox в один webhook.
+create table if not exists webhook_delivery (
+    webhook_delivery_id bigint generated always as identity primary key,
+    webhook_id bigint not null references webhook(webhook_id) on delete cascade,
+    outbox_id bigint not null,
+    event_type varchar(64) not null,
+    payload jsonb not null,
+    status varchar(16) not null default 'pending' check (status in ('pending', 'delivered', 'failed')),
+    attempts int not null default 0,
+    next_attempt_at timestamptz not null default now(),
+    created_at timestamptz not null default now(),
+    delivered_at timestamptz,
+    unique (webhook_id, outbox_id)
+);
+
+create index if not exists webhook_delivery_pending_idx on webhook_delivery (next_attempt_at) where status = 'pending';
+
+-- Каждая попытка доставки с ответом получателя.
+create table if not exists webhook_delivery_attempt (
+    webhook_delivery_attempt_id bigint generated always as identity primary key,
+    webhook_delivery_id bigint not null references webhook_delivery(webhook_delivery_id) on delete cascade,
+    attempt int not null,
+    status_code int not null default 0,
+    error text not null default '',
+    duration_ms bigint not null default 0,
+    created_at timestamptz not null default now()
+);
+
+create index if not exists webhook_delivery_attempt_delivery_id_idx on webhook_delivery_attempt (webhook_delivery_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists webhook_delivery_attempt;
+drop table if exists webhook_delivery;
+drop table if exists webhook;
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Старые имена тэгов и жанров, слитых в другой. По ним ищутся игры и тэги в запросах.
+create table if not exists tag_alias (
+    alias_name varchar(70) primary key,
+    tag_id int not null references tag(tag_id) on delete cascade,
+    created_at timestamptz not null default now()
+);
+
+create index if not exists tag_alias_tag_id_idx on tag_alias (tag_id);
+
+create table if not exists genre_alias (
+    alias_name varchar(70) primary key,
+    genre_id smallint not null references genre(genre_id) on delete cascade,
+    created_at timestamptz not null default now()
+);
+
+create index if not exists genre_alias_genre_id_idx on genre_alias (genre_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists genre_alias;
+drop table if exists tag_alias;
+-- +goose StatementEnd
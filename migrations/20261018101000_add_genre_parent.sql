@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Родительский жанр: RPG -> Action RPG. Циклы не дает создать сервис.
+alter table genre add column if not exists parent_genre_id smallint references genre(genre_id) on delete set null;
+alter table genre add constraint genre_parent_not_self check (parent_genre_id <> genre_id);
+
+create index if not exists genre_parent_genre_id_idx on genre (parent_genre_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists genre_parent_genre_id_idx;
+alter table genre drop constraint if exists genre_parent_not_self;
+alter table genre drop column if exists parent_genre_id;
+-- +goose StatementEnd
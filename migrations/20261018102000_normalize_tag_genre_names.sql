@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Имя для сравнения: без регистра, крайних пробелов и повторных пробелов внутри.
+create or replace function normalize_name(name text) returns text
+language sql immutable parallel safe
+as $$ select lower(regexp_replace(btrim(name), '\s+', ' ', 'g')) $$;
+
+alter table tag add column if not exists normalized_name text generated always as (normalize_name(tag_name)) stored;
+alter table genre add column if not exists normalized_name text generated always as (normalize_name(genre_name)) stored;
+alter table tag_alias add column if not exists normalized_name text generated always as (normalize_name(alias_name)) stored;
+alter table genre_alias add column if not exists normalized_name text generated always as (normalize_name(alias_name)) stored;
+
+-- Если индекс не создается, в справочнике есть дубли с точностью до регистра:
+-- их нужно сначала слить через MergeTags или MergeGenres.
+create unique index if not exists tag_normalized_name_idx on tag (normalized_name);
+create unique index if not exists genre_normalized_name_idx on genre (normalized_name);
+create unique index if not exists tag_alias_normalized_name_idx on tag_alias (normalized_name);
+create unique index if not exists genre_alias_normalized_name_idx on genre_alias (normalized_name);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table genre_alias drop column if exists normalized_name;
+alter table tag_alias drop column if exists normalized_name;
+alter table genre drop column if exists normalized_name;
+alter table tag drop column if exists normalized_name;
+drop function if exists normalize_name(text);
+-- +goose StatementEnd
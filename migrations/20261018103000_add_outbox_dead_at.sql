@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Событие, не доставленное за OUTBOX_MAX_ATTEMPTS попыток, помечается мертвым
+-- и больше не блокирует следующие события своей игры.
+alter table outbox add column if not exists dead_at timestamptz;
+
+drop index if exists outbox_unpublished_game_id_idx;
+drop index if exists outbox_unpublished_next_attempt_idx;
+create index if not exists outbox_pending_game_id_idx on outbox (game_id, outbox_id) where published_at is null and dead_at is null;
+create index if not exists outbox_pending_next_attempt_idx on outbox (next_attempt_at) where published_at is null and dead_at is null;
+create index if not exists outbox_dead_at_idx on outbox (dead_at) where dead_at is not null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists outbox_dead_at_idx;
+drop index if exists outbox_pending_next_attempt_idx;
+drop index if exists outbox_pending_game_id_idx;
+create index if not exists outbox_unpublished_game_id_idx on outbox (game_id, outbox_id) where published_at is null;
+create index if not exists outbox_unpublished_next_attempt_idx on outbox (next_attempt_at) where published_at is null;
+alter table outbox drop column if exists dead_at;
+-- +goose StatementEnd
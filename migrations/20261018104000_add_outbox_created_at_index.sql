@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- WatchGames перечитывает события, созданные за последние секунды: они могли
+-- закоммититься позже событий с большим outbox_id.
+create index if not exists outbox_created_at_idx on outbox (created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists outbox_created_at_idx;
+-- +goose StatementEnd
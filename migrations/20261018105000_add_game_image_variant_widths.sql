@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Ширины уменьшенных копий обложки, которые реально сохранены в s3.
+-- Ссылки выдаются только на них, иначе клиент получает 404.
+alter table game add column if not exists image_variant_widths integer[] not null default '{}';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table game drop column if exists image_variant_widths;
+-- +goose StatementEnd
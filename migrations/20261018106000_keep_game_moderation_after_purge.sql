@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- История модерации, как и история статусов, остается после окончательного
+-- удаления игры, поэтому внешний ключ на game убирается.
+alter table game_moderation drop constraint if exists game_moderation_game_id_fkey;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+delete from game_moderation where game_id not in (select game_id from game);
+alter table game_moderation
+    add constraint game_moderation_game_id_fkey foreign key (game_id) references game(game_id) on delete cascade;
+-- +goose StatementEnd
@@ -1,14 +1,18 @@
 package clientgrpc
 
 import (
+	"context"
 	"fmt"
 	"path/filepath"
 	"testing"
+	"time"
 
 	game_api "github.com/sariya23/api_game_service/gen/game"
 	"github.com/sariya23/game_service/internal/config"
+	"github.com/sariya23/game_service/internal/lib/authtoken"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 )
 
 type GameServiceTestClient struct {
@@ -16,7 +20,7 @@ type GameServiceTestClient struct {
 }
 
 func NewGameServiceTestClient() *GameServiceTestClient {
-	cfg := config.MustLoadByPath(filepath.Join("..", "..", "..", "..", "config", "test.env"))
+	cfg := loadConfig()
 	conn, err := grpc.NewClient(
 		fmt.Sprintf("%s:%d", cfg.Server.GRPCServerHost, cfg.Server.GrpcServerPort),
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
@@ -42,3 +46,32 @@ func (g *GameServiceTestClient) SetUp(t *testing.T) {
 func (g *GameServiceTestClient) TearDown(t *testing.T) {
 	t.Helper()
 }
+
+// WithRole выставляет роль вызывающего так же, как это делает сервис аутентификации.
+func WithRole(ctx context.Context, role string) context.Context {
+	return WithCaller(ctx, "test-"+role, role)
+}
+
+// WithUser передает токен аутентифицированного вызывающего без роли.
+func WithUser(ctx context.Context) context.Context {
+	return WithCaller(ctx, "test-user", "")
+}
+
+// WithCaller передает токен вызывающего, подписанный секретом из test.env.
+func WithCaller(ctx context.Context, id, role string) context.Context {
+	token := authtoken.Sign([]byte(loadConfig().Auth.TokenSecret), authtoken.Claims{
+		Subject:   id,
+		Role:      role,
+		ExpiresAt: time.Now().Add(time.Hour).Unix(),
+	})
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+}
+
+// MaxCoverImageBytes - лимит размера обложки, с которым запущен сервис.
+func MaxCoverImageBytes() int64 {
+	return loadConfig().Minio.MaxCoverImageBytes
+}
+
+func loadConfig() *config.Config {
+	return config.MustLoadByPath(filepath.Join("..", "..", "..", "..", "config", "test.env"))
+}
@@ -14,6 +14,7 @@ import (
 	game_api "github.com/sariya23/api_game_service/gen/game"
 	"github.com/sariya23/game_service/internal/lib/converters"
 	"github.com/sariya23/game_service/internal/model"
+	"github.com/sariya23/game_service/internal/outerror"
 	"github.com/sariya23/game_service/tests/clientgrpc"
 	"github.com/sariya23/game_service/tests/utils/random"
 	"github.com/stretchr/testify/assert"
@@ -32,7 +33,7 @@ func TestAddGame(t *testing.T) {
 		gameToAdd := random.GameToAddRequest(model.GenreNames(genres), model.TagNames(tags))
 		request := game_api.AddGameRequest{Game: gameToAdd}
 
-		response, err := client.GetClient().AddGame(ctx, &request)
+		response, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &request)
 
 		require.NoError(t, err)
 		assert.NotZero(t, response.GameId)
@@ -81,7 +82,7 @@ func TestAddGame(t *testing.T) {
 		gameToAdd.CoverImage = []byte{}
 		request := game_api.AddGameRequest{Game: gameToAdd}
 
-		response, err := client.GetClient().AddGame(ctx, &request)
+		response, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &request)
 
 		require.NoError(t, err)
 		assert.NotZero(t, response.GameId)
@@ -135,7 +136,7 @@ func TestAddGame(t *testing.T) {
 		for _, tc := range cases {
 			t.Run(tc.name, func(t *testing.T) {
 				request := game_api.AddGameRequest{Game: tc.gameToAdd}
-				response, err := client.GetClient().AddGame(ctx, &request)
+				response, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &request)
 				st, _ := status.FromError(err)
 				require.Error(t, err)
 				assert.Equal(t, codes.InvalidArgument, st.Code())
@@ -151,12 +152,12 @@ func TestAddGame(t *testing.T) {
 		genres, tags := dbT.GetGenres(ctx), dbT.GetTags(ctx)
 		gameToAdd := random.GameToAddRequest(model.GenreNames(genres), model.TagNames(tags))
 		request := game_api.AddGameRequest{Game: gameToAdd}
-		response, err := client.GetClient().AddGame(ctx, &request)
+		response, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &request)
 		require.NoError(t, err)
 		assert.NotZero(t, response.GameId)
 
 		request = game_api.AddGameRequest{Game: gameToAdd}
-		response, err = client.GetClient().AddGame(ctx, &request)
+		response, err = client.GetClient().AddGame(clientgrpc.WithUser(ctx), &request)
 
 		st, _ := status.FromError(err)
 		assert.Equal(t, codes.AlreadyExists, st.Code())
@@ -172,7 +173,7 @@ func TestAddGame(t *testing.T) {
 		gameToAdd.Genres = append(gameToAdd.Genres, gofakeit.LetterN(30))
 		request := game_api.AddGameRequest{Game: gameToAdd}
 
-		response, err := client.GetClient().AddGame(ctx, &request)
+		response, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &request)
 		st, _ := status.FromError(err)
 		assert.Equal(t, codes.InvalidArgument, st.Code())
 		assert.Nil(t, response)
@@ -187,9 +188,43 @@ func TestAddGame(t *testing.T) {
 		gameToAdd.Tags = append(gameToAdd.Tags, gofakeit.LetterN(30))
 		request := game_api.AddGameRequest{Game: gameToAdd}
 
-		response, err := client.GetClient().AddGame(ctx, &request)
+		response, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &request)
 		st, _ := status.FromError(err)
 		assert.Equal(t, codes.InvalidArgument, st.Code())
 		assert.Nil(t, response)
 	})
+	t.Run("Нельзя создать игру с обложкой, которая не является картинкой", func(t *testing.T) {
+		ctx := context.Background()
+		client := clientgrpc.NewGameServiceTestClient()
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		genres, tags := dbT.GetGenres(ctx), dbT.GetTags(ctx)
+		gameToAdd := random.GameToAddRequest(model.GenreNames(genres), model.TagNames(tags))
+		gameToAdd.CoverImage = []byte(gofakeit.Sentence(50))
+		request := game_api.AddGameRequest{Game: gameToAdd}
+
+		response, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &request)
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Equal(t, outerror.UnsupportedCoverImageMessage, st.Message())
+		assert.Nil(t, response)
+	})
+	t.Run("Обложка сверх лимита отклоняется валидатором, а не транспортом", func(t *testing.T) {
+		ctx := context.Background()
+		client := clientgrpc.NewGameServiceTestClient()
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		genres, tags := dbT.GetGenres(ctx), dbT.GetTags(ctx)
+		gameToAdd := random.GameToAddRequest(model.GenreNames(genres), model.TagNames(tags))
+		cover := make([]byte, clientgrpc.MaxCoverImageBytes()+1)
+		copy(cover, gameToAdd.CoverImage)
+		gameToAdd.CoverImage = cover
+		request := game_api.AddGameRequest{Game: gameToAdd}
+
+		response, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &request)
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Equal(t, outerror.CoverImageTooLargeMessage, st.Message())
+		assert.Nil(t, response)
+	})
 }
@@ -0,0 +1,104 @@
+//go:build integrations
+
+package game_test
+
+import (
+	"context"
+	"testing"
+
+	game_api "github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/lib/caller"
+	"github.com/sariya23/game_service/internal/model"
+	"github.com/sariya23/game_service/internal/outerror"
+	"github.com/sariya23/game_service/tests/clientgrpc"
+	"github.com/sariya23/game_service/tests/utils/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestCaller(t *testing.T) {
+	t.Run("Роль из метаданных user_role не принимается", func(t *testing.T) {
+		ctx := context.Background()
+		client := clientgrpc.NewGameServiceTestClient()
+		callCtx := metadata.AppendToOutgoingContext(ctx, "user_role", caller.RoleAdmin)
+
+		_, err := client.GetClient().GameList(callCtx, &game_api.GameListRequest{})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.Unauthenticated, st.Code())
+		assert.Equal(t, outerror.CallerMetadataForbiddenMessage, st.Message())
+	})
+	t.Run("Токен с чужой подписью отклоняется", func(t *testing.T) {
+		ctx := context.Background()
+		client := clientgrpc.NewGameServiceTestClient()
+		callCtx := metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer e30.e30.c2ln")
+
+		_, err := client.GetClient().GameList(callCtx, &game_api.GameListRequest{})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.Unauthenticated, st.Code())
+		assert.Equal(t, outerror.InvalidAuthTokenMessage, st.Message())
+	})
+	t.Run("Фильтр по статусу доступен только с токеном модератора", func(t *testing.T) {
+		ctx := context.Background()
+		client := clientgrpc.NewGameServiceTestClient()
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		genres, tags := dbT.GetGenres(ctx), dbT.GetTags(ctx)
+		gameToAdd := random.GameToAddRequest(model.GenreNames(genres), model.TagNames(tags))
+		respAddGame, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &game_api.AddGameRequest{Game: gameToAdd})
+		require.NoError(t, err)
+		request := &game_api.GameListRequest{Statuses: []game_api.GameStatusType{game_api.GameStatusType_DRAFT}}
+
+		_, err = client.GetClient().GameList(clientgrpc.WithRole(ctx, "user"), request)
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.PermissionDenied, st.Code())
+
+		resp, err := client.GetClient().GameList(clientgrpc.WithRole(ctx, caller.RoleModerator), request)
+		require.NoError(t, err)
+		ids := make([]int64, 0, len(resp.Games))
+		for _, g := range resp.Games {
+			ids = append(ids, g.ID)
+		}
+		assert.Contains(t, ids, respAddGame.GameId)
+	})
+	t.Run("Изменять игры без токена нельзя", func(t *testing.T) {
+		ctx := context.Background()
+		client := clientgrpc.NewGameServiceTestClient()
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		genres, tags := dbT.GetGenres(ctx), dbT.GetTags(ctx)
+		gameToAdd := random.GameToAddRequest(model.GenreNames(genres), model.TagNames(tags))
+		respAddGame, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &game_api.AddGameRequest{Game: gameToAdd})
+		require.NoError(t, err)
+		gameID := respAddGame.GameId
+
+		_, err = client.GetClient().AddGame(ctx, &game_api.AddGameRequest{Game: random.GameToAddRequest(model.GenreNames(genres), model.TagNames(tags))})
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.Unauthenticated, st.Code())
+		assert.Equal(t, outerror.CallerRequiredMessage, st.Message())
+
+		_, err = client.GetClient().UpdateGameStatus(ctx, &game_api.UpdateGameStatusRequest{GameId: gameID, NewStatus: game_api.GameStatusType_PUBLISH})
+		st, _ = status.FromError(err)
+		assert.Equal(t, codes.Unauthenticated, st.Code())
+
+		_, err = client.GetClient().RemoveGameCover(ctx, &game_api.RemoveGameCoverRequest{GameId: gameID})
+		st, _ = status.FromError(err)
+		assert.Equal(t, codes.Unauthenticated, st.Code())
+
+		_, err = client.GetClient().DeleteGame(ctx, &game_api.DeleteGameRequest{GameId: gameID})
+		st, _ = status.FromError(err)
+		assert.Equal(t, codes.Unauthenticated, st.Code())
+
+		_, err = client.GetClient().DeleteGame(clientgrpc.WithRole(ctx, "user"), &game_api.DeleteGameRequest{GameId: gameID})
+		st, _ = status.FromError(err)
+		assert.Equal(t, codes.PermissionDenied, st.Code())
+		assert.Equal(t, outerror.ModerationForbiddenMessage, st.Message())
+
+		_, err = client.GetClient().GetGame(clientgrpc.WithRole(ctx, caller.RoleModerator), &game_api.GetGameRequest{GameId: gameID})
+		require.NoError(t, err)
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/brianvoe/gofakeit/v7"
 	"github.com/minio/minio-go/v7"
 	game_api "github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/lib/caller"
 	"github.com/sariya23/game_service/internal/model"
 	"github.com/sariya23/game_service/tests/clientgrpc"
 	"github.com/sariya23/game_service/tests/utils/random"
@@ -19,29 +20,35 @@ import (
 )
 
 func TestDeleteGame(t *testing.T) {
-	t.Run("Успешное удаление игры", func(t *testing.T) {
+	t.Run("Успешное мягкое удаление игры", func(t *testing.T) {
 		ctx := context.Background()
 		client := clientgrpc.NewGameServiceTestClient()
 		dbT.SetUp(ctx, t, tables...)
 		defer dbT.TearDown(t)
 		genres, tags := dbT.GetGenres(ctx), dbT.GetTags(ctx)
 		gameToAdd := random.GameToAddRequest(model.GenreNames(genres), model.TagNames(tags))
-		respAddGame, err := client.GetClient().AddGame(ctx, &game_api.AddGameRequest{Game: gameToAdd})
+		respAddGame, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &game_api.AddGameRequest{Game: gameToAdd})
 		require.NoError(t, err)
 		assert.NotZero(t, respAddGame.GameId)
 		gameNoImageURL := dbT.GetGameById(ctx, respAddGame.GameId)
 		
 		request := game_api.DeleteGameRequest{GameId: respAddGame.GameId}
 
-		response, err := client.GetClient().DeleteGame(ctx, &request)
+		response, err := client.GetClient().DeleteGame(clientgrpc.WithRole(ctx, caller.RoleModerator), &request)
 
 		require.NoError(t, err)
 		assert.Equal(t, respAddGame.GameId, response.GameId)
-		assert.Len(t, dbT.GetGameGenreByGameID(ctx, respAddGame.GameId), 0)
-		assert.Len(t, dbT.GetGameTagByGameID(ctx, respAddGame.GameId), 0)
-		assert.Nil(t, dbT.GetGameById(ctx, respAddGame.GameId))
+		assert.Len(t, dbT.GetGameGenreByGameID(ctx, respAddGame.GameId), len(gameToAdd.Genres))
+		assert.Len(t, dbT.GetGameTagByGameID(ctx, respAddGame.GameId), len(gameToAdd.Tags))
+		assert.NotNil(t, dbT.GetGameById(ctx, respAddGame.GameId))
 		_, err = minioT.GetClient().StatObject(ctx, minioT.BucketName, gameNoImageURL.ImageKey, minio.GetObjectOptions{})
-		require.Equal(t, "The specified key does not exist.", err.Error())
+		require.NoError(t, err)
+		_, err = client.GetClient().GetGame(clientgrpc.WithRole(ctx, caller.RoleModerator), &game_api.GetGameRequest{GameId: respAddGame.GameId})
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.NotFound, st.Code())
+		_, err = client.GetClient().DeleteGame(clientgrpc.WithRole(ctx, caller.RoleModerator), &request)
+		st, _ = status.FromError(err)
+		assert.Equal(t, codes.NotFound, st.Code())
 	})
 	t.Run("Удаление несуществующей игры, возвращается ошибка", func(t *testing.T) {
 		ctx := context.Background()
@@ -51,7 +58,7 @@ func TestDeleteGame(t *testing.T) {
 		gameID := gofakeit.Int64()
 		request := game_api.DeleteGameRequest{GameId: gameID}
 
-		response, err := client.GetClient().DeleteGame(ctx, &request)
+		response, err := client.GetClient().DeleteGame(clientgrpc.WithRole(ctx, caller.RoleModerator), &request)
 		st, _ := status.FromError(err)
 		require.Error(t, err)
 		assert.Equal(t, codes.NotFound, st.Code())
@@ -0,0 +1,161 @@
+//go:build integrations
+
+package game_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	game_api "github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/events"
+	"github.com/sariya23/game_service/internal/interceptors"
+	"github.com/sariya23/game_service/internal/lib/caller"
+	"github.com/sariya23/game_service/internal/lib/mockslog"
+	"github.com/sariya23/game_service/internal/model"
+	"github.com/sariya23/game_service/internal/model/dto"
+	"github.com/sariya23/game_service/internal/outerror"
+	"github.com/sariya23/game_service/internal/storage/postgresql/gamerepo"
+	outboxrepo "github.com/sariya23/game_service/internal/storage/postgresql/outbox_repo"
+	"github.com/sariya23/game_service/tests/clientgrpc"
+	"github.com/sariya23/game_service/tests/utils/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestDeletedGames проверяет корзину и очистку в репозитории.
+func TestDeletedGames(t *testing.T) {
+	ctx := context.WithValue(context.Background(), interceptors.RequestIDKey, gofakeit.UUID())
+	repo := gamerepo.NewGameRepository(dbT.DB, mockslog.NewDiscardLogger())
+	t.Run("Удаленная игра попадает в корзину и восстанавливается", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		gameID := dbT.InsertGame(ctx, random.GameToAddService(nil, nil))
+		_, err := repo.DaleteGame(ctx, gameID)
+		require.NoError(t, err)
+
+		_, err = repo.GetGameByID(ctx, gameID)
+		assert.True(t, errors.Is(err, outerror.ErrGameNotFound))
+		deleted, err := repo.ListDeletedGames(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, deleted, 1)
+		assert.Equal(t, gameID, deleted[0].GameID)
+		assert.False(t, deleted[0].DeletedAt.IsZero())
+
+		require.NoError(t, repo.RestoreGame(ctx, gameID, "moderator-1", "req-1"))
+		restored, err := repo.GetGameByID(ctx, gameID)
+		require.NoError(t, err)
+		assert.Equal(t, gameID, restored.GameID)
+		deleted, err = repo.ListDeletedGames(ctx, 10)
+		require.NoError(t, err)
+		assert.Empty(t, deleted)
+	})
+	t.Run("Нельзя восстановить игру, если есть живой дубликат", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		gameToAdd := random.GameToAddService(nil, nil)
+		gameID := dbT.InsertGame(ctx, gameToAdd)
+		_, err := repo.DaleteGame(ctx, gameID)
+		require.NoError(t, err)
+		dbT.InsertGame(ctx, gameToAdd)
+
+		err = repo.RestoreGame(ctx, gameID, "moderator-1", "req-1")
+
+		assert.True(t, errors.Is(err, outerror.ErrGameAlreadyExist))
+	})
+	t.Run("Нельзя восстановить не удаленную игру", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		gameID := dbT.InsertGame(ctx, random.GameToAddService(nil, nil))
+
+		err := repo.RestoreGame(ctx, gameID, "moderator-1", "req-1")
+
+		assert.True(t, errors.Is(err, outerror.ErrGameNotFound))
+	})
+	t.Run("Очистка удаляет только игры старше срока хранения", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		oldID := dbT.InsertGame(ctx, random.GameToAddService(nil, nil))
+		recentID := dbT.InsertGame(ctx, random.GameToAddService(nil, nil))
+		aliveID := dbT.InsertGame(ctx, random.GameToAddService(nil, nil))
+		dbT.UpdateGameStatus(ctx, oldID, game_api.GameStatusType_DRAFT)
+		require.NoError(t, repo.ModerateGame(ctx, dto.GameModeration{
+			GameID:     oldID,
+			Decision:   model.ModerationSubmit,
+			FromStatus: game_api.GameStatusType_DRAFT,
+			ToStatus:   game_api.GameStatusType_PENDING,
+			Actor:      "author-1",
+		}))
+		now := time.Now()
+		dbT.SetGameDeletedAt(ctx, oldID, now.Add(-48*time.Hour))
+		dbT.SetGameDeletedAt(ctx, recentID, now.Add(-time.Hour))
+
+		purged, err := repo.PurgeDeletedGames(ctx, now.Add(-24*time.Hour), 10)
+
+		require.NoError(t, err)
+		require.Len(t, purged, 1)
+		assert.Equal(t, oldID, purged[0].GameID)
+		assert.Nil(t, dbT.GetGameById(ctx, oldID))
+		assert.Len(t, dbT.GetGameModerations(ctx, oldID), 1)
+		assert.Len(t, dbT.GetGameStatusHistory(ctx, oldID), 1)
+		assert.NotNil(t, dbT.GetGameById(ctx, recentID))
+		assert.NotNil(t, dbT.GetGameById(ctx, aliveID))
+	})
+}
+
+func TestDeletedGamesRPC(t *testing.T) {
+	t.Run("Модератор видит корзину и восстанавливает игру с событием GameRestored", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), interceptors.RequestIDKey, gofakeit.UUID())
+		client := clientgrpc.NewGameServiceTestClient()
+		outboxRepo := outboxrepo.NewOutboxRepository(dbT.DB, mockslog.NewDiscardLogger())
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		genres, tags := dbT.GetGenres(ctx), dbT.GetTags(ctx)
+		gameToAdd := random.GameToAddRequest(model.GenreNames(genres), model.TagNames(tags))
+		respAddGame, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &game_api.AddGameRequest{Game: gameToAdd})
+		require.NoError(t, err)
+		_, err = client.GetClient().DeleteGame(clientgrpc.WithRole(ctx, caller.RoleModerator), &game_api.DeleteGameRequest{GameId: respAddGame.GameId})
+		require.NoError(t, err)
+		moderator := gofakeit.UUID()
+		moderatorCtx := clientgrpc.WithCaller(ctx, moderator, caller.RoleModerator)
+
+		_, err = client.GetClient().ListDeletedGames(clientgrpc.WithRole(ctx, "user"), &game_api.ListDeletedGamesRequest{})
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.PermissionDenied, st.Code())
+		deleted, err := client.GetClient().ListDeletedGames(moderatorCtx, &game_api.ListDeletedGamesRequest{})
+		require.NoError(t, err)
+		require.Len(t, deleted.Games, 1)
+		assert.Equal(t, respAddGame.GameId, deleted.Games[0].GameId)
+		assert.Equal(t, gameToAdd.Title, deleted.Games[0].Title)
+		assert.NotNil(t, deleted.Games[0].DeletedAt)
+
+		_, err = client.GetClient().RestoreGame(clientgrpc.WithRole(ctx, "user"), &game_api.RestoreGameRequest{GameId: respAddGame.GameId})
+		st, _ = status.FromError(err)
+		assert.Equal(t, codes.PermissionDenied, st.Code())
+		_, err = client.GetClient().RestoreGame(moderatorCtx, &game_api.RestoreGameRequest{GameId: respAddGame.GameId})
+		require.NoError(t, err)
+		assert.NotNil(t, dbT.GetGameById(ctx, respAddGame.GameId))
+
+		var delivered []model.OutboxEvent
+		record := func(event model.OutboxEvent) error {
+			delivered = append(delivered, event)
+			return nil
+		}
+		for range 3 {
+			_, _, _, err = outboxRepo.RelayBatch(ctx, 10, 1, record, func(int) time.Duration { return 0 })
+			require.NoError(t, err)
+		}
+		require.Len(t, delivered, 3)
+		assert.Equal(t, events.GameRestored, delivered[2].EventType)
+		var payload events.GameRestoredPayload
+		require.NoError(t, json.Unmarshal(delivered[2].Payload, &payload))
+		assert.Equal(t, respAddGame.GameId, payload.GameID)
+		assert.Equal(t, moderator, payload.Actor)
+		assert.Equal(t, game_api.GameStatusType_DRAFT.String(), payload.Status)
+	})
+}
@@ -0,0 +1,124 @@
+//go:build integrations
+
+package game_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/minio/minio-go/v7"
+	game_api "github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/model"
+	"github.com/sariya23/game_service/tests/clientgrpc"
+	"github.com/sariya23/game_service/tests/utils/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGameCover(t *testing.T) {
+	t.Run("Замена обложки удаляет старый объект", func(t *testing.T) {
+		ctx := context.Background()
+		client := clientgrpc.NewGameServiceTestClient()
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		genres, tags := dbT.GetGenres(ctx), dbT.GetTags(ctx)
+		gameToAdd := random.GameToAddRequest(model.GenreNames(genres), model.TagNames(tags))
+		respAddGame, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &game_api.AddGameRequest{Game: gameToAdd})
+		require.NoError(t, err)
+		oldImageKey := dbT.GetGameById(ctx, respAddGame.GameId).ImageKey
+		newCover, err := random.UniqueImage()
+		require.NoError(t, err)
+
+		_, err = client.GetClient().SetGameCover(clientgrpc.WithUser(ctx), &game_api.SetGameCoverRequest{GameId: respAddGame.GameId, CoverImage: newCover})
+
+		require.NoError(t, err)
+		newImageKey := dbT.GetGameById(ctx, respAddGame.GameId).ImageKey
+		assert.NotEqual(t, oldImageKey, newImageKey)
+		_, err = minioT.GetClient().StatObject(ctx, minioT.BucketName, newImageKey, minio.StatObjectOptions{})
+		require.NoError(t, err)
+		_, err = minioT.GetClient().StatObject(ctx, minioT.BucketName, oldImageKey, minio.StatObjectOptions{})
+		require.Error(t, err)
+
+		_, err = client.GetClient().RemoveGameCover(clientgrpc.WithUser(ctx), &game_api.RemoveGameCoverRequest{GameId: respAddGame.GameId})
+
+		require.NoError(t, err)
+		assert.Empty(t, dbT.GetGameById(ctx, respAddGame.GameId).ImageKey)
+		_, err = minioT.GetClient().StatObject(ctx, minioT.BucketName, newImageKey, minio.StatObjectOptions{})
+		require.Error(t, err)
+	})
+	t.Run("Параллельные замены не оставляют игру без объекта", func(t *testing.T) {
+		ctx := context.Background()
+		client := clientgrpc.NewGameServiceTestClient()
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		gameToAdd := random.GameToAddRequest(nil, nil)
+		respAddGame, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &game_api.AddGameRequest{Game: gameToAdd})
+		require.NoError(t, err)
+
+		wg := sync.WaitGroup{}
+		for range 5 {
+			cover, err := random.UniqueImage()
+			require.NoError(t, err)
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := client.GetClient().SetGameCover(clientgrpc.WithUser(ctx), &game_api.SetGameCoverRequest{GameId: respAddGame.GameId, CoverImage: cover})
+				assert.NoError(t, err)
+			}()
+		}
+		wg.Wait()
+
+		imageKey := dbT.GetGameById(ctx, respAddGame.GameId).ImageKey
+		_, err = minioT.GetClient().StatObject(ctx, minioT.BucketName, imageKey, minio.StatObjectOptions{})
+		require.NoError(t, err)
+	})
+	t.Run("Повторная загрузка того же содержимого не теряет обложку", func(t *testing.T) {
+		ctx := context.Background()
+		client := clientgrpc.NewGameServiceTestClient()
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		gameToAdd := random.GameToAddRequest(nil, nil)
+		respAddGame, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &game_api.AddGameRequest{Game: gameToAdd})
+		require.NoError(t, err)
+		first, err := random.UniqueImage()
+		require.NoError(t, err)
+		second, err := random.UniqueImage()
+		require.NoError(t, err)
+
+		wg := sync.WaitGroup{}
+		for i := range 10 {
+			cover := first
+			if i%2 == 1 {
+				cover = second
+			}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := client.GetClient().SetGameCover(clientgrpc.WithUser(ctx), &game_api.SetGameCoverRequest{GameId: respAddGame.GameId, CoverImage: cover})
+				assert.NoError(t, err)
+			}()
+		}
+		wg.Wait()
+
+		imageKey := dbT.GetGameById(ctx, respAddGame.GameId).ImageKey
+		_, err = minioT.GetClient().StatObject(ctx, minioT.BucketName, imageKey, minio.StatObjectOptions{})
+		require.NoError(t, err)
+	})
+	t.Run("Обложка, которая не является картинкой, не сохраняется", func(t *testing.T) {
+		ctx := context.Background()
+		client := clientgrpc.NewGameServiceTestClient()
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		gameToAdd := random.GameToAddRequest(nil, nil)
+		respAddGame, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &game_api.AddGameRequest{Game: gameToAdd})
+		require.NoError(t, err)
+
+		_, err = client.GetClient().SetGameCover(clientgrpc.WithUser(ctx), &game_api.SetGameCoverRequest{GameId: respAddGame.GameId, CoverImage: []byte("not an image")})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+}
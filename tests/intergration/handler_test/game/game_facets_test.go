@@ -0,0 +1,84 @@
+//go:build integrations
+
+package game_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	game_api "github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/interceptors"
+	"github.com/sariya23/game_service/internal/lib/caller"
+	"github.com/sariya23/game_service/internal/lib/mockslog"
+	"github.com/sariya23/game_service/internal/model"
+	"github.com/sariya23/game_service/internal/model/dto"
+	"github.com/sariya23/game_service/internal/storage/postgresql/gamerepo"
+	"github.com/sariya23/game_service/tests/clientgrpc"
+	"github.com/sariya23/game_service/tests/utils/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGameFacets(t *testing.T) {
+	ctx := context.WithValue(context.Background(), interceptors.RequestIDKey, gofakeit.UUID())
+	repo := gamerepo.NewGameRepository(dbT.DB, mockslog.NewDiscardLogger())
+	dbT.SetUp(ctx, t, tables...)
+	defer dbT.TearDown(t)
+	tags, genres := dbT.GetTags(ctx), dbT.GetGenres(ctx)
+	require.GreaterOrEqual(t, len(tags), 2)
+	require.GreaterOrEqual(t, len(genres), 1)
+	insertGame := func(year int, tagIDs []int64) {
+		gameToAdd := random.GameToAddService(nil, nil)
+		gameToAdd.ReleaseDate = time.Date(year, time.March, 1, 0, 0, 0, 0, time.UTC)
+		gameID := dbT.InsertGame(ctx, gameToAdd)
+		dbT.InsertGameTag(ctx, gameID, tagIDs)
+		dbT.InsertGameGenre(ctx, gameID, []int64{genres[0].GenreID})
+	}
+	insertGame(2010, []int64{tags[0].TagID, tags[1].TagID})
+	insertGame(2010, []int64{tags[0].TagID})
+	insertGame(2020, []int64{tags[1].TagID})
+
+	t.Run("Без фильтров", func(t *testing.T) {
+		facets, err := repo.GameFacets(ctx, dto.GameFilters{})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []model.FacetCount{
+			{Value: tags[0].TagName, Count: 2},
+			{Value: tags[1].TagName, Count: 2},
+		}, facets.Tags)
+		assert.Equal(t, []model.FacetCount{{Value: genres[0].GenreName, Count: 3}}, facets.Genres)
+		assert.Equal(t, []model.YearFacetCount{{Year: 2020, Count: 1}, {Year: 2010, Count: 2}}, facets.Years)
+	})
+	t.Run("Фильтр по тэгу не сужает свой фасет", func(t *testing.T) {
+		facets, err := repo.GameFacets(ctx, dto.GameFilters{Tags: []string{tags[0].TagName}})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []model.FacetCount{
+			{Value: tags[0].TagName, Count: 2},
+			{Value: tags[1].TagName, Count: 2},
+		}, facets.Tags)
+		assert.Equal(t, []model.FacetCount{{Value: genres[0].GenreName, Count: 2}}, facets.Genres)
+		assert.Equal(t, []model.YearFacetCount{{Year: 2010, Count: 2}}, facets.Years)
+	})
+	t.Run("Фильтр по году не сужает свой фасет", func(t *testing.T) {
+		facets, err := repo.GameFacets(ctx, dto.GameFilters{ReleaseYear: 2020})
+		require.NoError(t, err)
+		assert.Equal(t, []model.FacetCount{{Value: tags[1].TagName, Count: 1}}, facets.Tags)
+		assert.Equal(t, []model.YearFacetCount{{Year: 2020, Count: 1}, {Year: 2010, Count: 2}}, facets.Years)
+	})
+	t.Run("Через клиент с теми же фильтрами, что у GameList", func(t *testing.T) {
+		client := clientgrpc.NewGameServiceTestClient()
+		response, err := client.GetClient().GameFacets(clientgrpc.WithRole(ctx, caller.RoleAdmin), &game_api.GameFacetsRequest{Year: 2020})
+		require.NoError(t, err)
+		require.Len(t, response.Tags, 1)
+		assert.Equal(t, tags[1].TagName, response.Tags[0].Value)
+		assert.Equal(t, int64(1), response.Tags[0].Count)
+		assert.Len(t, response.Years, 2)
+
+		_, err = client.GetClient().GameFacets(ctx, &game_api.GameFacetsRequest{Decade: 1995})
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+}
@@ -6,10 +6,19 @@ import (
 	"context"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/brianvoe/gofakeit/v7"
 	game_api "github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/interceptors"
+	"github.com/sariya23/game_service/internal/lib/caller"
+	"github.com/sariya23/game_service/internal/lib/converters"
+	"github.com/sariya23/game_service/internal/lib/mockslog"
 	"github.com/sariya23/game_service/internal/model"
+	"github.com/sariya23/game_service/internal/model/dto"
+	"github.com/sariya23/game_service/internal/outerror"
+	"github.com/sariya23/game_service/internal/storage/postgresql/gamerepo"
+	minioclient "github.com/sariya23/game_service/internal/storage/s3/minio"
 	"github.com/sariya23/game_service/tests/clientgrpc"
 	"github.com/sariya23/game_service/tests/utils/ds"
 	"github.com/sariya23/game_service/tests/utils/random"
@@ -31,7 +40,7 @@ func TestGameList(t *testing.T) {
 		for range n {
 			gameToAdd := random.GameToAddRequest(model.GenreNames(genres), model.TagNames(tags))
 			request := game_api.AddGameRequest{Game: gameToAdd}
-			responseAdd, err := client.GetClient().AddGame(ctx, &request)
+			responseAdd, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &request)
 			require.NoError(t, err)
 			gameIDs = append(gameIDs, responseAdd.GameId)
 			assert.NotZero(t, responseAdd.GameId)
@@ -57,8 +66,25 @@ func TestGameList(t *testing.T) {
 			assert.Equal(t, expectedGame.Title, response.Games[i].Title)
 			assert.Equal(t, expectedGame.Description, response.Games[i].Description)
 			assert.Contains(t, response.Games[i].CoverImageUrl, expectedGame.ImageKey)
+			assert.Contains(t, response.Games[i].ThumbnailUrl, minioclient.CoverVariantKey(expectedGame.ImageKey, minioclient.CoverVariantWidths[0]))
 		}
 	})
+	t.Run("Без сохраненной миниатюры отдается ссылка на оригинал", func(t *testing.T) {
+		ctx := context.Background()
+		client := clientgrpc.NewGameServiceTestClient()
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		gameID := dbT.InsertGame(ctx, random.GameToAddService(nil, nil))
+		dbT.UpdateGameStatus(ctx, gameID, game_api.GameStatusType_PUBLISH)
+		require.Empty(t, dbT.GetGameById(ctx, gameID).ImageVariantWidths)
+
+		response, err := client.GetClient().GameList(ctx, &game_api.GameListRequest{})
+
+		require.NoError(t, err)
+		require.Len(t, response.Games, 1)
+		assert.NotEmpty(t, response.Games[0].CoverImageUrl)
+		assert.Equal(t, response.Games[0].CoverImageUrl, response.Games[0].ThumbnailUrl)
+	})
 	t.Run("Список игр, фильтрация по годам, дефолтный лимит", func(t *testing.T) {
 		ctx := context.Background()
 		client := clientgrpc.NewGameServiceTestClient()
@@ -70,7 +96,7 @@ func TestGameList(t *testing.T) {
 		for range n {
 			gameToAdd := random.GameToAddRequest(model.GenreNames(genres), model.TagNames(tags))
 			request := game_api.AddGameRequest{Game: gameToAdd}
-			responseAdd, err := client.GetClient().AddGame(ctx, &request)
+			responseAdd, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &request)
 			require.NoError(t, err)
 			gameNoImageURL := dbT.GetGameById(ctx, responseAdd.GameId)
 			games = append(games, *gameNoImageURL)
@@ -126,7 +152,7 @@ func TestGameList(t *testing.T) {
 		for range n {
 			gameToAdd := random.GameToAddRequest(model.GenreNames(genres), model.TagNames(tags))
 			request := game_api.AddGameRequest{Game: gameToAdd}
-			responseAdd, err := client.GetClient().AddGame(ctx, &request)
+			responseAdd, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &request)
 			gameNoImageURL := dbT.GetGameById(ctx, responseAdd.GameId)
 			games = append(games, *gameNoImageURL)
 			require.NoError(t, err)
@@ -183,7 +209,7 @@ func TestGameList(t *testing.T) {
 		for range n {
 			gameToAdd := random.GameToAddRequest(model.GenreNames(genres), model.TagNames(tags))
 			request := game_api.AddGameRequest{Game: gameToAdd}
-			responseAdd, err := client.GetClient().AddGame(ctx, &request)
+			responseAdd, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &request)
 			gameNoImageURL := dbT.GetGameById(ctx, responseAdd.GameId)
 			games = append(games, *gameNoImageURL)
 			require.NoError(t, err)
@@ -240,7 +266,7 @@ func TestGameList(t *testing.T) {
 		for range n {
 			gameToAdd := random.GameToAddRequest(model.GenreNames(genres), model.TagNames(tags))
 			request := game_api.AddGameRequest{Game: gameToAdd}
-			responseAdd, err := client.GetClient().AddGame(ctx, &request)
+			responseAdd, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &request)
 			gameNoImageURL := dbT.GetGameById(ctx, responseAdd.GameId)
 			games = append(games, *gameNoImageURL)
 			require.NoError(t, err)
@@ -300,6 +326,213 @@ func TestGameList(t *testing.T) {
 			assert.Contains(t, response.Games[i].CoverImageUrl, expectedGame.ImageKey)
 		}
 	})
+	t.Run("Неопубликованные игры видны только привилегированным", func(t *testing.T) {
+		ctx := context.Background()
+		client := clientgrpc.NewGameServiceTestClient()
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		genres, tags := dbT.GetGenres(ctx), dbT.GetTags(ctx)
+		statuses := []game_api.GameStatusType{
+			game_api.GameStatusType_DRAFT,
+			game_api.GameStatusType_PENDING,
+			game_api.GameStatusType_PUBLISH,
+		}
+		var publishedGameID int64
+		for _, st := range statuses {
+			gameToAdd := random.GameToAddRequest(model.GenreNames(genres), model.TagNames(tags))
+			responseAdd, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &game_api.AddGameRequest{Game: gameToAdd})
+			require.NoError(t, err)
+			dbT.UpdateGameStatus(ctx, responseAdd.GameId, st)
+			if st == game_api.GameStatusType_PUBLISH {
+				publishedGameID = responseAdd.GameId
+			}
+		}
+
+		response, err := client.GetClient().GameList(ctx, &game_api.GameListRequest{})
+		require.NoError(t, err)
+		require.Len(t, response.Games, 1)
+		assert.Equal(t, publishedGameID, response.Games[0].ID)
+
+		response, err = client.GetClient().GameList(clientgrpc.WithRole(ctx, caller.RoleAdmin), &game_api.GameListRequest{})
+		require.NoError(t, err)
+		assert.Len(t, response.Games, len(statuses))
+	})
+	t.Run("Полнотекстовый поиск по названию", func(t *testing.T) {
+		ctx := context.Background()
+		client := clientgrpc.NewGameServiceTestClient()
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		witcher := random.GameToAddRequest(nil, nil)
+		witcher.Title = "Ведьмак " + gofakeit.LetterN(10)
+		responseAdd, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &game_api.AddGameRequest{Game: witcher})
+		require.NoError(t, err)
+		dbT.UpdateGameStatus(ctx, responseAdd.GameId, game_api.GameStatusType_PUBLISH)
+		other := random.GameToAddRequest(nil, nil)
+		responseOther, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &game_api.AddGameRequest{Game: other})
+		require.NoError(t, err)
+		dbT.UpdateGameStatus(ctx, responseOther.GameId, game_api.GameStatusType_PUBLISH)
+
+		response, err := client.GetClient().GameList(ctx, &game_api.GameListRequest{Query: "ведьмаки"})
+
+		require.NoError(t, err)
+		require.Len(t, response.Games, 1)
+		assert.Equal(t, responseAdd.GameId, response.Games[0].ID)
+	})
+	t.Run("Постраничная выдача по page_token", func(t *testing.T) {
+		ctx := context.Background()
+		client := clientgrpc.NewGameServiceTestClient()
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		n := 25
+		for range n {
+			responseAdd, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &game_api.AddGameRequest{Game: random.GameToAddRequest(nil, nil)})
+			require.NoError(t, err)
+			dbT.UpdateGameStatus(ctx, responseAdd.GameId, game_api.GameStatusType_PUBLISH)
+		}
+
+		seen := make(map[int64]struct{}, n)
+		pageToken := ""
+		for page := 0; ; page++ {
+			require.Less(t, page, n, "pagination does not terminate")
+			response, err := client.GetClient().GameList(ctx, &game_api.GameListRequest{Limit: 10, PageToken: pageToken})
+			require.NoError(t, err)
+			for _, g := range response.Games {
+				_, duplicate := seen[g.ID]
+				require.False(t, duplicate, "game %d returned twice", g.ID)
+				seen[g.ID] = struct{}{}
+			}
+			if page == 0 {
+				// Новая игра между страницами не сдвигает выдачу.
+				responseAdd, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &game_api.AddGameRequest{Game: random.GameToAddRequest(nil, nil)})
+				require.NoError(t, err)
+				dbT.UpdateGameStatus(ctx, responseAdd.GameId, game_api.GameStatusType_PUBLISH)
+				n++
+			}
+			if response.NextPageToken == "" {
+				break
+			}
+			pageToken = response.NextPageToken
+		}
+		assert.GreaterOrEqual(t, len(seen), n-1)
+
+		_, err := client.GetClient().GameList(ctx, &game_api.GameListRequest{PageToken: "not a token"})
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+
+		// Токен привязан к фильтрам: с другими фильтрами выдача пропускала бы строки.
+		firstPage, err := client.GetClient().GameList(ctx, &game_api.GameListRequest{Limit: 10})
+		require.NoError(t, err)
+		require.NotEmpty(t, firstPage.NextPageToken)
+		_, err = client.GetClient().GameList(ctx, &game_api.GameListRequest{Limit: 10, Year: 2015, PageToken: firstPage.NextPageToken})
+		st, _ = status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Equal(t, outerror.InvalidPageTokenMessage, st.Message())
+	})
+	t.Run("Сортировка по дате выхода", func(t *testing.T) {
+		ctx := context.Background()
+		client := clientgrpc.NewGameServiceTestClient()
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		gameIDs := make([]int64, 0, 3)
+		for _, year := range []int{2001, 2015, 1998} {
+			gameToAdd := random.GameToAddRequest(nil, nil)
+			gameToAdd.ReleaseDate = converters.ToProtoDate(time.Date(year, time.March, 1, 0, 0, 0, 0, time.UTC))
+			responseAdd, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &game_api.AddGameRequest{Game: gameToAdd})
+			require.NoError(t, err)
+			dbT.UpdateGameStatus(ctx, responseAdd.GameId, game_api.GameStatusType_PUBLISH)
+			gameIDs = append(gameIDs, responseAdd.GameId)
+		}
+		ids := func(response *game_api.GameListResponse) []int64 {
+			res := make([]int64, 0, len(response.Games))
+			for _, g := range response.Games {
+				res = append(res, g.ID)
+			}
+			return res
+		}
+
+		response, err := client.GetClient().GameList(ctx, &game_api.GameListRequest{Sort: game_api.GameSort_GAME_SORT_NEWEST})
+		require.NoError(t, err)
+		assert.Equal(t, []int64{gameIDs[1], gameIDs[0], gameIDs[2]}, ids(response))
+
+		response, err = client.GetClient().GameList(ctx, &game_api.GameListRequest{Sort: game_api.GameSort_GAME_SORT_OLDEST})
+		require.NoError(t, err)
+		assert.Equal(t, []int64{gameIDs[2], gameIDs[0], gameIDs[1]}, ids(response))
+
+		_, err = client.GetClient().GameList(ctx, &game_api.GameListRequest{Sort: game_api.GameSort_GAME_SORT_RELEVANCE})
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+	t.Run("Режим ALL и исключение тэгов", func(t *testing.T) {
+		ctx := context.Background()
+		client := clientgrpc.NewGameServiceTestClient()
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		tags := model.TagNames(dbT.GetTags(ctx))
+		require.GreaterOrEqual(t, len(tags), 3)
+		addGame := func(gameTags []string) int64 {
+			gameToAdd := random.GameToAddRequest(nil, nil)
+			gameToAdd.Tags = gameTags
+			responseAdd, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &game_api.AddGameRequest{Game: gameToAdd})
+			require.NoError(t, err)
+			dbT.UpdateGameStatus(ctx, responseAdd.GameId, game_api.GameStatusType_PUBLISH)
+			return responseAdd.GameId
+		}
+		both := addGame([]string{tags[0], tags[1]})
+		addGame([]string{tags[0]})
+		addGame([]string{tags[0], tags[1], tags[2]})
+
+		response, err := client.GetClient().GameList(ctx, &game_api.GameListRequest{
+			Tags:        []string{tags[0], tags[1]},
+			TagsMatch:   game_api.MatchMode_MATCH_ALL,
+			ExcludeTags: []string{tags[2]},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, response.Games, 1)
+		assert.Equal(t, both, response.Games[0].ID)
+	})
+	t.Run("Диапазон дат выхода", func(t *testing.T) {
+		ctx := context.Background()
+		client := clientgrpc.NewGameServiceTestClient()
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		addGame := func(releaseDate time.Time) int64 {
+			gameToAdd := random.GameToAddRequest(nil, nil)
+			gameToAdd.ReleaseDate = converters.ToProtoDate(releaseDate)
+			responseAdd, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &game_api.AddGameRequest{Game: gameToAdd})
+			require.NoError(t, err)
+			dbT.UpdateGameStatus(ctx, responseAdd.GameId, game_api.GameStatusType_PUBLISH)
+			return responseAdd.GameId
+		}
+		addGame(time.Date(1995, time.June, 1, 0, 0, 0, 0, time.UTC))
+		inRange := addGame(time.Date(2005, time.June, 1, 0, 0, 0, 0, time.UTC))
+		upcoming := addGame(time.Now().UTC().AddDate(1, 0, 0))
+
+		response, err := client.GetClient().GameList(ctx, &game_api.GameListRequest{
+			ReleasedAfter:  converters.ToProtoDate(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)),
+			ReleasedBefore: converters.ToProtoDate(time.Date(2010, time.January, 1, 0, 0, 0, 0, time.UTC)),
+		})
+		require.NoError(t, err)
+		require.Len(t, response.Games, 1)
+		assert.Equal(t, inRange, response.Games[0].ID)
+
+		response, err = client.GetClient().GameList(ctx, &game_api.GameListRequest{UpcomingOnly: true})
+		require.NoError(t, err)
+		require.Len(t, response.Games, 1)
+		assert.Equal(t, upcoming, response.Games[0].ID)
+
+		response, err = client.GetClient().GameList(ctx, &game_api.GameListRequest{Decade: 2000})
+		require.NoError(t, err)
+		require.Len(t, response.Games, 1)
+		assert.Equal(t, inRange, response.Games[0].ID)
+
+		_, err = client.GetClient().GameList(ctx, &game_api.GameListRequest{
+			ReleasedAfter:  converters.ToProtoDate(time.Date(2010, time.January, 1, 0, 0, 0, 0, time.UTC)),
+			ReleasedBefore: converters.ToProtoDate(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)),
+		})
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
 	t.Run("Отрицательный год", func(t *testing.T) {
 		ctx := context.Background()
 		client := clientgrpc.NewGameServiceTestClient()
@@ -311,3 +544,164 @@ func TestGameList(t *testing.T) {
 		assert.Nil(t, response)
 	})
 }
+
+// Сочетания режимов ANY/ALL и исключений проверяются на репозитории напрямую,
+// проброс полей из запроса - в TestGameList.
+func TestGameList_MatchModeAndExclude(t *testing.T) {
+	ctx := context.WithValue(context.Background(), interceptors.RequestIDKey, gofakeit.UUID())
+	repo := gamerepo.NewGameRepository(dbT.DB, mockslog.NewDiscardLogger())
+	gameIDs := func(games []model.ShortGameNoImageURL) []int64 {
+		res := make([]int64, 0, len(games))
+		for _, g := range games {
+			res = append(res, g.GameID)
+		}
+		return res
+	}
+	dbT.SetUp(ctx, t, tables...)
+	defer dbT.TearDown(t)
+	tags, genres := dbT.GetTags(ctx), dbT.GetGenres(ctx)
+	require.GreaterOrEqual(t, len(tags), 3)
+	require.GreaterOrEqual(t, len(genres), 3)
+	insertGame := func(tagIdx, genreIdx []int) int64 {
+		gameID := dbT.InsertGame(ctx, random.GameToAddService(nil, nil))
+		tagIDs := make([]int64, 0, len(tagIdx))
+		for _, i := range tagIdx {
+			tagIDs = append(tagIDs, tags[i].TagID)
+		}
+		genreIDs := make([]int64, 0, len(genreIdx))
+		for _, i := range genreIdx {
+			genreIDs = append(genreIDs, genres[i].GenreID)
+		}
+		if len(tagIDs) > 0 {
+			dbT.InsertGameTag(ctx, gameID, tagIDs)
+		}
+		if len(genreIDs) > 0 {
+			dbT.InsertGameGenre(ctx, gameID, genreIDs)
+		}
+		return gameID
+	}
+	both := insertGame([]int{0, 1}, []int{0, 1})
+	first := insertGame([]int{0}, []int{0})
+	second := insertGame([]int{1, 2}, []int{1, 2})
+	untagged := insertGame(nil, nil)
+
+	cases := []struct {
+		name     string
+		filters  dto.GameFilters
+		expected []int64
+	}{
+		{
+			name:     "tags any",
+			filters:  dto.GameFilters{Tags: []string{tags[0].TagName, tags[1].TagName}},
+			expected: []int64{both, first, second},
+		},
+		{
+			name:     "tags all",
+			filters:  dto.GameFilters{Tags: []string{tags[0].TagName, tags[1].TagName}, TagsMatch: dto.MatchAll},
+			expected: []int64{both},
+		},
+		{
+			name:     "genres all",
+			filters:  dto.GameFilters{Genres: []string{genres[1].GenreName, genres[2].GenreName}, GenresMatch: dto.MatchAll},
+			expected: []int64{second},
+		},
+		{
+			name:     "tags any, exclude tag",
+			filters:  dto.GameFilters{Tags: []string{tags[0].TagName, tags[1].TagName}, ExcludeTags: []string{tags[2].TagName}},
+			expected: []int64{both, first},
+		},
+		{
+			name:     "only exclude genre",
+			filters:  dto.GameFilters{ExcludeGenres: []string{genres[0].GenreName}},
+			expected: []int64{second, untagged},
+		},
+		{
+			name: "tags all, genres any, exclude genre",
+			filters: dto.GameFilters{
+				Tags:          []string{tags[0].TagName},
+				TagsMatch:     dto.MatchAll,
+				Genres:        []string{genres[0].GenreName, genres[1].GenreName},
+				ExcludeGenres: []string{genres[1].GenreName},
+			},
+			expected: []int64{first},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.filters.Sort = dto.GameSortTitle
+			games, next, err := repo.GameList(ctx, tc.filters, 10, nil)
+			require.NoError(t, err)
+			assert.Nil(t, next)
+			assert.ElementsMatch(t, tc.expected, gameIDs(games))
+		})
+	}
+}
+
+func TestGameList_ReleaseDateFilters(t *testing.T) {
+	ctx := context.WithValue(context.Background(), interceptors.RequestIDKey, gofakeit.UUID())
+	repo := gamerepo.NewGameRepository(dbT.DB, mockslog.NewDiscardLogger())
+	dbT.SetUp(ctx, t, tables...)
+	defer dbT.TearDown(t)
+	insertGame := func(releaseDate time.Time) int64 {
+		gameToAdd := random.GameToAddService(nil, nil)
+		gameToAdd.ReleaseDate = releaseDate
+		return dbT.InsertGame(ctx, gameToAdd)
+	}
+	day := func(year int, month time.Month, d int) time.Time {
+		return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+	}
+	nineties := insertGame(day(1998, time.November, 19))
+	lastDayOf1999 := insertGame(day(1999, time.December, 31))
+	firstDayOf2000 := insertGame(day(2000, time.January, 1))
+	modern := insertGame(day(2015, time.May, 19))
+	upcoming := insertGame(time.Now().UTC().AddDate(1, 0, 0))
+
+	cases := []struct {
+		name     string
+		filters  dto.GameFilters
+		expected []int64
+	}{
+		{
+			name:     "year",
+			filters:  dto.GameFilters{ReleaseYear: 1999},
+			expected: []int64{lastDayOf1999},
+		},
+		{
+			name:     "decade",
+			filters:  dto.GameFilters{Decade: 1990},
+			expected: []int64{nineties, lastDayOf1999},
+		},
+		{
+			name:     "after, inclusive",
+			filters:  dto.GameFilters{ReleasedAfter: day(2000, time.January, 1)},
+			expected: []int64{firstDayOf2000, modern, upcoming},
+		},
+		{
+			name:     "before, inclusive",
+			filters:  dto.GameFilters{ReleasedBefore: day(1999, time.December, 31)},
+			expected: []int64{nineties, lastDayOf1999},
+		},
+		{
+			name:     "after and before",
+			filters:  dto.GameFilters{ReleasedAfter: day(1999, time.January, 1), ReleasedBefore: day(2015, time.May, 19)},
+			expected: []int64{lastDayOf1999, firstDayOf2000, modern},
+		},
+		{
+			name:     "upcoming only",
+			filters:  dto.GameFilters{UpcomingOnly: true},
+			expected: []int64{upcoming},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.filters.Sort = dto.GameSortNewest
+			games, _, err := repo.GameList(ctx, tc.filters, 10, nil)
+			require.NoError(t, err)
+			gameIDs := make([]int64, 0, len(games))
+			for _, g := range games {
+				gameIDs = append(gameIDs, g.GameID)
+			}
+			assert.ElementsMatch(t, tc.expected, gameIDs)
+		})
+	}
+}
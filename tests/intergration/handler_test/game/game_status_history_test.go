@@ -0,0 +1,67 @@
+//go:build integrations
+
+package game_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	game_api "github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/lib/caller"
+	"github.com/sariya23/game_service/internal/model"
+	"github.com/sariya23/game_service/tests/clientgrpc"
+	"github.com/sariya23/game_service/tests/utils/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetGameStatusHistory(t *testing.T) {
+	t.Run("История доступна модератору и после удаления игры", func(t *testing.T) {
+		ctx := context.Background()
+		client := clientgrpc.NewGameServiceTestClient()
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		genres, tags := dbT.GetGenres(ctx), dbT.GetTags(ctx)
+		gameToAdd := random.GameToAddRequest(model.GenreNames(genres), model.TagNames(tags))
+		respAddGame, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &game_api.AddGameRequest{Game: gameToAdd})
+		require.NoError(t, err)
+		actor, requestID := gofakeit.UUID(), gofakeit.UUID()
+		callCtx := metadata.AppendToOutgoingContext(clientgrpc.WithCaller(ctx, actor, ""), "request_id", requestID)
+		_, err = client.GetClient().SubmitForReview(callCtx, &game_api.SubmitForReviewRequest{GameId: respAddGame.GameId})
+		require.NoError(t, err)
+		_, err = client.GetClient().DeleteGame(clientgrpc.WithRole(ctx, caller.RoleModerator), &game_api.DeleteGameRequest{GameId: respAddGame.GameId})
+		require.NoError(t, err)
+		request := &game_api.GetGameStatusHistoryRequest{GameId: respAddGame.GameId}
+
+		_, err = client.GetClient().GetGameStatusHistory(clientgrpc.WithCaller(ctx, actor, ""), request)
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.PermissionDenied, st.Code())
+
+		resp, err := client.GetClient().GetGameStatusHistory(clientgrpc.WithRole(ctx, caller.RoleModerator), request)
+		require.NoError(t, err)
+		require.Len(t, resp.Changes, 1)
+		assert.Equal(t, game_api.GameStatusType_DRAFT, resp.Changes[0].OldStatus)
+		assert.Equal(t, game_api.GameStatusType_PENDING, resp.Changes[0].NewStatus)
+		assert.Equal(t, actor, resp.Changes[0].Actor)
+		assert.Equal(t, requestID, resp.Changes[0].RequestId)
+		assert.NotNil(t, resp.Changes[0].ChangedAt)
+	})
+	t.Run("Нет ни игры, ни истории", func(t *testing.T) {
+		ctx := context.Background()
+		client := clientgrpc.NewGameServiceTestClient()
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+
+		_, err := client.GetClient().GetGameStatusHistory(
+			clientgrpc.WithRole(ctx, caller.RoleModerator),
+			&game_api.GetGameStatusHistoryRequest{GameId: int64(gofakeit.Uint32()) + 1<<40},
+		)
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.NotFound, st.Code())
+	})
+}
@@ -0,0 +1,145 @@
+//go:build integrations
+
+package game_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	game_api "github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/interceptors"
+	"github.com/sariya23/game_service/internal/lib/caller"
+	"github.com/sariya23/game_service/internal/lib/mockslog"
+	"github.com/sariya23/game_service/internal/model"
+	"github.com/sariya23/game_service/internal/model/dto"
+	"github.com/sariya23/game_service/internal/outerror"
+	genreservice "github.com/sariya23/game_service/internal/service/genre"
+	"github.com/sariya23/game_service/internal/storage/postgresql/gamerepo"
+	"github.com/sariya23/game_service/internal/storage/postgresql/genrerepo"
+	"github.com/sariya23/game_service/tests/clientgrpc"
+	"github.com/sariya23/game_service/tests/utils/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGenreHierarchy(t *testing.T) {
+	ctx := context.WithValue(context.Background(), interceptors.RequestIDKey, gofakeit.UUID())
+	moderatorCtx := context.WithValue(ctx, interceptors.CallerRoleKey, caller.RoleModerator)
+	gameRepo := gamerepo.NewGameRepository(dbT.DB, mockslog.NewDiscardLogger())
+	genreRepo := genrerepo.NewGenreRepository(dbT.DB, mockslog.NewDiscardLogger())
+	service := genreservice.NewGenreService(mockslog.NewDiscardLogger(), genreRepo)
+	// rpg -> actionRPG -> soulslike, jrpg отдельно.
+	newTree := func(t *testing.T) (rpg, actionRPG, soulslike, jrpg model.Genre) {
+		t.Helper()
+		for _, genre := range []*model.Genre{&rpg, &actionRPG, &soulslike, &jrpg} {
+			created, err := service.CreateGenre(moderatorCtx, gofakeit.LetterN(20))
+			require.NoError(t, err)
+			*genre = created
+			t.Cleanup(func() { _ = service.DeleteGenre(moderatorCtx, created.GenreID, true) })
+		}
+		require.NoError(t, service.SetGenreParent(moderatorCtx, actionRPG.GenreID, rpg.GenreID))
+		require.NoError(t, service.SetGenreParent(moderatorCtx, soulslike.GenreID, actionRPG.GenreID))
+		return rpg, actionRPG, soulslike, jrpg
+	}
+	t.Run("Фильтр по жанру находит игры его поджанров", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		rpg, actionRPG, soulslike, jrpg := newTree(t)
+		soulslikeGame := random.GameToAddService(nil, nil)
+		soulslikeGame.GenreIDs = []int64{soulslike.GenreID}
+		soulslikeGameID, err := gameRepo.SaveGame(ctx, soulslikeGame)
+		require.NoError(t, err)
+		jrpgGame := random.GameToAddService(nil, nil)
+		jrpgGame.GenreIDs = []int64{jrpg.GenreID}
+		jrpgGameID, err := gameRepo.SaveGame(ctx, jrpgGame)
+		require.NoError(t, err)
+
+		games, _, err := gameRepo.GameList(ctx, dto.GameFilters{Genres: []string{rpg.GenreName}}, 10, nil)
+		require.NoError(t, err)
+		require.Len(t, games, 1)
+		assert.Equal(t, soulslikeGameID, games[0].GameID)
+
+		games, _, err = gameRepo.GameList(ctx, dto.GameFilters{Genres: []string{actionRPG.GenreName, jrpg.GenreName}, GenresMatch: dto.MatchAll}, 10, nil)
+		require.NoError(t, err)
+		assert.Empty(t, games)
+
+		games, _, err = gameRepo.GameList(ctx, dto.GameFilters{ExcludeGenres: []string{rpg.GenreName}, Genres: []string{jrpg.GenreName}}, 10, nil)
+		require.NoError(t, err)
+		require.Len(t, games, 1)
+		assert.Equal(t, jrpgGameID, games[0].GameID)
+	})
+	t.Run("Путь жанра идет от корня", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		rpg, actionRPG, soulslike, jrpg := newTree(t)
+
+		paths, err := genreRepo.GetGenrePaths(ctx, []int64{soulslike.GenreID, jrpg.GenreID})
+
+		require.NoError(t, err)
+		assert.Equal(t, model.GenrePath{rpg, actionRPG, soulslike}, paths[soulslike.GenreID])
+		assert.Equal(t, model.GenrePath{jrpg}, paths[jrpg.GenreID])
+	})
+	t.Run("Цикл в дереве жанров не создается", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		rpg, actionRPG, soulslike, _ := newTree(t)
+
+		require.ErrorIs(t, service.SetGenreParent(moderatorCtx, rpg.GenreID, soulslike.GenreID), outerror.ErrGenreCycle)
+		require.ErrorIs(t, service.MergeGenres(moderatorCtx, []int64{actionRPG.GenreID}, soulslike.GenreID), outerror.ErrGenreCycle)
+		require.NoError(t, service.SetGenreParent(moderatorCtx, actionRPG.GenreID, 0))
+		require.NoError(t, service.SetGenreParent(moderatorCtx, rpg.GenreID, soulslike.GenreID))
+	})
+}
+
+func TestGenreHierarchyRPC(t *testing.T) {
+	ctx := context.Background()
+	client := clientgrpc.NewGameServiceTestClient()
+	moderatorCtx := clientgrpc.WithRole(ctx, caller.RoleModerator)
+	t.Run("SetGenreParent строит дерево, а GetGame отдает путь жанра", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		parent, err := client.GetClient().CreateGenre(moderatorCtx, &game_api.CreateGenreRequest{Name: gofakeit.LetterN(20)})
+		require.NoError(t, err)
+		child, err := client.GetClient().CreateGenre(moderatorCtx, &game_api.CreateGenreRequest{Name: gofakeit.LetterN(20)})
+		require.NoError(t, err)
+		for _, genreID := range []int64{child.GetGenre().GetGenreId(), parent.GetGenre().GetGenreId()} {
+			defer func() {
+				_, _ = client.GetClient().DeleteGenre(moderatorCtx, &game_api.DeleteGenreRequest{GenreId: genreID, Force: true})
+			}()
+		}
+		request := &game_api.SetGenreParentRequest{GenreId: child.GetGenre().GetGenreId(), ParentId: parent.GetGenre().GetGenreId()}
+
+		_, err = client.GetClient().SetGenreParent(ctx, request)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = client.GetClient().SetGenreParent(moderatorCtx, request)
+		require.NoError(t, err)
+		_, err = client.GetClient().SetGenreParent(moderatorCtx, &game_api.SetGenreParentRequest{GenreId: request.GetParentId(), ParentId: request.GetGenreId()})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		_, err = client.GetClient().SetGenreParent(moderatorCtx, &game_api.SetGenreParentRequest{GenreId: request.GetGenreId(), ParentId: request.GetGenreId()})
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Equal(t, outerror.GenreCycleMessage, st.Message())
+
+		gameToAdd := random.GameToAddRequest(nil, model.TagNames(dbT.GetTags(ctx)))
+		gameToAdd.Genres = []string{child.GetGenre().GetName()}
+		added, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &game_api.AddGameRequest{Game: gameToAdd})
+		require.NoError(t, err)
+		response, err := client.GetClient().GetGame(moderatorCtx, &game_api.GetGameRequest{GameId: added.GetGameId()})
+		require.NoError(t, err)
+		require.Len(t, response.GetGame().GetGenrePaths(), 1)
+		path := response.GetGame().GetGenrePaths()[0].GetGenres()
+		require.Len(t, path, 2)
+		assert.Equal(t, parent.GetGenre().GetGenreId(), path[0].GetGenreId())
+		assert.Equal(t, child.GetGenre().GetName(), path[1].GetName())
+
+		_, err = client.GetClient().SetGenreParent(moderatorCtx, &game_api.SetGenreParentRequest{GenreId: request.GetGenreId()})
+		require.NoError(t, err)
+		response, err = client.GetClient().GetGame(moderatorCtx, &game_api.GetGameRequest{GameId: added.GetGameId()})
+		require.NoError(t, err)
+		require.Len(t, response.GetGame().GetGenrePaths(), 1)
+		assert.Len(t, response.GetGame().GetGenrePaths()[0].GetGenres(), 1)
+	})
+}
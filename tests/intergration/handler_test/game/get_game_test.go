@@ -4,16 +4,19 @@ package game_test
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/brianvoe/gofakeit/v7"
 	"github.com/minio/minio-go/v7"
 	game_api "github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/lib/caller"
 	"github.com/sariya23/game_service/internal/model"
+	minioclient "github.com/sariya23/game_service/internal/storage/s3/minio"
 	"github.com/sariya23/game_service/tests/clientgrpc"
-	"github.com/sariya23/game_service/tests/clientminio"
 	"github.com/sariya23/game_service/tests/utils/random"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -29,24 +32,32 @@ func TestGetGame(t *testing.T) {
 		defer dbT.TearDown(t)
 		genres, tags := dbT.GetGenres(ctx), dbT.GetTags(ctx)
 		gameToAdd := random.GameToAddRequest(model.GenreNames(genres), model.TagNames(tags))
-		responseSave, err := client.GetClient().AddGame(ctx, &game_api.AddGameRequest{Game: gameToAdd})
+		responseSave, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &game_api.AddGameRequest{Game: gameToAdd})
 		require.NoError(t, err)
 		require.NotZero(t, responseSave.GameId)
 		request := game_api.GetGameRequest{GameId: responseSave.GameId}
 
-		response, err := client.GetClient().GetGame(ctx, &request)
+		response, err := client.GetClient().GetGame(clientgrpc.WithRole(ctx, caller.RoleModerator), &request)
 
 		require.NoError(t, err)
 		assert.Equal(t, responseSave.GameId, response.GetGame().ID)
 		assert.Equal(t, gameToAdd.Title, response.GetGame().Title)
 		assert.Equal(t, gameToAdd.Description, response.GetGame().Description)
 		assert.Equal(t, gameToAdd.ReleaseDate.String(), response.GetGame().ReleaseDate.String())
-		reader, err := minioT.GetClient().GetObject(ctx, minioT.BucketName, clientminio.GameKey(gameToAdd.Title, int(gameToAdd.ReleaseDate.Year)), minio.GetObjectOptions{})
+		gameNoImageURL := dbT.GetGameById(ctx, responseSave.GameId)
+		assert.True(t, strings.HasPrefix(gameNoImageURL.ImageKey, fmt.Sprintf("%s%d/cover/", minioclient.GameKeyPrefix, responseSave.GameId)))
+		assert.True(t, strings.HasSuffix(gameNoImageURL.ImageKey, ".png"))
+		reader, err := minioT.GetClient().GetObject(ctx, minioT.BucketName, gameNoImageURL.ImageKey, minio.GetObjectOptions{})
 		require.NoError(t, err)
 		defer reader.Close()
 		imageData, err := io.ReadAll(reader)
 		require.NoError(t, err)
 		assert.Equal(t, gameToAdd.CoverImage, imageData)
+		variantURLs := response.GetGame().CoverImageVariantUrls
+		require.Len(t, variantURLs, len(minioclient.CoverVariantWidths))
+		for _, width := range minioclient.CoverVariantWidths {
+			assert.Contains(t, variantURLs[int32(width)], minioclient.CoverVariantKey(gameNoImageURL.ImageKey, width))
+		}
 
 		expectedGenres := dbT.GetGenresByNames(ctx, gameToAdd.Genres)
 		sort.Slice(expectedGenres, func(i, j int) bool {
@@ -68,6 +79,28 @@ func TestGetGame(t *testing.T) {
 		})
 		assert.Equal(t, model.TagNames(expectedTags), actualTags)
 	})
+	t.Run("Черновик не виден публичному запросу", func(t *testing.T) {
+		ctx := context.Background()
+		client := clientgrpc.NewGameServiceTestClient()
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		genres, tags := dbT.GetGenres(ctx), dbT.GetTags(ctx)
+		gameToAdd := random.GameToAddRequest(model.GenreNames(genres), model.TagNames(tags))
+		responseSave, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &game_api.AddGameRequest{Game: gameToAdd})
+		require.NoError(t, err)
+		request := game_api.GetGameRequest{GameId: responseSave.GameId}
+
+		response, err := client.GetClient().GetGame(ctx, &request)
+		require.Error(t, err)
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.NotFound, st.Code())
+		assert.Nil(t, response)
+
+		dbT.UpdateGameStatus(ctx, responseSave.GameId, game_api.GameStatusType_PUBLISH)
+		response, err = client.GetClient().GetGame(ctx, &request)
+		require.NoError(t, err)
+		assert.Equal(t, responseSave.GameId, response.GetGame().ID)
+	})
 	t.Run("Нет игры с таким ID", func(t *testing.T) {
 		ctx := context.Background()
 		client := clientgrpc.NewGameServiceTestClient()
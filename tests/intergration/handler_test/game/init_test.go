@@ -10,7 +10,7 @@ import (
 var (
 	dbT    *postgresql.TestDB
 	minioT *clientminio.MinioTestClient
-	tables = []string{"game", "game_genre", "game_tag"}
+	tables = []string{"game", "game_genre", "game_tag", "game_moderation", "game_status_history", "outbox", "webhook", "webhook_delivery", "webhook_delivery_attempt", "tag_alias", "genre_alias"}
 )
 
 func init() {
@@ -0,0 +1,56 @@
+//go:build integrations
+
+package game_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/sariya23/game_service/internal/interceptors"
+	"github.com/sariya23/game_service/internal/lib/mockslog"
+	"github.com/sariya23/game_service/internal/storage/postgresql/gamerepo"
+	minioclient "github.com/sariya23/game_service/internal/storage/s3/minio"
+	"github.com/sariya23/game_service/tests/utils/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLegacyImageKey проверяет выборку и замену старых ключей обложек для covermigrator.
+func TestLegacyImageKey(t *testing.T) {
+	ctx := context.WithValue(context.Background(), interceptors.RequestIDKey, gofakeit.UUID())
+	repo := gamerepo.NewGameRepository(dbT.DB, mockslog.NewDiscardLogger())
+	t.Run("Удаленные игры не попадают в миграцию", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		liveID := dbT.InsertGame(ctx, random.GameToAddService(nil, nil))
+		deletedID := dbT.InsertGame(ctx, random.GameToAddService(nil, nil))
+		dbT.SetGameDeletedAt(ctx, deletedID, time.Now())
+
+		games, err := repo.GetGamesWithLegacyImageKey(ctx, minioclient.GameKeyPrefix)
+
+		require.NoError(t, err)
+		require.Len(t, games, 1)
+		assert.Equal(t, liveID, games[0].GameID)
+	})
+	t.Run("Ключ меняется, только если он не изменился", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		game := random.GameToAddService(nil, nil)
+		gameID := dbT.InsertGame(ctx, game)
+		newKey := minioclient.GameCoverKey(gameID, "image/png")
+
+		swapped, err := repo.SwapGameImageKey(ctx, gameID, gofakeit.UUID(), newKey, minioclient.CoverVariantWidths)
+		require.NoError(t, err)
+		assert.False(t, swapped)
+		assert.Equal(t, game.ImageKey, dbT.GetGameById(ctx, gameID).ImageKey)
+
+		swapped, err = repo.SwapGameImageKey(ctx, gameID, game.ImageKey, newKey, minioclient.CoverVariantWidths)
+		require.NoError(t, err)
+		assert.True(t, swapped)
+		swappedGame := dbT.GetGameById(ctx, gameID)
+		assert.Equal(t, newKey, swappedGame.ImageKey)
+		assert.Equal(t, minioclient.CoverVariantWidths, swappedGame.ImageVariantWidths)
+	})
+}
@@ -0,0 +1,165 @@
+//go:build integrations
+
+package game_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	game_api "github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/interceptors"
+	"github.com/sariya23/game_service/internal/lib/caller"
+	"github.com/sariya23/game_service/internal/lib/mockslog"
+	"github.com/sariya23/game_service/internal/model"
+	"github.com/sariya23/game_service/internal/model/dto"
+	"github.com/sariya23/game_service/internal/outerror"
+	"github.com/sariya23/game_service/internal/storage/postgresql/gamerepo"
+	"github.com/sariya23/game_service/tests/clientgrpc"
+	"github.com/sariya23/game_service/tests/utils/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestModerateGame проверяет порядок очереди и сверку статуса в репозитории.
+func TestModerateGame(t *testing.T) {
+	ctx := context.WithValue(context.Background(), interceptors.RequestIDKey, gofakeit.UUID())
+	repo := gamerepo.NewGameRepository(dbT.DB, mockslog.NewDiscardLogger())
+	submit := func(gameID int64, actor string) dto.GameModeration {
+		return dto.GameModeration{
+			GameID:     gameID,
+			Decision:   model.ModerationSubmit,
+			FromStatus: game_api.GameStatusType_DRAFT,
+			ToStatus:   game_api.GameStatusType_PENDING,
+			Actor:      actor,
+		}
+	}
+	t.Run("Отправка на модерацию и очередь", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		firstID := dbT.InsertGame(ctx, random.GameToAddService(nil, nil))
+		secondID := dbT.InsertGame(ctx, random.GameToAddService(nil, nil))
+		dbT.UpdateGameStatus(ctx, firstID, game_api.GameStatusType_DRAFT)
+		dbT.UpdateGameStatus(ctx, secondID, game_api.GameStatusType_DRAFT)
+
+		require.NoError(t, repo.ModerateGame(ctx, submit(secondID, "author-2")))
+		require.NoError(t, repo.ModerateGame(ctx, submit(firstID, "author-1")))
+
+		pending, err := repo.ListPendingGames(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, pending, 2)
+		assert.Equal(t, secondID, pending[0].GameID)
+		assert.Equal(t, "author-2", pending[0].SubmittedBy)
+		assert.Equal(t, firstID, pending[1].GameID)
+		assert.Equal(t, game_api.GameStatusType_PENDING, dbT.GetGameById(ctx, firstID).GameStatus)
+	})
+	t.Run("Отказ возвращает игру в черновики", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		gameID := dbT.InsertGame(ctx, random.GameToAddService(nil, nil))
+		dbT.UpdateGameStatus(ctx, gameID, game_api.GameStatusType_PENDING)
+
+		err := repo.ModerateGame(ctx, dto.GameModeration{
+			GameID:     gameID,
+			Decision:   model.ModerationReject,
+			FromStatus: game_api.GameStatusType_PENDING,
+			ToStatus:   game_api.GameStatusType_DRAFT,
+			Reason:     "Нет описания",
+			Actor:      "moderator-1",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, game_api.GameStatusType_DRAFT, dbT.GetGameById(ctx, gameID).GameStatus)
+		pending, err := repo.ListPendingGames(ctx, 10)
+		require.NoError(t, err)
+		assert.Empty(t, pending)
+	})
+	t.Run("Игра не в ожидаемом статусе", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		gameID := dbT.InsertGame(ctx, random.GameToAddService(nil, nil))
+
+		err := repo.ModerateGame(ctx, submit(gameID, "author-1"))
+
+		assert.ErrorIs(t, err, outerror.ErrInvalidNewGameStatus)
+		assert.Equal(t, game_api.GameStatusType_PUBLISH, dbT.GetGameById(ctx, gameID).GameStatus)
+	})
+	t.Run("Нет игры", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+
+		err := repo.ModerateGame(ctx, submit(gofakeit.Int64(), "author-1"))
+
+		assert.ErrorIs(t, err, outerror.ErrGameNotFound)
+	})
+}
+
+func TestModerationRPC(t *testing.T) {
+	t.Run("Отправка, очередь и одобрение", func(t *testing.T) {
+		ctx := context.Background()
+		client := clientgrpc.NewGameServiceTestClient()
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		genres, tags := dbT.GetGenres(ctx), dbT.GetTags(ctx)
+		gameToAdd := random.GameToAddRequest(model.GenreNames(genres), model.TagNames(tags))
+		respAddGame, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &game_api.AddGameRequest{Game: gameToAdd})
+		require.NoError(t, err)
+		author := gofakeit.UUID()
+		moderatorCtx := clientgrpc.WithCaller(ctx, gofakeit.UUID(), caller.RoleModerator)
+
+		_, err = client.GetClient().SubmitForReview(clientgrpc.WithCaller(ctx, author, ""), &game_api.SubmitForReviewRequest{GameId: respAddGame.GameId})
+		require.NoError(t, err)
+
+		_, err = client.GetClient().ListPendingGames(clientgrpc.WithCaller(ctx, author, ""), &game_api.ListPendingGamesRequest{})
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.PermissionDenied, st.Code())
+		pending, err := client.GetClient().ListPendingGames(moderatorCtx, &game_api.ListPendingGamesRequest{})
+		require.NoError(t, err)
+		require.Len(t, pending.Games, 1)
+		assert.Equal(t, respAddGame.GameId, pending.Games[0].GameId)
+		assert.Equal(t, gameToAdd.Title, pending.Games[0].Title)
+		assert.Equal(t, author, pending.Games[0].SubmittedBy)
+		assert.NotNil(t, pending.Games[0].SubmittedAt)
+
+		_, err = client.GetClient().ApproveGame(clientgrpc.WithCaller(ctx, author, ""), &game_api.ApproveGameRequest{GameId: respAddGame.GameId})
+		st, _ = status.FromError(err)
+		assert.Equal(t, codes.PermissionDenied, st.Code())
+		_, err = client.GetClient().ApproveGame(moderatorCtx, &game_api.ApproveGameRequest{GameId: respAddGame.GameId})
+		require.NoError(t, err)
+		assert.Equal(t, game_api.GameStatusType_PUBLISH, dbT.GetGameById(ctx, respAddGame.GameId).GameStatus)
+	})
+	t.Run("Отказ требует причину и возвращает игру в черновики", func(t *testing.T) {
+		ctx := context.Background()
+		client := clientgrpc.NewGameServiceTestClient()
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		gameID := dbT.InsertGame(ctx, random.GameToAddService(nil, nil))
+		dbT.UpdateGameStatus(ctx, gameID, game_api.GameStatusType_PENDING)
+		moderatorCtx := clientgrpc.WithCaller(ctx, gofakeit.UUID(), caller.RoleModerator)
+
+		_, err := client.GetClient().RejectGame(moderatorCtx, &game_api.RejectGameRequest{GameId: gameID, Reason: "  "})
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Equal(t, outerror.RejectReasonRequiredMessage, st.Message())
+
+		_, err = client.GetClient().RejectGame(moderatorCtx, &game_api.RejectGameRequest{GameId: gameID, Reason: "Нет описания"})
+		require.NoError(t, err)
+		assert.Equal(t, game_api.GameStatusType_DRAFT, dbT.GetGameById(ctx, gameID).GameStatus)
+	})
+	t.Run("Анонимный вызывающий не может отправить игру", func(t *testing.T) {
+		ctx := context.Background()
+		client := clientgrpc.NewGameServiceTestClient()
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		gameID := dbT.InsertGame(ctx, random.GameToAddService(nil, nil))
+		dbT.UpdateGameStatus(ctx, gameID, game_api.GameStatusType_DRAFT)
+
+		_, err := client.GetClient().SubmitForReview(ctx, &game_api.SubmitForReviewRequest{GameId: gameID})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.Unauthenticated, st.Code())
+		assert.Equal(t, game_api.GameStatusType_DRAFT, dbT.GetGameById(ctx, gameID).GameStatus)
+	})
+}
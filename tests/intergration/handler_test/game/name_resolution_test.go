@@ -0,0 +1,110 @@
+//go:build integrations
+
+package game_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/sariya23/game_service/internal/interceptors"
+	"github.com/sariya23/game_service/internal/lib/caller"
+	"github.com/sariya23/game_service/internal/lib/mockslog"
+	"github.com/sariya23/game_service/internal/model"
+	"github.com/sariya23/game_service/internal/model/dto"
+	"github.com/sariya23/game_service/internal/outerror"
+	genreservice "github.com/sariya23/game_service/internal/service/genre"
+	tagservice "github.com/sariya23/game_service/internal/service/tag"
+	"github.com/sariya23/game_service/internal/storage/postgresql/gamerepo"
+	"github.com/sariya23/game_service/internal/storage/postgresql/genrerepo"
+	"github.com/sariya23/game_service/internal/storage/postgresql/tagrepo"
+	"github.com/sariya23/game_service/tests/utils/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNameResolution(t *testing.T) {
+	ctx := context.WithValue(context.Background(), interceptors.RequestIDKey, gofakeit.UUID())
+	moderatorCtx := context.WithValue(ctx, interceptors.CallerRoleKey, caller.RoleModerator)
+	gameRepo := gamerepo.NewGameRepository(dbT.DB, mockslog.NewDiscardLogger())
+	tagRepo := tagrepo.NewTagRepository(dbT.DB, mockslog.NewDiscardLogger())
+	genreRepo := genrerepo.NewGenreRepository(dbT.DB, mockslog.NewDiscardLogger())
+	tagService := tagservice.NewTagService(mockslog.NewDiscardLogger(), tagRepo)
+	genreService := genreservice.NewGenreService(mockslog.NewDiscardLogger(), genreRepo)
+	t.Run("Тэг находится без учета регистра и лишних пробелов, повторы схлопываются", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		created, err := tagService.CreateTag(moderatorCtx, "Open "+gofakeit.LetterN(20))
+		require.NoError(t, err)
+		defer func() { _ = tagService.DeleteTag(moderatorCtx, created.TagID, true) }()
+		lower := strings.ToLower(created.TagName)
+		spaced := "  " + strings.ReplaceAll(strings.ToUpper(created.TagName), " ", "   ") + " "
+
+		resolved, err := tagRepo.GetTagByNames(ctx, []string{lower, spaced, created.TagName})
+
+		require.NoError(t, err)
+		assert.Equal(t, []model.Tag{created}, resolved)
+	})
+	t.Run("Тэг находится по алиасу в другом регистре", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		source, err := tagService.CreateTag(moderatorCtx, gofakeit.LetterN(20))
+		require.NoError(t, err)
+		target, err := tagService.CreateTag(moderatorCtx, gofakeit.LetterN(20))
+		require.NoError(t, err)
+		defer func() { _ = tagService.DeleteTag(moderatorCtx, target.TagID, true) }()
+		require.NoError(t, tagService.MergeTags(moderatorCtx, []int64{source.TagID}, target.TagID))
+
+		resolved, err := tagRepo.GetTagByNames(ctx, []string{strings.ToUpper(source.TagName), target.TagName})
+
+		require.NoError(t, err)
+		assert.Equal(t, []model.Tag{target}, resolved)
+	})
+	t.Run("Ошибка перечисляет неизвестные имена без повторов", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		existing := dbT.GetTags(ctx)[0]
+		unknown := gofakeit.LetterN(30)
+
+		_, err := tagRepo.GetTagByNames(ctx, []string{existing.TagName, unknown, strings.ToUpper(unknown)})
+
+		require.ErrorIs(t, err, outerror.ErrTagNotFound)
+		assert.Equal(t, []string{unknown}, outerror.UnknownNames(err))
+
+		_, err = genreRepo.GetGenreByNames(ctx, []string{unknown})
+
+		require.ErrorIs(t, err, outerror.ErrGenreNotFound)
+		assert.Equal(t, []string{unknown}, outerror.UnknownNames(err))
+	})
+	t.Run("Имена, отличающиеся регистром, считаются дублями", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		existingTag, existingGenre := dbT.GetTags(ctx)[0], dbT.GetGenres(ctx)[0]
+
+		_, err := tagService.CreateTag(moderatorCtx, strings.ToUpper(existingTag.TagName))
+		require.ErrorIs(t, err, outerror.ErrTagAlreadyExist)
+		_, err = genreService.CreateGenre(moderatorCtx, strings.ToLower(existingGenre.GenreName)+" ")
+		require.ErrorIs(t, err, outerror.ErrGenreAlreadyExist)
+	})
+	t.Run("Фильтр списка игр сравнивает имена без учета регистра", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		created, err := genreService.CreateGenre(moderatorCtx, gofakeit.LetterN(20))
+		require.NoError(t, err)
+		defer func() { _ = genreService.DeleteGenre(moderatorCtx, created.GenreID, true) }()
+		game := random.GameToAddService(nil, nil)
+		game.GenreIDs = []int64{created.GenreID}
+		gameID, err := gameRepo.SaveGame(ctx, game)
+		require.NoError(t, err)
+
+		games, _, err := gameRepo.GameList(ctx, dto.GameFilters{
+			Genres:      []string{strings.ToUpper(created.GenreName), strings.ToLower(created.GenreName)},
+			GenresMatch: dto.MatchAll,
+		}, 10, nil)
+
+		require.NoError(t, err)
+		require.Len(t, games, 1)
+		assert.Equal(t, gameID, games[0].GameID)
+	})
+}
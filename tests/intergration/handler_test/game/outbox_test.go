@@ -0,0 +1,115 @@
+//go:build integrations
+
+package game_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	game_api "github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/events"
+	"github.com/sariya23/game_service/internal/interceptors"
+	"github.com/sariya23/game_service/internal/lib/mockslog"
+	"github.com/sariya23/game_service/internal/model"
+	"github.com/sariya23/game_service/internal/model/dto"
+	"github.com/sariya23/game_service/internal/storage/postgresql/gamerepo"
+	outboxrepo "github.com/sariya23/game_service/internal/storage/postgresql/outbox_repo"
+	"github.com/sariya23/game_service/tests/utils/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutboxRelay(t *testing.T) {
+	ctx := context.WithValue(context.Background(), interceptors.RequestIDKey, gofakeit.UUID())
+	gameRepo := gamerepo.NewGameRepository(dbT.DB, mockslog.NewDiscardLogger())
+	outboxRepo := outboxrepo.NewOutboxRepository(dbT.DB, mockslog.NewDiscardLogger())
+	noDelay := func(int) time.Duration { return 0 }
+	const maxAttempts = 3
+	t.Run("События одной игры доставляются по порядку", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		gameID, err := gameRepo.SaveGame(ctx, random.GameToAddService(nil, nil))
+		require.NoError(t, err)
+		require.NoError(t, gameRepo.UpdateGameStatus(ctx, dto.GameStatusChange{GameID: gameID, NewStatus: game_api.GameStatusType_PENDING}))
+		_, err = gameRepo.DaleteGame(ctx, gameID)
+		require.NoError(t, err)
+
+		var delivered []model.OutboxEvent
+		record := func(event model.OutboxEvent) error {
+			delivered = append(delivered, event)
+			return nil
+		}
+		for range 3 {
+			published, failed, dead, err := outboxRepo.RelayBatch(ctx, 10, maxAttempts, record, noDelay)
+			require.NoError(t, err)
+			assert.Equal(t, 1, published)
+			assert.Zero(t, failed)
+			assert.Empty(t, dead)
+		}
+		published, _, _, err := outboxRepo.RelayBatch(ctx, 10, maxAttempts, record, noDelay)
+		require.NoError(t, err)
+		assert.Zero(t, published)
+
+		require.Len(t, delivered, 3)
+		assert.Equal(t, events.GameCreated, delivered[0].EventType)
+		assert.Equal(t, events.GameStatusChanged, delivered[1].EventType)
+		assert.Equal(t, events.GameDeleted, delivered[2].EventType)
+		for _, event := range delivered {
+			assert.Equal(t, gameID, event.GameID)
+		}
+	})
+	t.Run("Неудачная доставка откладывается", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		_, err := gameRepo.SaveGame(ctx, random.GameToAddService(nil, nil))
+		require.NoError(t, err)
+		fail := func(model.OutboxEvent) error { return errors.New("broker is down") }
+
+		published, failed, _, err := outboxRepo.RelayBatch(ctx, 10, maxAttempts, fail, func(int) time.Duration { return time.Hour })
+		require.NoError(t, err)
+		assert.Zero(t, published)
+		assert.Equal(t, 1, failed)
+
+		published, failed, _, err = outboxRepo.RelayBatch(ctx, 10, maxAttempts, func(model.OutboxEvent) error { return nil }, noDelay)
+		require.NoError(t, err)
+		assert.Zero(t, published)
+		assert.Zero(t, failed)
+	})
+	t.Run("Событие после maxAttempts попыток не держит очередь игры", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		gameID, err := gameRepo.SaveGame(ctx, random.GameToAddService(nil, nil))
+		require.NoError(t, err)
+		require.NoError(t, gameRepo.UpdateGameStatus(ctx, dto.GameStatusChange{GameID: gameID, NewStatus: game_api.GameStatusType_PENDING}))
+		failCreated := func(event model.OutboxEvent) error {
+			if event.EventType == events.GameCreated {
+				return errors.New("poison event")
+			}
+			return nil
+		}
+
+		for range maxAttempts - 1 {
+			published, failed, dead, err := outboxRepo.RelayBatch(ctx, 10, maxAttempts, failCreated, noDelay)
+			require.NoError(t, err)
+			assert.Zero(t, published)
+			assert.Equal(t, 1, failed)
+			assert.Empty(t, dead)
+		}
+		published, failed, dead, err := outboxRepo.RelayBatch(ctx, 10, maxAttempts, failCreated, noDelay)
+		require.NoError(t, err)
+		assert.Zero(t, published)
+		assert.Zero(t, failed)
+		require.Len(t, dead, 1)
+		assert.Equal(t, events.GameCreated, dead[0].EventType)
+		assert.Equal(t, maxAttempts, dead[0].Attempts)
+
+		published, failed, dead, err = outboxRepo.RelayBatch(ctx, 10, maxAttempts, failCreated, noDelay)
+		require.NoError(t, err)
+		assert.Equal(t, 1, published)
+		assert.Zero(t, failed)
+		assert.Empty(t, dead)
+	})
+}
@@ -0,0 +1,163 @@
+//go:build integrations
+
+package game_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	game_api "github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/interceptors"
+	"github.com/sariya23/game_service/internal/lib/caller"
+	"github.com/sariya23/game_service/internal/lib/mockslog"
+	"github.com/sariya23/game_service/internal/model"
+	"github.com/sariya23/game_service/internal/model/dto"
+	"github.com/sariya23/game_service/internal/outerror"
+	"github.com/sariya23/game_service/internal/storage/postgresql/gamerepo"
+	"github.com/sariya23/game_service/tests/clientgrpc"
+	"github.com/sariya23/game_service/tests/utils/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TestPublishDueGames проверяет выборку и публикацию по расписанию в репозитории.
+func TestPublishDueGames(t *testing.T) {
+	ctx := context.WithValue(context.Background(), interceptors.RequestIDKey, gofakeit.UUID())
+	repo := gamerepo.NewGameRepository(dbT.DB, mockslog.NewDiscardLogger())
+	allow := func(model.GameNoImageURL) error { return nil }
+	now := time.Now()
+	t.Run("Публикуются только игры с наступившим publish_at", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		dueID := dbT.InsertGame(ctx, random.GameToAddService(nil, nil))
+		futureID := dbT.InsertGame(ctx, random.GameToAddService(nil, nil))
+		dbT.UpdateGameStatus(ctx, dueID, game_api.GameStatusType_PENDING)
+		dbT.UpdateGameStatus(ctx, futureID, game_api.GameStatusType_PENDING)
+		dueAt, futureAt := now.Add(-time.Minute), now.Add(time.Hour)
+		require.NoError(t, repo.SchedulePublish(ctx, dueID, &dueAt))
+		require.NoError(t, repo.SchedulePublish(ctx, futureID, &futureAt))
+
+		published, err := repo.PublishDueGames(ctx, now, 10, allow, "scheduler", "req-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, []int64{dueID}, published)
+		assert.Equal(t, game_api.GameStatusType_PUBLISH, dbT.GetGameById(ctx, dueID).GameStatus)
+		assert.Equal(t, game_api.GameStatusType_PENDING, dbT.GetGameById(ctx, futureID).GameStatus)
+		history := dbT.GetGameStatusHistory(ctx, dueID)
+		require.NotEmpty(t, history)
+		assert.Equal(t, "scheduler", history[len(history)-1].Actor)
+		moderations := dbT.GetGameModerations(ctx, dueID)
+		require.Len(t, moderations, 1)
+		assert.Equal(t, model.ModerationApprove, moderations[0].Decision)
+		assert.Equal(t, "scheduler", moderations[0].Actor)
+		assert.Empty(t, dbT.GetGameModerations(ctx, futureID))
+
+		published, err = repo.PublishDueGames(ctx, now, 10, allow, "scheduler", "req-2")
+		require.NoError(t, err)
+		assert.Empty(t, published)
+	})
+	t.Run("Игра, не прошедшая проверку, снимается с расписания", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		gameID := dbT.InsertGame(ctx, random.GameToAddService(nil, nil))
+		dbT.UpdateGameStatus(ctx, gameID, game_api.GameStatusType_PENDING)
+		dueAt := now.Add(-time.Minute)
+		require.NoError(t, repo.SchedulePublish(ctx, gameID, &dueAt))
+
+		published, err := repo.PublishDueGames(ctx, now, 10, func(model.GameNoImageURL) error {
+			return outerror.ErrGameCoverRequired
+		}, "scheduler", "req-1")
+		require.NoError(t, err)
+		assert.Empty(t, published)
+
+		published, err = repo.PublishDueGames(ctx, now, 10, allow, "scheduler", "req-2")
+		require.NoError(t, err)
+		assert.Empty(t, published)
+		assert.Equal(t, game_api.GameStatusType_PENDING, dbT.GetGameById(ctx, gameID).GameStatus)
+	})
+	t.Run("Смена статуса сбрасывает расписание", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		gameID := dbT.InsertGame(ctx, random.GameToAddService(nil, nil))
+		dbT.UpdateGameStatus(ctx, gameID, game_api.GameStatusType_PENDING)
+		dueAt := now.Add(-time.Minute)
+		require.NoError(t, repo.SchedulePublish(ctx, gameID, &dueAt))
+		pending := game_api.GameStatusType_PENDING
+		require.NoError(t, repo.UpdateGameStatus(ctx, dto.GameStatusChange{
+			GameID:         gameID,
+			ExpectedStatus: &pending,
+			NewStatus:      game_api.GameStatusType_DRAFT,
+		}))
+		require.NoError(t, repo.UpdateGameStatus(ctx, dto.GameStatusChange{
+			GameID:    gameID,
+			NewStatus: game_api.GameStatusType_PENDING,
+		}))
+
+		published, err := repo.PublishDueGames(ctx, now, 10, allow, "scheduler", "req-1")
+
+		require.NoError(t, err)
+		assert.Empty(t, published)
+	})
+	t.Run("Запланировать можно только игру в PENDING", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		gameID := dbT.InsertGame(ctx, random.GameToAddService(nil, nil))
+		dbT.UpdateGameStatus(ctx, gameID, game_api.GameStatusType_DRAFT)
+		publishAt := now.Add(time.Hour)
+
+		err := repo.SchedulePublish(ctx, gameID, &publishAt)
+
+		assert.True(t, errors.Is(err, outerror.ErrGameNotPending))
+	})
+}
+
+func TestSchedulePublish(t *testing.T) {
+	t.Run("Модератор планирует и снимает публикацию", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), interceptors.RequestIDKey, gofakeit.UUID())
+		client := clientgrpc.NewGameServiceTestClient()
+		repo := gamerepo.NewGameRepository(dbT.DB, mockslog.NewDiscardLogger())
+		allow := func(model.GameNoImageURL) error { return nil }
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		genres, tags := dbT.GetGenres(ctx), dbT.GetTags(ctx)
+		gameToAdd := random.GameToAddRequest(model.GenreNames(genres), model.TagNames(tags))
+		respAddGame, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &game_api.AddGameRequest{Game: gameToAdd})
+		require.NoError(t, err)
+		dbT.UpdateGameStatus(ctx, respAddGame.GameId, game_api.GameStatusType_PENDING)
+		moderatorCtx := clientgrpc.WithRole(ctx, caller.RoleModerator)
+		publishAt := time.Now().Add(time.Hour)
+		request := &game_api.SchedulePublishRequest{GameId: respAddGame.GameId, PublishAt: timestamppb.New(publishAt)}
+
+		_, err = client.GetClient().SchedulePublish(clientgrpc.WithRole(ctx, "user"), request)
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.PermissionDenied, st.Code())
+
+		_, err = client.GetClient().SchedulePublish(moderatorCtx, &game_api.SchedulePublishRequest{
+			GameId:    respAddGame.GameId,
+			PublishAt: timestamppb.New(time.Now().Add(-time.Hour)),
+		})
+		st, _ = status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Equal(t, outerror.PublishAtInPastMessage, st.Message())
+
+		_, err = client.GetClient().SchedulePublish(moderatorCtx, request)
+		require.NoError(t, err)
+		_, err = client.GetClient().SchedulePublish(moderatorCtx, &game_api.SchedulePublishRequest{GameId: respAddGame.GameId})
+		require.NoError(t, err)
+		published, err := repo.PublishDueGames(ctx, publishAt.Add(time.Minute), 10, allow, "scheduler", "req-1")
+		require.NoError(t, err)
+		assert.Empty(t, published)
+
+		_, err = client.GetClient().SchedulePublish(moderatorCtx, request)
+		require.NoError(t, err)
+		published, err = repo.PublishDueGames(ctx, publishAt.Add(time.Minute), 10, allow, "scheduler", "req-2")
+		require.NoError(t, err)
+		assert.Equal(t, []int64{respAddGame.GameId}, published)
+	})
+}
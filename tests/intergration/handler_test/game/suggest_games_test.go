@@ -0,0 +1,70 @@
+//go:build integrations
+
+package game_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	game_api "github.com/sariya23/api_game_service/gen/game"
+	gameservice "github.com/sariya23/game_service/internal/service/game"
+	"github.com/sariya23/game_service/tests/clientgrpc"
+	"github.com/sariya23/game_service/tests/utils/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSuggestGames(t *testing.T) {
+	t.Run("Подсказка находит опубликованную игру с опечаткой", func(t *testing.T) {
+		ctx := context.Background()
+		client := clientgrpc.NewGameServiceTestClient()
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		published := random.GameToAddRequest(nil, nil)
+		published.Title = "Cyberpunk " + gofakeit.LetterN(10)
+		responsePublished, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &game_api.AddGameRequest{Game: published})
+		require.NoError(t, err)
+		dbT.UpdateGameStatus(ctx, responsePublished.GameId, game_api.GameStatusType_PUBLISH)
+		draft := random.GameToAddRequest(nil, nil)
+		draft.Title = "Cyberpunk " + gofakeit.LetterN(10)
+		_, err = client.GetClient().AddGame(clientgrpc.WithUser(ctx), &game_api.AddGameRequest{Game: draft})
+		require.NoError(t, err)
+
+		response, err := client.GetClient().SuggestGames(ctx, &game_api.SuggestGamesRequest{Prefix: "cyberpnk"})
+
+		require.NoError(t, err)
+		require.Len(t, response.Suggestions, 1)
+		assert.Equal(t, responsePublished.GameId, response.Suggestions[0].GameId)
+		assert.Equal(t, published.Title, response.Suggestions[0].Title)
+	})
+	t.Run("Лимит подсказок ограничен сервером", func(t *testing.T) {
+		ctx := context.Background()
+		client := clientgrpc.NewGameServiceTestClient()
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		for range gameservice.MaxSuggestLimit + 2 {
+			gameToAdd := random.GameToAddRequest(nil, nil)
+			gameToAdd.Title = "Stalker " + gofakeit.LetterN(10)
+			responseAdd, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &game_api.AddGameRequest{Game: gameToAdd})
+			require.NoError(t, err)
+			dbT.UpdateGameStatus(ctx, responseAdd.GameId, game_api.GameStatusType_PUBLISH)
+		}
+
+		response, err := client.GetClient().SuggestGames(ctx, &game_api.SuggestGamesRequest{Prefix: "stalker", Limit: 1000})
+
+		require.NoError(t, err)
+		assert.Len(t, response.Suggestions, gameservice.MaxSuggestLimit)
+	})
+	t.Run("Слишком короткий префикс", func(t *testing.T) {
+		ctx := context.Background()
+		client := clientgrpc.NewGameServiceTestClient()
+
+		_, err := client.GetClient().SuggestGames(ctx, &game_api.SuggestGamesRequest{Prefix: "a"})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+}
@@ -0,0 +1,339 @@
+//go:build integrations
+
+package game_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	game_api "github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/interceptors"
+	"github.com/sariya23/game_service/internal/lib/caller"
+	"github.com/sariya23/game_service/internal/lib/mockslog"
+	"github.com/sariya23/game_service/internal/model"
+	"github.com/sariya23/game_service/internal/outerror"
+	genreservice "github.com/sariya23/game_service/internal/service/genre"
+	tagservice "github.com/sariya23/game_service/internal/service/tag"
+	"github.com/sariya23/game_service/internal/storage/postgresql/gamerepo"
+	"github.com/sariya23/game_service/internal/storage/postgresql/genrerepo"
+	"github.com/sariya23/game_service/internal/storage/postgresql/tagrepo"
+	"github.com/sariya23/game_service/tests/clientgrpc"
+	"github.com/sariya23/game_service/tests/utils/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTagManagement(t *testing.T) {
+	ctx := context.WithValue(context.Background(), interceptors.RequestIDKey, gofakeit.UUID())
+	moderatorCtx := context.WithValue(ctx, interceptors.CallerRoleKey, caller.RoleModerator)
+	gameRepo := gamerepo.NewGameRepository(dbT.DB, mockslog.NewDiscardLogger())
+	tagRepo := tagrepo.NewTagRepository(dbT.DB, mockslog.NewDiscardLogger())
+	service := tagservice.NewTagService(mockslog.NewDiscardLogger(), tagRepo)
+	t.Run("Тэг создается, переименовывается и попадает в список", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		_, err := service.CreateTag(ctx, gofakeit.LetterN(20))
+		require.ErrorIs(t, err, outerror.ErrModerationForbidden)
+
+		created, err := service.CreateTag(moderatorCtx, " "+gofakeit.LetterN(20)+" ")
+		require.NoError(t, err)
+		defer func() { _ = service.DeleteTag(moderatorCtx, created.TagID, true) }()
+		newName := gofakeit.LetterN(20)
+		require.NoError(t, service.RenameTag(moderatorCtx, created.TagID, newName))
+
+		tags, err := service.ListTags(ctx)
+		require.NoError(t, err)
+		assert.Contains(t, tags, model.Tag{TagID: created.TagID, TagName: newName})
+	})
+	t.Run("Имя тэга уникально", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		existing := dbT.GetTags(ctx)[0]
+
+		_, err := service.CreateTag(moderatorCtx, existing.TagName)
+		require.ErrorIs(t, err, outerror.ErrTagAlreadyExist)
+
+		created, err := service.CreateTag(moderatorCtx, gofakeit.LetterN(20))
+		require.NoError(t, err)
+		defer func() { _ = service.DeleteTag(moderatorCtx, created.TagID, true) }()
+		require.ErrorIs(t, service.RenameTag(moderatorCtx, created.TagID, existing.TagName), outerror.ErrTagAlreadyExist)
+		require.ErrorIs(t, service.RenameTag(moderatorCtx, gofakeit.Int64(), gofakeit.LetterN(20)), outerror.ErrTagNotFound)
+	})
+	t.Run("Тэг, привязанный к игре, удаляется только с force", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		created, err := service.CreateTag(moderatorCtx, gofakeit.LetterN(20))
+		require.NoError(t, err)
+		game := random.GameToAddService(nil, nil)
+		game.TagIDs = []int64{created.TagID}
+		gameID, err := gameRepo.SaveGame(ctx, game)
+		require.NoError(t, err)
+
+		require.ErrorIs(t, service.DeleteTag(moderatorCtx, created.TagID, false), outerror.ErrTagInUse)
+		require.Len(t, dbT.GetGameTagByGameID(ctx, gameID), 1)
+
+		require.NoError(t, service.DeleteTag(moderatorCtx, created.TagID, true))
+		assert.Empty(t, dbT.GetGameTagByGameID(ctx, gameID))
+		require.ErrorIs(t, service.DeleteTag(moderatorCtx, created.TagID, true), outerror.ErrTagNotFound)
+	})
+	t.Run("Слияние тэгов переносит игры без дублей и оставляет старое имя алиасом", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		source, err := service.CreateTag(moderatorCtx, gofakeit.LetterN(20))
+		require.NoError(t, err)
+		target, err := service.CreateTag(moderatorCtx, gofakeit.LetterN(20))
+		require.NoError(t, err)
+		defer func() { _ = service.DeleteTag(moderatorCtx, target.TagID, true) }()
+		onlySource := random.GameToAddService(nil, nil)
+		onlySource.TagIDs = []int64{source.TagID}
+		onlySourceID, err := gameRepo.SaveGame(ctx, onlySource)
+		require.NoError(t, err)
+		both := random.GameToAddService(nil, nil)
+		both.TagIDs = []int64{source.TagID, target.TagID}
+		bothID, err := gameRepo.SaveGame(ctx, both)
+		require.NoError(t, err)
+
+		require.NoError(t, service.MergeTags(moderatorCtx, []int64{source.TagID, source.TagID}, target.TagID))
+
+		for _, gameID := range []int64{onlySourceID, bothID} {
+			links := dbT.GetGameTagByGameID(ctx, gameID)
+			require.Len(t, links, 1)
+			assert.Equal(t, target.TagID, links[0].TagID)
+		}
+		resolved, err := tagRepo.GetTagByNames(ctx, []string{source.TagName, target.TagName})
+		require.NoError(t, err)
+		assert.Equal(t, []model.Tag{target}, resolved)
+		require.ErrorIs(t, service.MergeTags(moderatorCtx, []int64{source.TagID}, target.TagID), outerror.ErrTagNotFound)
+	})
+	t.Run("Имя алиаса нельзя занять новым или переименованным тэгом", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		source, err := service.CreateTag(moderatorCtx, gofakeit.LetterN(20))
+		require.NoError(t, err)
+		target, err := service.CreateTag(moderatorCtx, gofakeit.LetterN(20))
+		require.NoError(t, err)
+		defer func() { _ = service.DeleteTag(moderatorCtx, target.TagID, true) }()
+		other, err := service.CreateTag(moderatorCtx, gofakeit.LetterN(20))
+		require.NoError(t, err)
+		defer func() { _ = service.DeleteTag(moderatorCtx, other.TagID, true) }()
+		require.NoError(t, service.MergeTags(moderatorCtx, []int64{source.TagID}, target.TagID))
+
+		_, err = service.CreateTag(moderatorCtx, " "+source.TagName+" ")
+		require.ErrorIs(t, err, outerror.ErrTagAlreadyExist)
+		require.ErrorIs(t, service.RenameTag(moderatorCtx, other.TagID, source.TagName), outerror.ErrTagAlreadyExist)
+		require.NoError(t, service.RenameTag(moderatorCtx, target.TagID, source.TagName))
+	})
+	t.Run("Слияние тэга в самого себя - ErrMergeIntoItself", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		tag := dbT.GetTags(ctx)[0]
+
+		require.ErrorIs(t, service.MergeTags(moderatorCtx, []int64{tag.TagID}, tag.TagID), outerror.ErrMergeIntoItself)
+	})
+}
+
+func TestGenreManagement(t *testing.T) {
+	ctx := context.WithValue(context.Background(), interceptors.RequestIDKey, gofakeit.UUID())
+	moderatorCtx := context.WithValue(ctx, interceptors.CallerRoleKey, caller.RoleModerator)
+	gameRepo := gamerepo.NewGameRepository(dbT.DB, mockslog.NewDiscardLogger())
+	genreRepo := genrerepo.NewGenreRepository(dbT.DB, mockslog.NewDiscardLogger())
+	service := genreservice.NewGenreService(mockslog.NewDiscardLogger(), genreRepo)
+	t.Run("Жанр создается, переименовывается и попадает в список", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		created, err := service.CreateGenre(moderatorCtx, gofakeit.LetterN(20))
+		require.NoError(t, err)
+		defer func() { _ = service.DeleteGenre(moderatorCtx, created.GenreID, true) }()
+		newName := gofakeit.LetterN(20)
+		require.NoError(t, service.RenameGenre(moderatorCtx, created.GenreID, newName))
+
+		genres, err := service.ListGenres(ctx)
+		require.NoError(t, err)
+		assert.Contains(t, genres, model.Genre{GenreID: created.GenreID, GenreName: newName})
+	})
+	t.Run("Имя жанра уникально", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		existing := dbT.GetGenres(ctx)[0]
+
+		_, err := service.CreateGenre(moderatorCtx, existing.GenreName)
+		require.ErrorIs(t, err, outerror.ErrGenreAlreadyExist)
+	})
+	t.Run("Жанр, привязанный к игре, удаляется только с force", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		created, err := service.CreateGenre(moderatorCtx, gofakeit.LetterN(20))
+		require.NoError(t, err)
+		game := random.GameToAddService(nil, nil)
+		game.GenreIDs = []int64{created.GenreID}
+		gameID, err := gameRepo.SaveGame(ctx, game)
+		require.NoError(t, err)
+
+		require.ErrorIs(t, service.DeleteGenre(moderatorCtx, created.GenreID, false), outerror.ErrGenreInUse)
+		require.NoError(t, service.DeleteGenre(moderatorCtx, created.GenreID, true))
+		assert.Empty(t, dbT.GetGameGenreByGameID(ctx, gameID))
+	})
+	t.Run("Слияние жанров переносит игры и оставляет старое имя алиасом", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		source, err := service.CreateGenre(moderatorCtx, gofakeit.LetterN(20))
+		require.NoError(t, err)
+		target, err := service.CreateGenre(moderatorCtx, gofakeit.LetterN(20))
+		require.NoError(t, err)
+		defer func() { _ = service.DeleteGenre(moderatorCtx, target.GenreID, true) }()
+		game := random.GameToAddService(nil, nil)
+		game.GenreIDs = []int64{source.GenreID, target.GenreID}
+		gameID, err := gameRepo.SaveGame(ctx, game)
+		require.NoError(t, err)
+
+		require.NoError(t, service.MergeGenres(moderatorCtx, []int64{source.GenreID}, target.GenreID))
+
+		links := dbT.GetGameGenreByGameID(ctx, gameID)
+		require.Len(t, links, 1)
+		assert.Equal(t, target.GenreID, links[0].GenreID)
+		resolved, err := genreRepo.GetGenreByNames(ctx, []string{source.GenreName})
+		require.NoError(t, err)
+		assert.Equal(t, []model.Genre{target}, resolved)
+	})
+	t.Run("Имя алиаса нельзя занять новым или переименованным жанром", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		source, err := service.CreateGenre(moderatorCtx, gofakeit.LetterN(20))
+		require.NoError(t, err)
+		target, err := service.CreateGenre(moderatorCtx, gofakeit.LetterN(20))
+		require.NoError(t, err)
+		defer func() { _ = service.DeleteGenre(moderatorCtx, target.GenreID, true) }()
+		other, err := service.CreateGenre(moderatorCtx, gofakeit.LetterN(20))
+		require.NoError(t, err)
+		defer func() { _ = service.DeleteGenre(moderatorCtx, other.GenreID, true) }()
+		require.NoError(t, service.MergeGenres(moderatorCtx, []int64{source.GenreID}, target.GenreID))
+
+		_, err = service.CreateGenre(moderatorCtx, source.GenreName)
+		require.ErrorIs(t, err, outerror.ErrGenreAlreadyExist)
+		require.ErrorIs(t, service.RenameGenre(moderatorCtx, other.GenreID, source.GenreName), outerror.ErrGenreAlreadyExist)
+		require.NoError(t, service.RenameGenre(moderatorCtx, target.GenreID, source.GenreName))
+	})
+	t.Run("Слияние жанра в самого себя - ErrMergeIntoItself", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		genre := dbT.GetGenres(ctx)[0]
+
+		require.ErrorIs(t, service.MergeGenres(moderatorCtx, []int64{genre.GenreID}, genre.GenreID), outerror.ErrMergeIntoItself)
+	})
+}
+
+func TestTagsGenresRPC(t *testing.T) {
+	ctx := context.Background()
+	client := clientgrpc.NewGameServiceTestClient()
+	moderatorCtx := clientgrpc.WithRole(ctx, caller.RoleModerator)
+	t.Run("Тэг создается, переименовывается, выводится и удаляется", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		_, err := client.GetClient().CreateTag(ctx, &game_api.CreateTagRequest{Name: gofakeit.LetterN(20)})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		created, err := client.GetClient().CreateTag(moderatorCtx, &game_api.CreateTagRequest{Name: gofakeit.LetterN(20)})
+		require.NoError(t, err)
+		newName := gofakeit.LetterN(20)
+		_, err = client.GetClient().RenameTag(moderatorCtx, &game_api.RenameTagRequest{TagId: created.GetTag().GetTagId(), Name: newName})
+		require.NoError(t, err)
+		listed, err := client.GetClient().ListTags(ctx, &game_api.ListTagsRequest{})
+		require.NoError(t, err)
+		assert.Contains(t, model.TagNames(protoTags(listed.GetTags())), newName)
+
+		_, err = client.GetClient().DeleteTag(moderatorCtx, &game_api.DeleteTagRequest{TagId: created.GetTag().GetTagId()})
+		require.NoError(t, err)
+		_, err = client.GetClient().DeleteTag(moderatorCtx, &game_api.DeleteTagRequest{TagId: created.GetTag().GetTagId()})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+	t.Run("Повтор имени - AlreadyExists, пустое имя - InvalidArgument", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		existingTag, existingGenre := dbT.GetTags(ctx)[0], dbT.GetGenres(ctx)[0]
+
+		_, err := client.GetClient().CreateTag(moderatorCtx, &game_api.CreateTagRequest{Name: existingTag.TagName})
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.AlreadyExists, st.Code())
+		assert.Equal(t, outerror.TagAlreadyExistMessage, st.Message())
+		_, err = client.GetClient().CreateGenre(moderatorCtx, &game_api.CreateGenreRequest{Name: existingGenre.GenreName})
+		st, _ = status.FromError(err)
+		assert.Equal(t, codes.AlreadyExists, st.Code())
+		assert.Equal(t, outerror.GenreAlreadyExistMessage, st.Message())
+		_, err = client.GetClient().CreateGenre(moderatorCtx, &game_api.CreateGenreRequest{Name: "  "})
+		st, _ = status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Equal(t, outerror.GenreNameRequiredMessage, st.Message())
+	})
+	t.Run("Жанр, привязанный к игре, удаляется только с force", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		genres, tags := dbT.GetGenres(ctx), dbT.GetTags(ctx)
+		gameToAdd := random.GameToAddRequest(model.GenreNames(genres), model.TagNames(tags))
+		gameToAdd.Genres = gameToAdd.Genres[:1]
+		_, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &game_api.AddGameRequest{Game: gameToAdd})
+		require.NoError(t, err)
+		genre := dbT.GetGenresByNames(ctx, gameToAdd.Genres)[0]
+
+		_, err = client.GetClient().DeleteGenre(moderatorCtx, &game_api.DeleteGenreRequest{GenreId: genre.GenreID})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		_, err = client.GetClient().DeleteGenre(moderatorCtx, &game_api.DeleteGenreRequest{GenreId: genre.GenreID, Force: true})
+		require.NoError(t, err)
+		listed, err := client.GetClient().ListGenres(ctx, &game_api.ListGenresRequest{})
+		require.NoError(t, err)
+		for _, g := range listed.GetGenres() {
+			assert.NotEqual(t, genre.GenreID, g.GetGenreId())
+		}
+	})
+	t.Run("Слияние тэгов и жанров", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		source, err := client.GetClient().CreateTag(moderatorCtx, &game_api.CreateTagRequest{Name: gofakeit.LetterN(20)})
+		require.NoError(t, err)
+		target, err := client.GetClient().CreateTag(moderatorCtx, &game_api.CreateTagRequest{Name: gofakeit.LetterN(20)})
+		require.NoError(t, err)
+		defer func() {
+			_, _ = client.GetClient().DeleteTag(moderatorCtx, &game_api.DeleteTagRequest{TagId: target.GetTag().GetTagId(), Force: true})
+		}()
+		merge := &game_api.MergeTagsRequest{TargetId: target.GetTag().GetTagId(), SourceIds: []int64{source.GetTag().GetTagId()}}
+
+		_, err = client.GetClient().MergeTags(ctx, merge)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = client.GetClient().MergeTags(moderatorCtx, &game_api.MergeTagsRequest{TargetId: merge.GetTargetId(), SourceIds: []int64{merge.GetTargetId()}})
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Equal(t, outerror.MergeIntoItselfMessage, st.Message())
+		_, err = client.GetClient().MergeTags(moderatorCtx, merge)
+		require.NoError(t, err)
+		_, err = client.GetClient().CreateTag(moderatorCtx, &game_api.CreateTagRequest{Name: source.GetTag().GetName()})
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+		genreSource, err := client.GetClient().CreateGenre(moderatorCtx, &game_api.CreateGenreRequest{Name: gofakeit.LetterN(20)})
+		require.NoError(t, err)
+		genreTarget, err := client.GetClient().CreateGenre(moderatorCtx, &game_api.CreateGenreRequest{Name: gofakeit.LetterN(20)})
+		require.NoError(t, err)
+		defer func() {
+			_, _ = client.GetClient().DeleteGenre(moderatorCtx, &game_api.DeleteGenreRequest{GenreId: genreTarget.GetGenre().GetGenreId(), Force: true})
+		}()
+		_, err = client.GetClient().MergeGenres(moderatorCtx, &game_api.MergeGenresRequest{
+			TargetId:  genreTarget.GetGenre().GetGenreId(),
+			SourceIds: []int64{genreSource.GetGenre().GetGenreId()},
+		})
+		require.NoError(t, err)
+		_, err = client.GetClient().MergeGenres(moderatorCtx, &game_api.MergeGenresRequest{
+			TargetId:  genreTarget.GetGenre().GetGenreId(),
+			SourceIds: []int64{genreSource.GetGenre().GetGenreId()},
+		})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func protoTags(tags []*game_api.Tag) []model.Tag {
+	result := make([]model.Tag, 0, len(tags))
+	for _, tag := range tags {
+		result = append(result, model.Tag{TagID: tag.GetTagId(), TagName: tag.GetName()})
+	}
+	return result
+}
@@ -8,48 +8,87 @@ import (
 
 	"github.com/brianvoe/gofakeit/v7"
 	game_api "github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/lib/caller"
 	"github.com/sariya23/game_service/internal/model"
+	"github.com/sariya23/game_service/internal/outerror"
 	"github.com/sariya23/game_service/tests/clientgrpc"
 	"github.com/sariya23/game_service/tests/utils/random"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
 func TestUpdateGameStatus(t *testing.T) {
-	t.Run("Успешное обновление статуса из DRAFT в PENDING", func(t *testing.T) {
+	t.Run("В PENDING игра попадает только через SubmitForReview", func(t *testing.T) {
 		ctx := context.Background()
 		client := clientgrpc.NewGameServiceTestClient()
 		dbT.SetUp(ctx, t, tables...)
 		defer dbT.TearDown(t)
 		genres, tags := dbT.GetGenres(ctx), dbT.GetTags(ctx)
 		gameToAdd := random.GameToAddRequest(model.GenreNames(genres), model.TagNames(tags))
-		responseSave, err := client.GetClient().AddGame(ctx, &game_api.AddGameRequest{Game: gameToAdd})
+		responseSave, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &game_api.AddGameRequest{Game: gameToAdd})
 		require.NoError(t, err)
 		require.NotZero(t, responseSave.GameId)
 
-		_, err = client.GetClient().UpdateGameStatus(ctx, &game_api.UpdateGameStatusRequest{GameId: responseSave.GameId, NewStatus: game_api.GameStatusType_PENDING})
-		require.NoError(t, err)
+		for _, callCtx := range []context.Context{clientgrpc.WithUser(ctx), clientgrpc.WithRole(ctx, caller.RoleAdmin)} {
+			_, err = client.GetClient().UpdateGameStatus(callCtx, &game_api.UpdateGameStatusRequest{GameId: responseSave.GameId, NewStatus: game_api.GameStatusType_PENDING})
+			st, _ := status.FromError(err)
+			assert.Equal(t, codes.FailedPrecondition, st.Code())
+			assert.Equal(t, outerror.ModerationRequiredMessage, st.Message())
+		}
 		game := dbT.GetGameById(ctx, responseSave.GameId)
-		assert.Equal(t, game_api.GameStatusType_PENDING, game.GameStatus)
+		assert.Equal(t, game_api.GameStatusType_DRAFT, game.GameStatus)
+		assert.Empty(t, dbT.GetGameStatusHistory(ctx, responseSave.GameId))
+		pending, err := client.GetClient().ListPendingGames(clientgrpc.WithRole(ctx, caller.RoleModerator), &game_api.ListPendingGamesRequest{})
+		require.NoError(t, err)
+		assert.Empty(t, pending.GetGames())
+	})
+	t.Run("Смена статуса пишется в историю", func(t *testing.T) {
+		ctx := context.Background()
+		client := clientgrpc.NewGameServiceTestClient()
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		genres, tags := dbT.GetGenres(ctx), dbT.GetTags(ctx)
+		gameToAdd := random.GameToAddRequest(model.GenreNames(genres), model.TagNames(tags))
+		responseSave, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &game_api.AddGameRequest{Game: gameToAdd})
+		require.NoError(t, err)
+		actor, requestID := gofakeit.UUID(), gofakeit.UUID()
+		callCtx := metadata.AppendToOutgoingContext(clientgrpc.WithCaller(ctx, actor, ""), "request_id", requestID)
+
+		_, err = client.GetClient().SubmitForReview(callCtx, &game_api.SubmitForReviewRequest{GameId: responseSave.GameId})
+		require.NoError(t, err)
+
+		history := dbT.GetGameStatusHistory(ctx, responseSave.GameId)
+		require.Len(t, history, 1)
+		assert.Equal(t, game_api.GameStatusType_DRAFT, history[0].OldStatus)
+		assert.Equal(t, game_api.GameStatusType_PENDING, history[0].NewStatus)
+		assert.Equal(t, actor, history[0].Actor)
+		assert.Equal(t, requestID, history[0].RequestID)
+		assert.False(t, history[0].ChangedAt.IsZero())
 	})
-	t.Run("Успешное обновление статуса из PENDING в PUBLISH", func(t *testing.T) {
+	t.Run("Из PENDING игру выводит только модерация", func(t *testing.T) {
 		ctx := context.Background()
 		client := clientgrpc.NewGameServiceTestClient()
 		dbT.SetUp(ctx, t, tables...)
 		defer dbT.TearDown(t)
 		genres, tags := dbT.GetGenres(ctx), dbT.GetTags(ctx)
 		gameToAdd := random.GameToAddRequest(model.GenreNames(genres), model.TagNames(tags))
-		responseSave, err := client.GetClient().AddGame(ctx, &game_api.AddGameRequest{Game: gameToAdd})
+		responseSave, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &game_api.AddGameRequest{Game: gameToAdd})
 		require.NoError(t, err)
 		require.NotZero(t, responseSave.GameId)
 		dbT.UpdateGameStatus(ctx, responseSave.GameId, game_api.GameStatusType_PENDING)
 
-		_, err = client.GetClient().UpdateGameStatus(ctx, &game_api.UpdateGameStatusRequest{GameId: responseSave.GameId, NewStatus: game_api.GameStatusType_PUBLISH})
-		require.NoError(t, err)
+		for _, newStatus := range []game_api.GameStatusType{game_api.GameStatusType_PUBLISH, game_api.GameStatusType_DRAFT} {
+			_, err = client.GetClient().UpdateGameStatus(clientgrpc.WithRole(ctx, caller.RoleAdmin), &game_api.UpdateGameStatusRequest{GameId: responseSave.GameId, NewStatus: newStatus})
+			st, _ := status.FromError(err)
+			assert.Equal(t, codes.FailedPrecondition, st.Code())
+			assert.Equal(t, outerror.ModerationRequiredMessage, st.Message())
+		}
 		game := dbT.GetGameById(ctx, responseSave.GameId)
-		assert.Equal(t, game_api.GameStatusType_PUBLISH, game.GameStatus)
+		assert.Equal(t, game_api.GameStatusType_PENDING, game.GameStatus)
+		assert.Empty(t, dbT.GetGameStatusHistory(ctx, responseSave.GameId))
 	})
 	t.Run("Нельзя перевести из статуса DRAFT в PUBLISH", func(t *testing.T) {
 		ctx := context.Background()
@@ -58,11 +97,11 @@ func TestUpdateGameStatus(t *testing.T) {
 		defer dbT.TearDown(t)
 		genres, tags := dbT.GetGenres(ctx), dbT.GetTags(ctx)
 		gameToAdd := random.GameToAddRequest(model.GenreNames(genres), model.TagNames(tags))
-		responseSave, err := client.GetClient().AddGame(ctx, &game_api.AddGameRequest{Game: gameToAdd})
+		responseSave, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &game_api.AddGameRequest{Game: gameToAdd})
 		require.NoError(t, err)
 		require.NotZero(t, responseSave.GameId)
 
-		_, err = client.GetClient().UpdateGameStatus(ctx, &game_api.UpdateGameStatusRequest{GameId: responseSave.GameId, NewStatus: game_api.GameStatusType_PUBLISH})
+		_, err = client.GetClient().UpdateGameStatus(clientgrpc.WithUser(ctx), &game_api.UpdateGameStatusRequest{GameId: responseSave.GameId, NewStatus: game_api.GameStatusType_PUBLISH})
 		st, _ := status.FromError(err)
 		assert.Equal(t, codes.InvalidArgument, st.Code())
 	})
@@ -71,7 +110,7 @@ func TestUpdateGameStatus(t *testing.T) {
 		client := clientgrpc.NewGameServiceTestClient()
 		dbT.SetUp(ctx, t, tables...)
 		defer dbT.TearDown(t)
-		_, err := client.GetClient().UpdateGameStatus(ctx, &game_api.UpdateGameStatusRequest{GameId: -gofakeit.Int64(), NewStatus: game_api.GameStatusType_PUBLISH})
+		_, err := client.GetClient().UpdateGameStatus(clientgrpc.WithUser(ctx), &game_api.UpdateGameStatusRequest{GameId: -gofakeit.Int64(), NewStatus: game_api.GameStatusType_PUBLISH})
 		st, _ := status.FromError(err)
 		assert.Equal(t, codes.InvalidArgument, st.Code())
 	})
@@ -0,0 +1,128 @@
+//go:build integrations
+
+package game_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	game_api "github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/lib/converters"
+	"github.com/sariya23/game_service/internal/model"
+	"github.com/sariya23/game_service/internal/outerror"
+	"github.com/sariya23/game_service/tests/clientgrpc"
+	"github.com/sariya23/game_service/tests/utils/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestUpdateGame(t *testing.T) {
+	t.Run("Меняются только поля из маски", func(t *testing.T) {
+		ctx := context.Background()
+		client := clientgrpc.NewGameServiceTestClient()
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		genres, tags := dbT.GetGenres(ctx), dbT.GetTags(ctx)
+		gameToAdd := random.GameToAddRequest(model.GenreNames(genres), model.TagNames(tags))
+		respAddGame, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &game_api.AddGameRequest{Game: gameToAdd})
+		require.NoError(t, err)
+		before := dbT.GetGameById(ctx, respAddGame.GameId)
+		newDescription := gofakeit.Sentence(20)
+
+		_, err = client.GetClient().UpdateGame(clientgrpc.WithUser(ctx), &game_api.UpdateGameRequest{
+			GameId:     respAddGame.GameId,
+			Game:       &game_api.GameUpdate{Title: gofakeit.LetterN(20), Description: newDescription},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"description"}},
+		})
+
+		require.NoError(t, err)
+		after := dbT.GetGameById(ctx, respAddGame.GameId)
+		assert.Equal(t, newDescription, after.Description)
+		assert.Equal(t, before.Title, after.Title)
+		assert.Equal(t, before.ReleaseDate, after.ReleaseDate)
+		assert.Equal(t, before.ImageKey, after.ImageKey)
+		assert.Len(t, dbT.GetGameTagByGameID(ctx, respAddGame.GameId), len(gameToAdd.Tags))
+		assert.Len(t, dbT.GetGameGenreByGameID(ctx, respAddGame.GameId), len(gameToAdd.Genres))
+	})
+	t.Run("Тэги и жанры из маски заменяются целиком", func(t *testing.T) {
+		ctx := context.Background()
+		client := clientgrpc.NewGameServiceTestClient()
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		genres, tags := dbT.GetGenres(ctx), dbT.GetTags(ctx)
+		gameToAdd := random.GameToAddRequest(model.GenreNames(genres), model.TagNames(tags))
+		respAddGame, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &game_api.AddGameRequest{Game: gameToAdd})
+		require.NoError(t, err)
+		newTags := random.Sample(model.TagNames(tags), 2)
+
+		_, err = client.GetClient().UpdateGame(clientgrpc.WithUser(ctx), &game_api.UpdateGameRequest{
+			GameId:     respAddGame.GameId,
+			Game:       &game_api.GameUpdate{Tags: newTags},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"tags", "genres"}},
+		})
+
+		require.NoError(t, err)
+		tagsExpected := model.TagIDs(dbT.GetTagsByNames(ctx, newTags))
+		tagsActual := make([]int64, 0, len(tagsExpected))
+		for _, link := range dbT.GetGameTagByGameID(ctx, respAddGame.GameId) {
+			tagsActual = append(tagsActual, link.TagID)
+		}
+		sort.Slice(tagsExpected, func(i, j int) bool { return tagsExpected[i] < tagsExpected[j] })
+		sort.Slice(tagsActual, func(i, j int) bool { return tagsActual[i] < tagsActual[j] })
+		assert.Equal(t, tagsExpected, tagsActual)
+		assert.Empty(t, dbT.GetGameGenreByGameID(ctx, respAddGame.GameId))
+	})
+	t.Run("Нельзя переименовать игру в уже существующую", func(t *testing.T) {
+		ctx := context.Background()
+		client := clientgrpc.NewGameServiceTestClient()
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		genres, tags := dbT.GetGenres(ctx), dbT.GetTags(ctx)
+		existing := random.GameToAddRequest(model.GenreNames(genres), model.TagNames(tags))
+		_, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &game_api.AddGameRequest{Game: existing})
+		require.NoError(t, err)
+		gameToAdd := random.GameToAddRequest(model.GenreNames(genres), model.TagNames(tags))
+		respAddGame, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &game_api.AddGameRequest{Game: gameToAdd})
+		require.NoError(t, err)
+
+		_, err = client.GetClient().UpdateGame(clientgrpc.WithUser(ctx), &game_api.UpdateGameRequest{
+			GameId:     respAddGame.GameId,
+			Game:       &game_api.GameUpdate{Title: existing.Title, ReleaseDate: existing.ReleaseDate},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title", "release_date"}},
+		})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.AlreadyExists, st.Code())
+		assert.Equal(t, outerror.GameAlreadyExistMessage, st.Message())
+		assert.Equal(t, gameToAdd.Title, dbT.GetGameById(ctx, respAddGame.GameId).Title)
+		assert.Equal(t, converters.FromProtoDate(gameToAdd.ReleaseDate), dbT.GetGameById(ctx, respAddGame.GameId).ReleaseDate)
+	})
+	t.Run("Неизвестный путь в маске и несуществующая игра", func(t *testing.T) {
+		ctx := context.Background()
+		client := clientgrpc.NewGameServiceTestClient()
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		gameID := int64(gofakeit.Uint32()) + 1<<40
+
+		_, err := client.GetClient().UpdateGame(clientgrpc.WithUser(ctx), &game_api.UpdateGameRequest{
+			GameId:     gameID,
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"cover_image"}},
+		})
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Equal(t, outerror.UnknownUpdateFieldMessage, st.Message())
+
+		_, err = client.GetClient().UpdateGame(clientgrpc.WithUser(ctx), &game_api.UpdateGameRequest{
+			GameId:     gameID,
+			Game:       &game_api.GameUpdate{Description: gofakeit.Sentence(10)},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"description"}},
+		})
+		st, _ = status.FromError(err)
+		assert.Equal(t, codes.NotFound, st.Code())
+	})
+}
@@ -0,0 +1,180 @@
+//go:build integrations
+
+package game_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	game_api "github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/events"
+	"github.com/sariya23/game_service/internal/interceptors"
+	"github.com/sariya23/game_service/internal/lib/caller"
+	"github.com/sariya23/game_service/internal/lib/mockslog"
+	"github.com/sariya23/game_service/internal/model"
+	"github.com/sariya23/game_service/internal/model/dto"
+	"github.com/sariya23/game_service/internal/outerror"
+	outboxservice "github.com/sariya23/game_service/internal/service/outbox"
+	"github.com/sariya23/game_service/internal/storage/postgresql/gamerepo"
+	outboxrepo "github.com/sariya23/game_service/internal/storage/postgresql/outbox_repo"
+	"github.com/sariya23/game_service/tests/clientgrpc"
+	"github.com/sariya23/game_service/tests/utils/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestWatchGames(t *testing.T) {
+	ctx := context.WithValue(context.Background(), interceptors.RequestIDKey, gofakeit.UUID())
+	moderatorCtx := context.WithValue(ctx, interceptors.CallerRoleKey, caller.RoleModerator)
+	gameRepo := gamerepo.NewGameRepository(dbT.DB, mockslog.NewDiscardLogger())
+	outboxRepo := outboxrepo.NewOutboxRepository(dbT.DB, mockslog.NewDiscardLogger())
+	watchAs := func(t *testing.T, ctx context.Context, watcher *outboxservice.Watcher, afterSeq int64) (<-chan model.OutboxEvent, context.CancelFunc) {
+		watchCtx, cancel := context.WithCancel(ctx)
+		received := make(chan model.OutboxEvent, 10)
+		go func() {
+			_ = watcher.WatchGames(watchCtx, afterSeq, func(event model.OutboxEvent) error {
+				received <- event
+				return nil
+			})
+		}()
+		return received, cancel
+	}
+	watch := func(t *testing.T, watcher *outboxservice.Watcher, afterSeq int64) (<-chan model.OutboxEvent, context.CancelFunc) {
+		return watchAs(t, moderatorCtx, watcher, afterSeq)
+	}
+	next := func(t *testing.T, received <-chan model.OutboxEvent) model.OutboxEvent {
+		select {
+		case event := <-received:
+			return event
+		case <-time.After(5 * time.Second):
+			t.Fatal("event is not received")
+			return model.OutboxEvent{}
+		}
+	}
+	t.Run("Клиент получает новые события", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		watcher := outboxservice.NewWatcher(mockslog.NewDiscardLogger(), outboxRepo)
+		go watcher.Run()
+		defer watcher.Stop()
+		received, cancel := watch(t, watcher, 0)
+		defer cancel()
+		// Ждем, пока Watcher подпишется на канал.
+		time.Sleep(200 * time.Millisecond)
+
+		gameID, err := gameRepo.SaveGame(ctx, random.GameToAddService(nil, nil))
+		require.NoError(t, err)
+
+		event := next(t, received)
+		assert.Equal(t, gameID, event.GameID)
+		assert.Equal(t, events.GameCreated, event.EventType)
+	})
+	t.Run("Клиент продолжает с номера последнего события", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		firstID, err := gameRepo.SaveGame(ctx, random.GameToAddService(nil, nil))
+		require.NoError(t, err)
+		secondID, err := gameRepo.SaveGame(ctx, random.GameToAddService(nil, nil))
+		require.NoError(t, err)
+		watcher := outboxservice.NewWatcher(mockslog.NewDiscardLogger(), outboxRepo)
+
+		received, cancel := watch(t, watcher, 0)
+		first := next(t, received)
+		cancel()
+		assert.Equal(t, firstID, first.GameID)
+
+		received, cancel = watch(t, watcher, first.OutboxID)
+		defer cancel()
+		assert.Equal(t, secondID, next(t, received).GameID)
+	})
+	t.Run("После переподключения приходит событие, закоммиченное позже следующего", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		late, err := events.NewGameCreated(int64(gofakeit.Uint32()), gofakeit.LetterN(20), time.Now(), game_api.GameStatusType_PUBLISH)
+		require.NoError(t, err)
+		tx, err := dbT.DB.GetPool().Begin(ctx)
+		require.NoError(t, err)
+		defer func() { _ = tx.Rollback(ctx) }()
+		_, err = tx.Exec(ctx, "insert into outbox (game_id, event_type, payload) values ($1, $2, $3)", late.GameID, late.EventType, late.Payload)
+		require.NoError(t, err)
+		gameID, err := gameRepo.SaveGame(ctx, random.GameToAddService(nil, nil))
+		require.NoError(t, err)
+		watcher := outboxservice.NewWatcher(mockslog.NewDiscardLogger(), outboxRepo)
+
+		received, cancel := watch(t, watcher, 0)
+		first := next(t, received)
+		cancel()
+		require.Equal(t, gameID, first.GameID)
+		require.NoError(t, tx.Commit(ctx))
+
+		received, cancel = watch(t, watcher, first.OutboxID)
+		defer cancel()
+		event := next(t, received)
+		assert.Equal(t, late.GameID, event.GameID)
+		assert.Less(t, event.OutboxID, first.OutboxID)
+	})
+	t.Run("Вызывающий без привилегий не видит события неопубликованных игр", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		gameID, err := gameRepo.SaveGame(ctx, random.GameToAddService(nil, nil))
+		require.NoError(t, err)
+		require.NoError(t, gameRepo.UpdateGameStatus(ctx, dto.GameStatusChange{GameID: gameID, NewStatus: game_api.GameStatusType_PENDING}))
+		require.NoError(t, gameRepo.UpdateGameStatus(ctx, dto.GameStatusChange{GameID: gameID, NewStatus: game_api.GameStatusType_PUBLISH}))
+		watcher := outboxservice.NewWatcher(mockslog.NewDiscardLogger(), outboxRepo)
+
+		received, cancel := watchAs(t, ctx, watcher, 0)
+		defer cancel()
+
+		event := next(t, received)
+		assert.Equal(t, events.GameStatusChanged, event.EventType)
+		assert.True(t, events.Public(event))
+		select {
+		case event = <-received:
+			t.Fatalf("unexpected event %s", event.EventType)
+		case <-time.After(200 * time.Millisecond):
+		}
+	})
+}
+
+func TestWatchGamesRPC(t *testing.T) {
+	t.Run("Поток отдает новые события", func(t *testing.T) {
+		ctx := context.Background()
+		client := clientgrpc.NewGameServiceTestClient()
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		genres, tags := dbT.GetGenres(ctx), dbT.GetTags(ctx)
+		watchCtx, cancel := context.WithTimeout(clientgrpc.WithRole(ctx, caller.RoleModerator), 10*time.Second)
+		defer cancel()
+		stream, err := client.GetClient().WatchGames(watchCtx, &game_api.WatchGamesRequest{})
+		require.NoError(t, err)
+
+		resp, err := client.GetClient().AddGame(clientgrpc.WithUser(ctx), &game_api.AddGameRequest{Game: random.GameToAddRequest(model.GenreNames(genres), model.TagNames(tags))})
+		require.NoError(t, err)
+
+		event, err := stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, resp.GameId, event.GetGameId())
+		assert.Equal(t, events.GameCreated, event.GetEventType())
+		assert.Positive(t, event.GetSeq())
+		var payload events.GameCreatedPayload
+		require.NoError(t, json.Unmarshal([]byte(event.GetPayload()), &payload))
+		assert.Equal(t, resp.GameId, payload.GameID)
+	})
+	t.Run("Отрицательный after_seq", func(t *testing.T) {
+		ctx := context.Background()
+		client := clientgrpc.NewGameServiceTestClient()
+		stream, err := client.GetClient().WatchGames(ctx, &game_api.WatchGamesRequest{AfterSeq: -1})
+		require.NoError(t, err)
+
+		_, err = stream.Recv()
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Equal(t, outerror.NegativeAfterSeqMessage, st.Message())
+	})
+}
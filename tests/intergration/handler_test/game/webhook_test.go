@@ -0,0 +1,229 @@
+//go:build integrations
+
+package game_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	game_api "github.com/sariya23/api_game_service/gen/game"
+	"github.com/sariya23/game_service/internal/events"
+	"github.com/sariya23/game_service/internal/interceptors"
+	"github.com/sariya23/game_service/internal/lib/caller"
+	"github.com/sariya23/game_service/internal/lib/mockslog"
+	"github.com/sariya23/game_service/internal/model"
+	"github.com/sariya23/game_service/internal/outerror"
+	webhookservice "github.com/sariya23/game_service/internal/service/webhook"
+	"github.com/sariya23/game_service/internal/storage/postgresql/webhookrepo"
+	"github.com/sariya23/game_service/internal/webhook"
+	"github.com/sariya23/game_service/tests/clientgrpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+type webhookReceiver struct {
+	mu       sync.Mutex
+	requests []receivedWebhook
+	codes    []int
+}
+
+type receivedWebhook struct {
+	header http.Header
+	body   []byte
+}
+
+func (r *webhookReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.requests = append(r.requests, receivedWebhook{header: req.Header, body: body})
+	code := http.StatusOK
+	if len(r.codes) > 0 {
+		code, r.codes = r.codes[0], r.codes[1:]
+	}
+	w.WriteHeader(code)
+}
+
+func TestWebhooks(t *testing.T) {
+	ctx := context.WithValue(context.Background(), interceptors.RequestIDKey, gofakeit.UUID())
+	moderatorCtx := context.WithValue(ctx, interceptors.CallerRoleKey, caller.RoleModerator)
+	webhookRepo := webhookrepo.NewWebhookRepository(dbT.DB, mockslog.NewDiscardLogger())
+	// Получатель в тестах слушает на 127.0.0.1.
+	guard := webhook.NewGuard(true)
+	service := webhookservice.NewWebhookService(mockslog.NewDiscardLogger(), webhookRepo, guard)
+	event, err := events.NewGameCreated(1, gofakeit.LetterN(20), time.Now(), game_api.GameStatusType_PUBLISH)
+	require.NoError(t, err)
+	event.OutboxID = 1
+	t.Run("Подписка создается, выводится и удаляется только модератором", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		_, err := service.CreateWebhook(ctx, "https://partner.example.com/hook", "", nil)
+		require.ErrorIs(t, err, outerror.ErrModerationForbidden)
+
+		created, err := service.CreateWebhook(moderatorCtx, "https://partner.example.com/hook", "", []string{events.GameCreated})
+		require.NoError(t, err)
+		assert.NotZero(t, created.WebhookID)
+		assert.NotEmpty(t, created.Secret)
+
+		webhooks, err := service.ListWebhooks(moderatorCtx)
+		require.NoError(t, err)
+		require.Len(t, webhooks, 1)
+		assert.Equal(t, created.WebhookID, webhooks[0].WebhookID)
+		assert.Equal(t, []string{events.GameCreated}, webhooks[0].EventTypes)
+		assert.Empty(t, webhooks[0].Secret)
+
+		require.NoError(t, service.DeleteWebhook(moderatorCtx, created.WebhookID))
+		require.ErrorIs(t, service.DeleteWebhook(moderatorCtx, created.WebhookID), outerror.ErrWebhookNotFound)
+	})
+	t.Run("Событие доставляется подписанным и только подписчикам на его тип", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		receiver := &webhookReceiver{}
+		server := httptest.NewServer(receiver)
+		defer server.Close()
+		subscribed, err := service.CreateWebhook(moderatorCtx, server.URL, "secret", []string{events.GameCreated})
+		require.NoError(t, err)
+		_, err = service.CreateWebhook(moderatorCtx, server.URL, "secret", []string{events.GameDeleted})
+		require.NoError(t, err)
+		deliverer := webhookservice.NewDeliverer(mockslog.NewDiscardLogger(), webhookRepo, webhook.NewSender(time.Second, guard), 10, 3, time.Second)
+
+		require.NoError(t, service.Publish(ctx, event))
+		require.NoError(t, service.Publish(ctx, event))
+		require.NoError(t, deliverer.DeliverOnce(ctx))
+		require.NoError(t, deliverer.DeliverOnce(ctx))
+
+		require.Len(t, receiver.requests, 1)
+		request := receiver.requests[0]
+		assert.JSONEq(t, string(event.Payload), string(request.body))
+		assert.Equal(t, events.GameCreated, request.header.Get(webhook.EventHeader))
+		assert.Equal(t, "1", request.header.Get(webhook.DeliveryHeader))
+		timestamp, err := strconv.ParseInt(request.header.Get(webhook.TimestampHeader), 10, 64)
+		require.NoError(t, err)
+		assert.True(t, webhook.Verify("secret", timestamp, request.body, request.header.Get(webhook.SignatureHeader)))
+		status, attempts := dbT.GetWebhookDeliveryStatus(ctx, subscribed.WebhookID)
+		assert.Equal(t, model.WebhookDeliveryDelivered, status)
+		assert.Equal(t, 1, attempts)
+		assert.Equal(t, []int{http.StatusOK}, dbT.GetWebhookAttemptStatusCodes(ctx, subscribed.WebhookID))
+	})
+	t.Run("Неудачная доставка повторяется, а после лимита попыток бросается", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		receiver := &webhookReceiver{codes: []int{http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable}}
+		server := httptest.NewServer(receiver)
+		defer server.Close()
+		created, err := service.CreateWebhook(moderatorCtx, server.URL, "secret", nil)
+		require.NoError(t, err)
+		deliverer := webhookservice.NewDeliverer(mockslog.NewDiscardLogger(), webhookRepo, webhook.NewSender(time.Second, guard), 10, 3, time.Second)
+		require.NoError(t, service.Publish(ctx, event))
+
+		require.NoError(t, deliverer.DeliverOnce(ctx))
+		require.NoError(t, deliverer.DeliverOnce(ctx))
+		status, attempts := dbT.GetWebhookDeliveryStatus(ctx, created.WebhookID)
+		assert.Equal(t, model.WebhookDeliveryPending, status)
+		assert.Equal(t, 1, attempts)
+
+		for range 2 {
+			dbT.SetWebhookDeliveriesDue(ctx)
+			require.NoError(t, deliverer.DeliverOnce(ctx))
+		}
+		status, attempts = dbT.GetWebhookDeliveryStatus(ctx, created.WebhookID)
+		assert.Equal(t, model.WebhookDeliveryFailed, status)
+		assert.Equal(t, 3, attempts)
+		assert.Equal(t,
+			[]int{http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable},
+			dbT.GetWebhookAttemptStatusCodes(ctx, created.WebhookID),
+		)
+	})
+	t.Run("Адрес во внутренней сети и неверный адрес отклоняются", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		strictService := webhookservice.NewWebhookService(mockslog.NewDiscardLogger(), webhookRepo, webhook.NewGuard(false))
+
+		for _, url := range []string{"http://127.0.0.1:8080/hook", "http://169.254.169.254/latest/meta-data", "http://localhost/hook", "http://[::1]/hook"} {
+			_, err := strictService.CreateWebhook(moderatorCtx, url, "", nil)
+			require.ErrorIs(t, err, outerror.ErrWebhookAddressForbidden, url)
+		}
+		_, err := strictService.CreateWebhook(moderatorCtx, "ftp://partner.example.com/hook", "", nil)
+		require.ErrorIs(t, err, outerror.ErrInvalidWebhook)
+		_, err = strictService.CreateWebhook(moderatorCtx, "https://partner.example.com/hook", "", []string{"GameLiked"})
+		require.ErrorIs(t, err, outerror.ErrInvalidWebhook)
+		webhooks, err := strictService.ListWebhooks(moderatorCtx)
+		require.NoError(t, err)
+		assert.Empty(t, webhooks)
+	})
+	t.Run("События неопубликованных игр не доставляются", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		receiver := &webhookReceiver{}
+		server := httptest.NewServer(receiver)
+		defer server.Close()
+		created, err := service.CreateWebhook(moderatorCtx, server.URL, "secret", nil)
+		require.NoError(t, err)
+		draftEvent, err := events.NewGameCreated(2, gofakeit.LetterN(20), time.Now(), game_api.GameStatusType_DRAFT)
+		require.NoError(t, err)
+		draftEvent.OutboxID = 2
+		deliverer := webhookservice.NewDeliverer(mockslog.NewDiscardLogger(), webhookRepo, webhook.NewSender(time.Second, guard), 10, 3, time.Second)
+
+		require.NoError(t, service.Publish(ctx, draftEvent))
+		require.NoError(t, deliverer.DeliverOnce(ctx))
+
+		assert.Empty(t, receiver.requests)
+		assert.Empty(t, dbT.GetWebhookAttemptStatusCodes(ctx, created.WebhookID))
+	})
+}
+
+func TestWebhookRPC(t *testing.T) {
+	ctx := context.Background()
+	client := clientgrpc.NewGameServiceTestClient()
+	moderatorCtx := clientgrpc.WithRole(ctx, caller.RoleModerator)
+	t.Run("Подписка создается, выводится и удаляется", func(t *testing.T) {
+		dbT.SetUp(ctx, t, tables...)
+		defer dbT.TearDown(t)
+		_, err := client.GetClient().CreateWebhook(ctx, &game_api.CreateWebhookRequest{Url: "https://partner.example.com/hook"})
+		assert.Equal(t, codes.PermissionDenied, grpcstatus.Code(err))
+
+		created, err := client.GetClient().CreateWebhook(moderatorCtx, &game_api.CreateWebhookRequest{
+			Url:        "https://partner.example.com/hook",
+			EventTypes: []string{events.GameCreated},
+		})
+		require.NoError(t, err)
+		assert.NotEmpty(t, created.GetWebhook().GetSecret())
+
+		listed, err := client.GetClient().ListWebhooks(moderatorCtx, &game_api.ListWebhooksRequest{})
+		require.NoError(t, err)
+		require.Len(t, listed.GetWebhooks(), 1)
+		assert.Equal(t, created.GetWebhook().GetWebhookId(), listed.GetWebhooks()[0].GetWebhookId())
+		assert.Equal(t, []string{events.GameCreated}, listed.GetWebhooks()[0].GetEventTypes())
+		assert.Empty(t, listed.GetWebhooks()[0].GetSecret())
+
+		_, err = client.GetClient().DeleteWebhook(moderatorCtx, &game_api.DeleteWebhookRequest{WebhookId: created.GetWebhook().GetWebhookId()})
+		require.NoError(t, err)
+		_, err = client.GetClient().DeleteWebhook(moderatorCtx, &game_api.DeleteWebhookRequest{WebhookId: created.GetWebhook().GetWebhookId()})
+		st, _ := grpcstatus.FromError(err)
+		assert.Equal(t, codes.NotFound, st.Code())
+		assert.Equal(t, outerror.WebhookNotFoundMessage, st.Message())
+	})
+	t.Run("Неверный адрес и тип события", func(t *testing.T) {
+		_, err := client.GetClient().CreateWebhook(moderatorCtx, &game_api.CreateWebhookRequest{Url: "ftp://partner.example.com/hook"})
+		st, _ := grpcstatus.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Equal(t, outerror.InvalidWebhookURLMessage, st.Message())
+
+		_, err = client.GetClient().CreateWebhook(moderatorCtx, &game_api.CreateWebhookRequest{
+			Url:        "https://partner.example.com/hook",
+			EventTypes: []string{"GameLiked"},
+		})
+		st, _ = grpcstatus.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Equal(t, outerror.UnknownEventTypeMessage, st.Message())
+	})
+}
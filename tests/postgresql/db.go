@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"path/filepath"
 
@@ -275,7 +276,7 @@ func (d *TestDB) GetGameTagByGameID(ctx context.Context, gameID int64) []model.G
 }
 
 func (d *TestDB) GetGameById(ctx context.Context, gameID int64) *model.GameNoImageURL {
-	queryGame := "select game_id, title, description, release_date, image_key, game_status_id from game where game_id=$1"
+	queryGame := "select game_id, title, description, release_date, image_key, image_variant_widths, game_status_id from game where game_id=$1"
 	queryGenre := "select genre_id, genre_name from game join game_genre using(game_id) join genre using(genre_id) where game_id=$1"
 	queryTag := "select tag_id, tag_name from game_tag join game using(game_id) join tag using(tag_id) where game_id=$1"
 	var gameDB dto.GameDB
@@ -285,6 +286,7 @@ func (d *TestDB) GetGameById(ctx context.Context, gameID int64) *model.GameNoIma
 		&gameDB.Description,
 		&gameDB.ReleaseDate,
 		&gameDB.ImageKey,
+		&gameDB.ImageVariantWidths,
 		&gameDB.GameStatus)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -337,3 +339,83 @@ func (d *TestDB) UpdateGameStatus(ctx context.Context, gameID int64, newStatus a
 		panic(err)
 	}
 }
+
+func (d *TestDB) SetGameDeletedAt(ctx context.Context, gameID int64, deletedAt time.Time) {
+	query := "update game set deleted_at = $1 where game_id = $2"
+	_, err := d.DB.GetPool().Exec(ctx, query, deletedAt, gameID)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (d *TestDB) GetGameStatusHistory(ctx context.Context, gameID int64) []model.GameStatusHistoryEntry {
+	query := "select old_status_id, new_status_id, actor, request_id, created_at from game_status_history where game_id = $1 order by game_status_history_id"
+	rows, err := d.DB.GetPool().Query(ctx, query, gameID)
+	if err != nil {
+		panic(err)
+	}
+	defer rows.Close()
+	var history []model.GameStatusHistoryEntry
+	for rows.Next() {
+		var entry model.GameStatusHistoryEntry
+		if err := rows.Scan(&entry.OldStatus, &entry.NewStatus, &entry.Actor, &entry.RequestID, &entry.ChangedAt); err != nil {
+			panic(err)
+		}
+		history = append(history, entry)
+	}
+	return history
+}
+
+// GetGameModerations возвращает решения модерации по игре в порядке записи.
+func (d *TestDB) GetGameModerations(ctx context.Context, gameID int64) []dto.GameModeration {
+	query := "select decision, coalesce(reason, ''), actor from game_moderation where game_id = $1 order by game_moderation_id"
+	rows, err := d.DB.GetPool().Query(ctx, query, gameID)
+	if err != nil {
+		panic(err)
+	}
+	defer rows.Close()
+	var moderations []dto.GameModeration
+	for rows.Next() {
+		moderation := dto.GameModeration{GameID: gameID}
+		if err := rows.Scan(&moderation.Decision, &moderation.Reason, &moderation.Actor); err != nil {
+			panic(err)
+		}
+		moderations = append(moderations, moderation)
+	}
+	return moderations
+}
+
+func (d *TestDB) GetWebhookDeliveryStatus(ctx context.Context, webhookID int64) (status string, attempts int) {
+	query := "select status, attempts from webhook_delivery where webhook_id = $1"
+	if err := d.DB.GetPool().QueryRow(ctx, query, webhookID).Scan(&status, &attempts); err != nil {
+		panic(err)
+	}
+	return status, attempts
+}
+
+func (d *TestDB) GetWebhookAttemptStatusCodes(ctx context.Context, webhookID int64) []int {
+	query := `select a.status_code from webhook_delivery_attempt a
+	join webhook_delivery d using(webhook_delivery_id)
+	where d.webhook_id = $1 order by a.webhook_delivery_attempt_id`
+	rows, err := d.DB.GetPool().Query(ctx, query, webhookID)
+	if err != nil {
+		panic(err)
+	}
+	defer rows.Close()
+	var codes []int
+	for rows.Next() {
+		var code int
+		if err := rows.Scan(&code); err != nil {
+			panic(err)
+		}
+		codes = append(codes, code)
+	}
+	return codes
+}
+
+func (d *TestDB) SetWebhookDeliveriesDue(ctx context.Context) {
+	query := "update webhook_delivery set next_attempt_at = now() where status = 'pending'"
+	if _, err := d.DB.GetPool().Exec(ctx, query); err != nil {
+		panic(err)
+	}
+}
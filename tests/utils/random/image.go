@@ -5,6 +5,8 @@ import (
 	"image"
 	"image/color"
 	"image/png"
+
+	"github.com/brianvoe/gofakeit/v7"
 )
 
 // Image создает простое тестовое изображение
@@ -27,3 +29,22 @@ func Image() ([]byte, error) {
 
 	return buf.Bytes(), nil
 }
+
+// UniqueImage создает однотонное изображение случайного цвета,
+// чтобы у разных обложек были разные ключи в s3
+func UniqueImage() ([]byte, error) {
+	img := image.NewRGBA(image.Rect(0, 0, 100, 100))
+	fill := color.RGBA{R: gofakeit.Uint8(), G: gofakeit.Uint8(), B: gofakeit.Uint8(), A: 255}
+	for y := 0; y < 100; y++ {
+		for x := 0; x < 100; x++ {
+			img.Set(x, y, fill)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
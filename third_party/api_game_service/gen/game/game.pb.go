@@ -0,0 +1,4902 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: game/game.proto
+
+package game
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	date "google.golang.org/genproto/googleapis/type/date"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Как фильтр сочетает несколько жанров или тэгов
+type MatchMode int32
+
+const (
+	MatchMode_MATCH_ANY MatchMode = 0 // Нужен любой из них
+	MatchMode_MATCH_ALL MatchMode = 1 // Нужны все сразу
+)
+
+// Enum value maps for MatchMode.
+var (
+	MatchMode_name = map[int32]string{
+		0: "MATCH_ANY",
+		1: "MATCH_ALL",
+	}
+	MatchMode_value = map[string]int32{
+		"MATCH_ANY": 0,
+		"MATCH_ALL": 1,
+	}
+)
+
+func (x MatchMode) Enum() *MatchMode {
+	p := new(MatchMode)
+	*p = x
+	return p
+}
+
+func (x MatchMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MatchMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_game_game_proto_enumTypes[0].Descriptor()
+}
+
+func (MatchMode) Type() protoreflect.EnumType {
+	return &file_game_game_proto_enumTypes[0]
+}
+
+func (x MatchMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MatchMode.Descriptor instead.
+func (MatchMode) EnumDescriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{0}
+}
+
+// Порядок выдачи GameList, при равенстве - по ID игры
+type GameSort int32
+
+const (
+	GameSort_GAME_SORT_UNSPECIFIED    GameSort = 0 // По релевантности при поиске, иначе по названию
+	GameSort_GAME_SORT_TITLE          GameSort = 1 // По названию, затем по дате выхода
+	GameSort_GAME_SORT_NEWEST         GameSort = 2 // Сначала новые релизы
+	GameSort_GAME_SORT_OLDEST         GameSort = 3 // Сначала старые релизы
+	GameSort_GAME_SORT_RECENTLY_ADDED GameSort = 4 // Сначала недавно добавленные
+	GameSort_GAME_SORT_RELEVANCE      GameSort = 5 // По релевантности, только вместе с query
+)
+
+// Enum value maps for GameSort.
+var (
+	GameSort_name = map[int32]string{
+		0: "GAME_SORT_UNSPECIFIED",
+		1: "GAME_SORT_TITLE",
+		2: "GAME_SORT_NEWEST",
+		3: "GAME_SORT_OLDEST",
+		4: "GAME_SORT_RECENTLY_ADDED",
+		5: "GAME_SORT_RELEVANCE",
+	}
+	GameSort_value = map[string]int32{
+		"GAME_SORT_UNSPECIFIED":    0,
+		"GAME_SORT_TITLE":          1,
+		"GAME_SORT_NEWEST":         2,
+		"GAME_SORT_OLDEST":         3,
+		"GAME_SORT_RECENTLY_ADDED": 4,
+		"GAME_SORT_RELEVANCE":      5,
+	}
+)
+
+func (x GameSort) Enum() *GameSort {
+	p := new(GameSort)
+	*p = x
+	return p
+}
+
+func (x GameSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GameSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_game_game_proto_enumTypes[1].Descriptor()
+}
+
+func (GameSort) Type() protoreflect.EnumType {
+	return &file_game_game_proto_enumTypes[1]
+}
+
+func (x GameSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GameSort.Descriptor instead.
+func (GameSort) EnumDescriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{1}
+}
+
+type GameStatusType int32
+
+const (
+	GameStatusType_DRAFT   GameStatusType = 0 // Игра в драфте
+	GameStatusType_PENDING GameStatusType = 1 // Игра валидируется
+	GameStatusType_PUBLISH GameStatusType = 2 // Игра опубликована
+)
+
+// Enum value maps for GameStatusType.
+var (
+	GameStatusType_name = map[int32]string{
+		0: "DRAFT",
+		1: "PENDING",
+		2: "PUBLISH",
+	}
+	GameStatusType_value = map[string]int32{
+		"DRAFT":   0,
+		"PENDING": 1,
+		"PUBLISH": 2,
+	}
+)
+
+func (x GameStatusType) Enum() *GameStatusType {
+	p := new(GameStatusType)
+	*p = x
+	return p
+}
+
+func (x GameStatusType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GameStatusType) Descriptor() protoreflect.EnumDescriptor {
+	return file_game_game_proto_enumTypes[2].Descriptor()
+}
+
+func (GameStatusType) Type() protoreflect.EnumType {
+	return &file_game_game_proto_enumTypes[2]
+}
+
+func (x GameStatusType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GameStatusType.Descriptor instead.
+func (GameStatusType) EnumDescriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{2}
+}
+
+type GameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Genres        []string               `protobuf:"bytes,2,rep,name=genres,proto3" json:"genres,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ReleaseDate   *date.Date             `protobuf:"bytes,4,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
+	CoverImage    []byte                 `protobuf:"bytes,5,opt,name=cover_image,json=coverImage,proto3" json:"cover_image,omitempty"`
+	Tags          []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameRequest) Reset() {
+	*x = GameRequest{}
+	mi := &file_game_game_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameRequest) ProtoMessage() {}
+
+func (x *GameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameRequest.ProtoReflect.Descriptor instead.
+func (*GameRequest) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GameRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *GameRequest) GetGenres() []string {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+func (x *GameRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GameRequest) GetReleaseDate() *date.Date {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return nil
+}
+
+func (x *GameRequest) GetCoverImage() []byte {
+	if x != nil {
+		return x.CoverImage
+	}
+	return nil
+}
+
+func (x *GameRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type DomainGame struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Genres        []string               `protobuf:"bytes,2,rep,name=genres,proto3" json:"genres,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ReleaseDate   *date.Date             `protobuf:"bytes,4,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
+	CoverImageUrl string                 `protobuf:"bytes,5,opt,name=cover_image_url,json=coverImageUrl,proto3" json:"cover_image_url,omitempty"`
+	Tags          []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	ID            int64                  `protobuf:"varint,7,opt,name=ID,proto3" json:"ID,omitempty"`
+	// Уменьшенные копии обложки: ширина в пикселях -> ссылка
+	CoverImageVariantUrls map[int32]string `protobuf:"bytes,8,rep,name=cover_image_variant_urls,json=coverImageVariantUrls,proto3" json:"cover_image_variant_urls,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Путь каждого жанра игры от корня, в порядке genres
+	GenrePaths    []*GenrePath `protobuf:"bytes,9,rep,name=genre_paths,json=genrePaths,proto3" json:"genre_paths,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DomainGame) Reset() {
+	*x = DomainGame{}
+	mi := &file_game_game_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DomainGame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DomainGame) ProtoMessage() {}
+
+func (x *DomainGame) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DomainGame.ProtoReflect.Descriptor instead.
+func (*DomainGame) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DomainGame) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *DomainGame) GetGenres() []string {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+func (x *DomainGame) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *DomainGame) GetReleaseDate() *date.Date {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return nil
+}
+
+func (x *DomainGame) GetCoverImageUrl() string {
+	if x != nil {
+		return x.CoverImageUrl
+	}
+	return ""
+}
+
+func (x *DomainGame) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *DomainGame) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *DomainGame) GetCoverImageVariantUrls() map[int32]string {
+	if x != nil {
+		return x.CoverImageVariantUrls
+	}
+	return nil
+}
+
+func (x *DomainGame) GetGenrePaths() []*GenrePath {
+	if x != nil {
+		return x.GenrePaths
+	}
+	return nil
+}
+
+type AddGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Game          *GameRequest           `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddGameRequest) Reset() {
+	*x = AddGameRequest{}
+	mi := &file_game_game_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddGameRequest) ProtoMessage() {}
+
+func (x *AddGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddGameRequest.ProtoReflect.Descriptor instead.
+func (*AddGameRequest) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AddGameRequest) GetGame() *GameRequest {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+type AddGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        int64                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddGameResponse) Reset() {
+	*x = AddGameResponse{}
+	mi := &file_game_game_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddGameResponse) ProtoMessage() {}
+
+func (x *AddGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddGameResponse.ProtoReflect.Descriptor instead.
+func (*AddGameResponse) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AddGameResponse) GetGameId() int64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+type GetGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        int64                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGameRequest) Reset() {
+	*x = GetGameRequest{}
+	mi := &file_game_game_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameRequest) ProtoMessage() {}
+
+func (x *GetGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameRequest.ProtoReflect.Descriptor instead.
+func (*GetGameRequest) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetGameRequest) GetGameId() int64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+type GetGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Game          *DomainGame            `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGameResponse) Reset() {
+	*x = GetGameResponse{}
+	mi := &file_game_game_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameResponse) ProtoMessage() {}
+
+func (x *GetGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameResponse.ProtoReflect.Descriptor instead.
+func (*GetGameResponse) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetGameResponse) GetGame() *DomainGame {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+type GameListRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Year   int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Limit  uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Genres []string               `protobuf:"bytes,3,rep,name=genres,proto3" json:"genres,omitempty"`
+	Tags   []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Полнотекстовый поиск по названию и описанию
+	Query string `protobuf:"bytes,5,opt,name=query,proto3" json:"query,omitempty"`
+	// next_page_token из предыдущего ответа, пустой - первая страница
+	PageToken   string    `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Sort        GameSort  `protobuf:"varint,7,opt,name=sort,proto3,enum=game.GameSort" json:"sort,omitempty"`
+	GenresMatch MatchMode `protobuf:"varint,8,opt,name=genres_match,json=genresMatch,proto3,enum=game.MatchMode" json:"genres_match,omitempty"`
+	TagsMatch   MatchMode `protobuf:"varint,9,opt,name=tags_match,json=tagsMatch,proto3,enum=game.MatchMode" json:"tags_match,omitempty"`
+	// Игры хотя бы с одним из этих жанров или тэгов не попадают в выдачу
+	ExcludeGenres []string `protobuf:"bytes,10,rep,name=exclude_genres,json=excludeGenres,proto3" json:"exclude_genres,omitempty"`
+	ExcludeTags   []string `protobuf:"bytes,11,rep,name=exclude_tags,json=excludeTags,proto3" json:"exclude_tags,omitempty"`
+	// Границы даты выхода включительно, незаданная - без границы
+	ReleasedAfter  *date.Date `protobuf:"bytes,12,opt,name=released_after,json=releasedAfter,proto3" json:"released_after,omitempty"`
+	ReleasedBefore *date.Date `protobuf:"bytes,13,opt,name=released_before,json=releasedBefore,proto3" json:"released_before,omitempty"`
+	// Первый год десятилетия, например 1990
+	Decade int32 `protobuf:"varint,14,opt,name=decade,proto3" json:"decade,omitempty"`
+	// Только игры, которые еще не вышли
+	UpcomingOnly bool `protobuf:"varint,15,opt,name=upcoming_only,json=upcomingOnly,proto3" json:"upcoming_only,omitempty"`
+	// Статусы игр в выдаче, только для модераторов. Пусто - только опубликованные.
+	Statuses      []GameStatusType `protobuf:"varint,16,rep,packed,name=statuses,proto3,enum=game.GameStatusType" json:"statuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameListRequest) Reset() {
+	*x = GameListRequest{}
+	mi := &file_game_game_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameListRequest) ProtoMessage() {}
+
+func (x *GameListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameListRequest.ProtoReflect.Descriptor instead.
+func (*GameListRequest) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GameListRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *GameListRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GameListRequest) GetGenres() []string {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+func (x *GameListRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *GameListRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *GameListRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GameListRequest) GetSort() GameSort {
+	if x != nil {
+		return x.Sort
+	}
+	return GameSort_GAME_SORT_UNSPECIFIED
+}
+
+func (x *GameListRequest) GetGenresMatch() MatchMode {
+	if x != nil {
+		return x.GenresMatch
+	}
+	return MatchMode_MATCH_ANY
+}
+
+func (x *GameListRequest) GetTagsMatch() MatchMode {
+	if x != nil {
+		return x.TagsMatch
+	}
+	return MatchMode_MATCH_ANY
+}
+
+func (x *GameListRequest) GetExcludeGenres() []string {
+	if x != nil {
+		return x.ExcludeGenres
+	}
+	return nil
+}
+
+func (x *GameListRequest) GetExcludeTags() []string {
+	if x != nil {
+		return x.ExcludeTags
+	}
+	return nil
+}
+
+func (x *GameListRequest) GetReleasedAfter() *date.Date {
+	if x != nil {
+		return x.ReleasedAfter
+	}
+	return nil
+}
+
+func (x *GameListRequest) GetReleasedBefore() *date.Date {
+	if x != nil {
+		return x.ReleasedBefore
+	}
+	return nil
+}
+
+func (x *GameListRequest) GetDecade() int32 {
+	if x != nil {
+		return x.Decade
+	}
+	return 0
+}
+
+func (x *GameListRequest) GetUpcomingOnly() bool {
+	if x != nil {
+		return x.UpcomingOnly
+	}
+	return false
+}
+
+func (x *GameListRequest) GetStatuses() []GameStatusType {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+type GameListResponse struct {
+	state protoimpl.MessageState        `protogen:"open.v1"`
+	Games []*GameListResponse_ShortGame `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
+	// Пустой, если страница последняя
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameListResponse) Reset() {
+	*x = GameListResponse{}
+	mi := &file_game_game_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameListResponse) ProtoMessage() {}
+
+func (x *GameListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameListResponse.ProtoReflect.Descriptor instead.
+func (*GameListResponse) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GameListResponse) GetGames() []*GameListResponse_ShortGame {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+func (x *GameListResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type DeleteGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        int64                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGameRequest) Reset() {
+	*x = DeleteGameRequest{}
+	mi := &file_game_game_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGameRequest) ProtoMessage() {}
+
+func (x *DeleteGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGameRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGameRequest) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteGameRequest) GetGameId() int64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+type DeleteGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        int64                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGameResponse) Reset() {
+	*x = DeleteGameResponse{}
+	mi := &file_game_game_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGameResponse) ProtoMessage() {}
+
+func (x *DeleteGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGameResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGameResponse) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteGameResponse) GetGameId() int64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+type UpdateGameStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        int64                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	NewStatus     GameStatusType         `protobuf:"varint,2,opt,name=new_status,json=newStatus,proto3,enum=game.GameStatusType" json:"new_status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGameStatusRequest) Reset() {
+	*x = UpdateGameStatusRequest{}
+	mi := &file_game_game_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGameStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGameStatusRequest) ProtoMessage() {}
+
+func (x *UpdateGameStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGameStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGameStatusRequest) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateGameStatusRequest) GetGameId() int64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *UpdateGameStatusRequest) GetNewStatus() GameStatusType {
+	if x != nil {
+		return x.NewStatus
+	}
+	return GameStatusType_DRAFT
+}
+
+type UpdateGameStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGameStatusResponse) Reset() {
+	*x = UpdateGameStatusResponse{}
+	mi := &file_game_game_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGameStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGameStatusResponse) ProtoMessage() {}
+
+func (x *UpdateGameStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGameStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGameStatusResponse) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{11}
+}
+
+type GameUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Genres        []string               `protobuf:"bytes,2,rep,name=genres,proto3" json:"genres,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ReleaseDate   *date.Date             `protobuf:"bytes,4,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
+	Tags          []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameUpdate) Reset() {
+	*x = GameUpdate{}
+	mi := &file_game_game_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameUpdate) ProtoMessage() {}
+
+func (x *GameUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameUpdate.ProtoReflect.Descriptor instead.
+func (*GameUpdate) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GameUpdate) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *GameUpdate) GetGenres() []string {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+func (x *GameUpdate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GameUpdate) GetReleaseDate() *date.Date {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return nil
+}
+
+func (x *GameUpdate) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// update_mask - пути из GameUpdate: title, description, release_date, tags, genres
+type UpdateGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        int64                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Game          *GameUpdate            `protobuf:"bytes,2,opt,name=game,proto3" json:"game,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGameRequest) Reset() {
+	*x = UpdateGameRequest{}
+	mi := &file_game_game_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGameRequest) ProtoMessage() {}
+
+func (x *UpdateGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGameRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGameRequest) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateGameRequest) GetGameId() int64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *UpdateGameRequest) GetGame() *GameUpdate {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+func (x *UpdateGameRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdateGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGameResponse) Reset() {
+	*x = UpdateGameResponse{}
+	mi := &file_game_game_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGameResponse) ProtoMessage() {}
+
+func (x *UpdateGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGameResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGameResponse) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{14}
+}
+
+type SetGameCoverRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        int64                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	CoverImage    []byte                 `protobuf:"bytes,2,opt,name=cover_image,json=coverImage,proto3" json:"cover_image,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGameCoverRequest) Reset() {
+	*x = SetGameCoverRequest{}
+	mi := &file_game_game_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGameCoverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGameCoverRequest) ProtoMessage() {}
+
+func (x *SetGameCoverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGameCoverRequest.ProtoReflect.Descriptor instead.
+func (*SetGameCoverRequest) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SetGameCoverRequest) GetGameId() int64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *SetGameCoverRequest) GetCoverImage() []byte {
+	if x != nil {
+		return x.CoverImage
+	}
+	return nil
+}
+
+type SetGameCoverResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGameCoverResponse) Reset() {
+	*x = SetGameCoverResponse{}
+	mi := &file_game_game_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGameCoverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGameCoverResponse) ProtoMessage() {}
+
+func (x *SetGameCoverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGameCoverResponse.ProtoReflect.Descriptor instead.
+func (*SetGameCoverResponse) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{16}
+}
+
+type RemoveGameCoverRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        int64                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveGameCoverRequest) Reset() {
+	*x = RemoveGameCoverRequest{}
+	mi := &file_game_game_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveGameCoverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveGameCoverRequest) ProtoMessage() {}
+
+func (x *RemoveGameCoverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveGameCoverRequest.ProtoReflect.Descriptor instead.
+func (*RemoveGameCoverRequest) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RemoveGameCoverRequest) GetGameId() int64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+type RemoveGameCoverResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveGameCoverResponse) Reset() {
+	*x = RemoveGameCoverResponse{}
+	mi := &file_game_game_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveGameCoverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveGameCoverResponse) ProtoMessage() {}
+
+func (x *RemoveGameCoverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveGameCoverResponse.ProtoReflect.Descriptor instead.
+func (*RemoveGameCoverResponse) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{18}
+}
+
+type SuggestGamesRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Prefix string                 `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// 0 - лимит по умолчанию, сервер ограничивает сверху
+	Limit         uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestGamesRequest) Reset() {
+	*x = SuggestGamesRequest{}
+	mi := &file_game_game_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestGamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestGamesRequest) ProtoMessage() {}
+
+func (x *SuggestGamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestGamesRequest.ProtoReflect.Descriptor instead.
+func (*SuggestGamesRequest) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SuggestGamesRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *SuggestGamesRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SuggestGamesResponse struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	Suggestions   []*SuggestGamesResponse_Suggestion `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestGamesResponse) Reset() {
+	*x = SuggestGamesResponse{}
+	mi := &file_game_game_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestGamesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestGamesResponse) ProtoMessage() {}
+
+func (x *SuggestGamesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestGamesResponse.ProtoReflect.Descriptor instead.
+func (*SuggestGamesResponse) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SuggestGamesResponse) GetSuggestions() []*SuggestGamesResponse_Suggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+// Те же фильтры, что в GameListRequest, с теми же номерами полей
+type GameFacetsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Year           int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Genres         []string               `protobuf:"bytes,3,rep,name=genres,proto3" json:"genres,omitempty"`
+	Tags           []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Query          string                 `protobuf:"bytes,5,opt,name=query,proto3" json:"query,omitempty"`
+	GenresMatch    MatchMode              `protobuf:"varint,8,opt,name=genres_match,json=genresMatch,proto3,enum=game.MatchMode" json:"genres_match,omitempty"`
+	TagsMatch      MatchMode              `protobuf:"varint,9,opt,name=tags_match,json=tagsMatch,proto3,enum=game.MatchMode" json:"tags_match,omitempty"`
+	ExcludeGenres  []string               `protobuf:"bytes,10,rep,name=exclude_genres,json=excludeGenres,proto3" json:"exclude_genres,omitempty"`
+	ExcludeTags    []string               `protobuf:"bytes,11,rep,name=exclude_tags,json=excludeTags,proto3" json:"exclude_tags,omitempty"`
+	ReleasedAfter  *date.Date             `protobuf:"bytes,12,opt,name=released_after,json=releasedAfter,proto3" json:"released_after,omitempty"`
+	ReleasedBefore *date.Date             `protobuf:"bytes,13,opt,name=released_before,json=releasedBefore,proto3" json:"released_before,omitempty"`
+	Decade         int32                  `protobuf:"varint,14,opt,name=decade,proto3" json:"decade,omitempty"`
+	UpcomingOnly   bool                   `protobuf:"varint,15,opt,name=upcoming_only,json=upcomingOnly,proto3" json:"upcoming_only,omitempty"`
+	// Статусы игр в выдаче, только для модераторов. Пусто - только опубликованные.
+	Statuses      []GameStatusType `protobuf:"varint,16,rep,packed,name=statuses,proto3,enum=game.GameStatusType" json:"statuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameFacetsRequest) Reset() {
+	*x = GameFacetsRequest{}
+	mi := &file_game_game_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameFacetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameFacetsRequest) ProtoMessage() {}
+
+func (x *GameFacetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameFacetsRequest.ProtoReflect.Descriptor instead.
+func (*GameFacetsRequest) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GameFacetsRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *GameFacetsRequest) GetGenres() []string {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+func (x *GameFacetsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *GameFacetsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *GameFacetsRequest) GetGenresMatch() MatchMode {
+	if x != nil {
+		return x.GenresMatch
+	}
+	return MatchMode_MATCH_ANY
+}
+
+func (x *GameFacetsRequest) GetTagsMatch() MatchMode {
+	if x != nil {
+		return x.TagsMatch
+	}
+	return MatchMode_MATCH_ANY
+}
+
+func (x *GameFacetsRequest) GetExcludeGenres() []string {
+	if x != nil {
+		return x.ExcludeGenres
+	}
+	return nil
+}
+
+func (x *GameFacetsRequest) GetExcludeTags() []string {
+	if x != nil {
+		return x.ExcludeTags
+	}
+	return nil
+}
+
+func (x *GameFacetsRequest) GetReleasedAfter() *date.Date {
+	if x != nil {
+		return x.ReleasedAfter
+	}
+	return nil
+}
+
+func (x *GameFacetsRequest) GetReleasedBefore() *date.Date {
+	if x != nil {
+		return x.ReleasedBefore
+	}
+	return nil
+}
+
+func (x *GameFacetsRequest) GetDecade() int32 {
+	if x != nil {
+		return x.Decade
+	}
+	return 0
+}
+
+func (x *GameFacetsRequest) GetUpcomingOnly() bool {
+	if x != nil {
+		return x.UpcomingOnly
+	}
+	return false
+}
+
+func (x *GameFacetsRequest) GetStatuses() []GameStatusType {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+// Счетчик фасета не учитывает фильтр по своему же измерению
+type GameFacetsResponse struct {
+	state         protoimpl.MessageState               `protogen:"open.v1"`
+	Tags          []*GameFacetsResponse_FacetCount     `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	Genres        []*GameFacetsResponse_FacetCount     `protobuf:"bytes,2,rep,name=genres,proto3" json:"genres,omitempty"`
+	Years         []*GameFacetsResponse_YearFacetCount `protobuf:"bytes,3,rep,name=years,proto3" json:"years,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameFacetsResponse) Reset() {
+	*x = GameFacetsResponse{}
+	mi := &file_game_game_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameFacetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameFacetsResponse) ProtoMessage() {}
+
+func (x *GameFacetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameFacetsResponse.ProtoReflect.Descriptor instead.
+func (*GameFacetsResponse) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GameFacetsResponse) GetTags() []*GameFacetsResponse_FacetCount {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *GameFacetsResponse) GetGenres() []*GameFacetsResponse_FacetCount {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+func (x *GameFacetsResponse) GetYears() []*GameFacetsResponse_YearFacetCount {
+	if x != nil {
+		return x.Years
+	}
+	return nil
+}
+
+type SubmitForReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        int64                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitForReviewRequest) Reset() {
+	*x = SubmitForReviewRequest{}
+	mi := &file_game_game_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitForReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitForReviewRequest) ProtoMessage() {}
+
+func (x *SubmitForReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitForReviewRequest.ProtoReflect.Descriptor instead.
+func (*SubmitForReviewRequest) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SubmitForReviewRequest) GetGameId() int64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+type SubmitForReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitForReviewResponse) Reset() {
+	*x = SubmitForReviewResponse{}
+	mi := &file_game_game_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitForReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitForReviewResponse) ProtoMessage() {}
+
+func (x *SubmitForReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitForReviewResponse.ProtoReflect.Descriptor instead.
+func (*SubmitForReviewResponse) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{24}
+}
+
+type ApproveGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        int64                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveGameRequest) Reset() {
+	*x = ApproveGameRequest{}
+	mi := &file_game_game_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveGameRequest) ProtoMessage() {}
+
+func (x *ApproveGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveGameRequest.ProtoReflect.Descriptor instead.
+func (*ApproveGameRequest) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ApproveGameRequest) GetGameId() int64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+type ApproveGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveGameResponse) Reset() {
+	*x = ApproveGameResponse{}
+	mi := &file_game_game_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveGameResponse) ProtoMessage() {}
+
+func (x *ApproveGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveGameResponse.ProtoReflect.Descriptor instead.
+func (*ApproveGameResponse) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{26}
+}
+
+type RejectGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        int64                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectGameRequest) Reset() {
+	*x = RejectGameRequest{}
+	mi := &file_game_game_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectGameRequest) ProtoMessage() {}
+
+func (x *RejectGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectGameRequest.ProtoReflect.Descriptor instead.
+func (*RejectGameRequest) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RejectGameRequest) GetGameId() int64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *RejectGameRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RejectGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectGameResponse) Reset() {
+	*x = RejectGameResponse{}
+	mi := &file_game_game_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectGameResponse) ProtoMessage() {}
+
+func (x *RejectGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectGameResponse.ProtoReflect.Descriptor instead.
+func (*RejectGameResponse) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{28}
+}
+
+type ListPendingGamesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         uint32                 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingGamesRequest) Reset() {
+	*x = ListPendingGamesRequest{}
+	mi := &file_game_game_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingGamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingGamesRequest) ProtoMessage() {}
+
+func (x *ListPendingGamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingGamesRequest.ProtoReflect.Descriptor instead.
+func (*ListPendingGamesRequest) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListPendingGamesRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListPendingGamesResponse struct {
+	state         protoimpl.MessageState                  `protogen:"open.v1"`
+	Games         []*ListPendingGamesResponse_PendingGame `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingGamesResponse) Reset() {
+	*x = ListPendingGamesResponse{}
+	mi := &file_game_game_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingGamesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingGamesResponse) ProtoMessage() {}
+
+func (x *ListPendingGamesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingGamesResponse.ProtoReflect.Descriptor instead.
+func (*ListPendingGamesResponse) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListPendingGamesResponse) GetGames() []*ListPendingGamesResponse_PendingGame {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+type GetGameStatusHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        int64                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGameStatusHistoryRequest) Reset() {
+	*x = GetGameStatusHistoryRequest{}
+	mi := &file_game_game_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGameStatusHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameStatusHistoryRequest) ProtoMessage() {}
+
+func (x *GetGameStatusHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameStatusHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetGameStatusHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetGameStatusHistoryRequest) GetGameId() int64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+// История хранится и после удаления игры, записи от старых к новым
+type GetGameStatusHistoryResponse struct {
+	state         protoimpl.MessageState                       `protogen:"open.v1"`
+	Changes       []*GetGameStatusHistoryResponse_StatusChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGameStatusHistoryResponse) Reset() {
+	*x = GetGameStatusHistoryResponse{}
+	mi := &file_game_game_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGameStatusHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameStatusHistoryResponse) ProtoMessage() {}
+
+func (x *GetGameStatusHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameStatusHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetGameStatusHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetGameStatusHistoryResponse) GetChanges() []*GetGameStatusHistoryResponse_StatusChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type SchedulePublishRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	GameId int64                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// Время публикации в будущем. Не задано - снять игру с расписания
+	PublishAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchedulePublishRequest) Reset() {
+	*x = SchedulePublishRequest{}
+	mi := &file_game_game_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulePublishRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulePublishRequest) ProtoMessage() {}
+
+func (x *SchedulePublishRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulePublishRequest.ProtoReflect.Descriptor instead.
+func (*SchedulePublishRequest) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SchedulePublishRequest) GetGameId() int64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *SchedulePublishRequest) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+type SchedulePublishResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchedulePublishResponse) Reset() {
+	*x = SchedulePublishResponse{}
+	mi := &file_game_game_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulePublishResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulePublishResponse) ProtoMessage() {}
+
+func (x *SchedulePublishResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulePublishResponse.ProtoReflect.Descriptor instead.
+func (*SchedulePublishResponse) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{34}
+}
+
+type ListDeletedGamesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         uint32                 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedGamesRequest) Reset() {
+	*x = ListDeletedGamesRequest{}
+	mi := &file_game_game_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedGamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedGamesRequest) ProtoMessage() {}
+
+func (x *ListDeletedGamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedGamesRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedGamesRequest) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListDeletedGamesRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListDeletedGamesResponse struct {
+	state         protoimpl.MessageState                  `protogen:"open.v1"`
+	Games         []*ListDeletedGamesResponse_DeletedGame `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedGamesResponse) Reset() {
+	*x = ListDeletedGamesResponse{}
+	mi := &file_game_game_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedGamesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedGamesResponse) ProtoMessage() {}
+
+func (x *ListDeletedGamesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedGamesResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletedGamesResponse) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListDeletedGamesResponse) GetGames() []*ListDeletedGamesResponse_DeletedGame {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+type RestoreGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        int64                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreGameRequest) Reset() {
+	*x = RestoreGameRequest{}
+	mi := &file_game_game_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreGameRequest) ProtoMessage() {}
+
+func (x *RestoreGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreGameRequest.ProtoReflect.Descriptor instead.
+func (*RestoreGameRequest) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RestoreGameRequest) GetGameId() int64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+type RestoreGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreGameResponse) Reset() {
+	*x = RestoreGameResponse{}
+	mi := &file_game_game_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreGameResponse) ProtoMessage() {}
+
+func (x *RestoreGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreGameResponse.ProtoReflect.Descriptor instead.
+func (*RestoreGameResponse) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{38}
+}
+
+type WatchGamesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Наибольший полученный seq, 0 - с начала
+	AfterSeq      int64 `protobuf:"varint,1,opt,name=after_seq,json=afterSeq,proto3" json:"after_seq,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchGamesRequest) Reset() {
+	*x = WatchGamesRequest{}
+	mi := &file_game_game_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchGamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchGamesRequest) ProtoMessage() {}
+
+func (x *WatchGamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchGamesRequest.ProtoReflect.Descriptor instead.
+func (*WatchGamesRequest) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *WatchGamesRequest) GetAfterSeq() int64 {
+	if x != nil {
+		return x.AfterSeq
+	}
+	return 0
+}
+
+type GameEvent struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Seq       int64                  `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	GameId    int64                  `protobuf:"varint,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	EventType string                 `protobuf:"bytes,3,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	// Тело события в JSON
+	Payload       string                 `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameEvent) Reset() {
+	*x = GameEvent{}
+	mi := &file_game_game_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameEvent) ProtoMessage() {}
+
+func (x *GameEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameEvent.ProtoReflect.Descriptor instead.
+func (*GameEvent) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GameEvent) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *GameEvent) GetGameId() int64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *GameEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *GameEvent) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *GameEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type Webhook struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId int64                  `protobuf:"varint,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	Url       string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Пустой список - все события
+	EventTypes []string               `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	CreatedBy  string                 `protobuf:"bytes,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Секрет подписи, возвращается только при создании
+	Secret        string `protobuf:"bytes,6,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	mi := &file_game_game_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *Webhook) GetWebhookId() int64 {
+	if x != nil {
+		return x.WebhookId
+	}
+	return 0
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *Webhook) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Webhook) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Webhook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type CreateWebhookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Url   string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Не задан - сгенерировать
+	Secret        string   `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	EventTypes    []string `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookRequest) Reset() {
+	*x = CreateWebhookRequest{}
+	mi := &file_game_game_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookRequest) ProtoMessage() {}
+
+func (x *CreateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CreateWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+type CreateWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhook       *Webhook               `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookResponse) Reset() {
+	*x = CreateWebhookResponse{}
+	mi := &file_game_game_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookResponse) ProtoMessage() {}
+
+func (x *CreateWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookResponse.ProtoReflect.Descriptor instead.
+func (*CreateWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CreateWebhookResponse) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+type ListWebhooksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksRequest) Reset() {
+	*x = ListWebhooksRequest{}
+	mi := &file_game_game_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksRequest) ProtoMessage() {}
+
+func (x *ListWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{44}
+}
+
+type ListWebhooksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhooks      []*Webhook             `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksResponse) Reset() {
+	*x = ListWebhooksResponse{}
+	mi := &file_game_game_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksResponse) ProtoMessage() {}
+
+func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListWebhooksResponse) GetWebhooks() []*Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+type DeleteWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId     int64                  `protobuf:"varint,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookRequest) Reset() {
+	*x = DeleteWebhookRequest{}
+	mi := &file_game_game_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DeleteWebhookRequest) GetWebhookId() int64 {
+	if x != nil {
+		return x.WebhookId
+	}
+	return 0
+}
+
+type DeleteWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookResponse) Reset() {
+	*x = DeleteWebhookResponse{}
+	mi := &file_game_game_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookResponse) ProtoMessage() {}
+
+func (x *DeleteWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{47}
+}
+
+type Tag struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TagId         int64                  `protobuf:"varint,1,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tag) Reset() {
+	*x = Tag{}
+	mi := &file_game_game_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tag) ProtoMessage() {}
+
+func (x *Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tag.ProtoReflect.Descriptor instead.
+func (*Tag) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *Tag) GetTagId() int64 {
+	if x != nil {
+		return x.TagId
+	}
+	return 0
+}
+
+func (x *Tag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
+	mi := &file_game_game_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsRequest) ProtoMessage() {}
+
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{49}
+}
+
+type ListTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*Tag                 `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
+	mi := &file_game_game_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsResponse) ProtoMessage() {}
+
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListTagsResponse) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type CreateTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTagRequest) Reset() {
+	*x = CreateTagRequest{}
+	mi := &file_game_game_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTagRequest) ProtoMessage() {}
+
+func (x *CreateTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTagRequest.ProtoReflect.Descriptor instead.
+func (*CreateTagRequest) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CreateTagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           *Tag                   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTagResponse) Reset() {
+	*x = CreateTagResponse{}
+	mi := &file_game_game_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTagResponse) ProtoMessage() {}
+
+func (x *CreateTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTagResponse.ProtoReflect.Descriptor instead.
+func (*CreateTagResponse) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CreateTagResponse) GetTag() *Tag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+type RenameTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TagId         int64                  `protobuf:"varint,1,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameTagRequest) Reset() {
+	*x = RenameTagRequest{}
+	mi := &file_game_game_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameTagRequest) ProtoMessage() {}
+
+func (x *RenameTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameTagRequest.ProtoReflect.Descriptor instead.
+func (*RenameTagRequest) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RenameTagRequest) GetTagId() int64 {
+	if x != nil {
+		return x.TagId
+	}
+	return 0
+}
+
+func (x *RenameTagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RenameTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameTagResponse) Reset() {
+	*x = RenameTagResponse{}
+	mi := &file_game_game_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameTagResponse) ProtoMessage() {}
+
+func (x *RenameTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameTagResponse.ProtoReflect.Descriptor instead.
+func (*RenameTagResponse) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{54}
+}
+
+type DeleteTagRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	TagId int64                  `protobuf:"varint,1,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	// Удалить, даже если тэг привязан к играм, и отвязать его
+	Force         bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTagRequest) Reset() {
+	*x = DeleteTagRequest{}
+	mi := &file_game_game_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTagRequest) ProtoMessage() {}
+
+func (x *DeleteTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTagRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTagRequest) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DeleteTagRequest) GetTagId() int64 {
+	if x != nil {
+		return x.TagId
+	}
+	return 0
+}
+
+func (x *DeleteTagRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type DeleteTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTagResponse) Reset() {
+	*x = DeleteTagResponse{}
+	mi := &file_game_game_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTagResponse) ProtoMessage() {}
+
+func (x *DeleteTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTagResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTagResponse) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{56}
+}
+
+type Genre struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GenreId       int64                  `protobuf:"varint,1,opt,name=genre_id,json=genreId,proto3" json:"genre_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Genre) Reset() {
+	*x = Genre{}
+	mi := &file_game_game_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Genre) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Genre) ProtoMessage() {}
+
+func (x *Genre) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Genre.ProtoReflect.Descriptor instead.
+func (*Genre) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *Genre) GetGenreId() int64 {
+	if x != nil {
+		return x.GenreId
+	}
+	return 0
+}
+
+func (x *Genre) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListGenresRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGenresRequest) Reset() {
+	*x = ListGenresRequest{}
+	mi := &file_game_game_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGenresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGenresRequest) ProtoMessage() {}
+
+func (x *ListGenresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGenresRequest.ProtoReflect.Descriptor instead.
+func (*ListGenresRequest) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{58}
+}
+
+type ListGenresResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Genres        []*Genre               `protobuf:"bytes,1,rep,name=genres,proto3" json:"genres,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGenresResponse) Reset() {
+	*x = ListGenresResponse{}
+	mi := &file_game_game_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGenresResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGenresResponse) ProtoMessage() {}
+
+func (x *ListGenresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGenresResponse.ProtoReflect.Descriptor instead.
+func (*ListGenresResponse) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListGenresResponse) GetGenres() []*Genre {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+type CreateGenreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGenreRequest) Reset() {
+	*x = CreateGenreRequest{}
+	mi := &file_game_game_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGenreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGenreRequest) ProtoMessage() {}
+
+func (x *CreateGenreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGenreRequest.ProtoReflect.Descriptor instead.
+func (*CreateGenreRequest) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CreateGenreRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateGenreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Genre         *Genre                 `protobuf:"bytes,1,opt,name=genre,proto3" json:"genre,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGenreResponse) Reset() {
+	*x = CreateGenreResponse{}
+	mi := &file_game_game_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGenreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGenreResponse) ProtoMessage() {}
+
+func (x *CreateGenreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGenreResponse.ProtoReflect.Descriptor instead.
+func (*CreateGenreResponse) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CreateGenreResponse) GetGenre() *Genre {
+	if x != nil {
+		return x.Genre
+	}
+	return nil
+}
+
+type RenameGenreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GenreId       int64                  `protobuf:"varint,1,opt,name=genre_id,json=genreId,proto3" json:"genre_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameGenreRequest) Reset() {
+	*x = RenameGenreRequest{}
+	mi := &file_game_game_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameGenreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameGenreRequest) ProtoMessage() {}
+
+func (x *RenameGenreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameGenreRequest.ProtoReflect.Descriptor instead.
+func (*RenameGenreRequest) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *RenameGenreRequest) GetGenreId() int64 {
+	if x != nil {
+		return x.GenreId
+	}
+	return 0
+}
+
+func (x *RenameGenreRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RenameGenreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameGenreResponse) Reset() {
+	*x = RenameGenreResponse{}
+	mi := &file_game_game_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameGenreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameGenreResponse) ProtoMessage() {}
+
+func (x *RenameGenreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameGenreResponse.ProtoReflect.Descriptor instead.
+func (*RenameGenreResponse) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{63}
+}
+
+type DeleteGenreRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GenreId int64                  `protobuf:"varint,1,opt,name=genre_id,json=genreId,proto3" json:"genre_id,omitempty"`
+	// Удалить, даже если жанр привязан к играм, и отвязать его
+	Force         bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGenreRequest) Reset() {
+	*x = DeleteGenreRequest{}
+	mi := &file_game_game_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGenreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGenreRequest) ProtoMessage() {}
+
+func (x *DeleteGenreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGenreRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGenreRequest) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *DeleteGenreRequest) GetGenreId() int64 {
+	if x != nil {
+		return x.GenreId
+	}
+	return 0
+}
+
+func (x *DeleteGenreRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type DeleteGenreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGenreResponse) Reset() {
+	*x = DeleteGenreResponse{}
+	mi := &file_game_game_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGenreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGenreResponse) ProtoMessage() {}
+
+func (x *DeleteGenreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGenreResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGenreResponse) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{65}
+}
+
+type MergeTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetId      int64                  `protobuf:"varint,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	SourceIds     []int64                `protobuf:"varint,2,rep,packed,name=source_ids,json=sourceIds,proto3" json:"source_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeTagsRequest) Reset() {
+	*x = MergeTagsRequest{}
+	mi := &file_game_game_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeTagsRequest) ProtoMessage() {}
+
+func (x *MergeTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeTagsRequest.ProtoReflect.Descriptor instead.
+func (*MergeTagsRequest) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *MergeTagsRequest) GetTargetId() int64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *MergeTagsRequest) GetSourceIds() []int64 {
+	if x != nil {
+		return x.SourceIds
+	}
+	return nil
+}
+
+type MergeTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeTagsResponse) Reset() {
+	*x = MergeTagsResponse{}
+	mi := &file_game_game_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeTagsResponse) ProtoMessage() {}
+
+func (x *MergeTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeTagsResponse.ProtoReflect.Descriptor instead.
+func (*MergeTagsResponse) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{67}
+}
+
+type MergeGenresRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetId      int64                  `protobuf:"varint,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	SourceIds     []int64                `protobuf:"varint,2,rep,packed,name=source_ids,json=sourceIds,proto3" json:"source_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeGenresRequest) Reset() {
+	*x = MergeGenresRequest{}
+	mi := &file_game_game_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeGenresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeGenresRequest) ProtoMessage() {}
+
+func (x *MergeGenresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeGenresRequest.ProtoReflect.Descriptor instead.
+func (*MergeGenresRequest) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *MergeGenresRequest) GetTargetId() int64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *MergeGenresRequest) GetSourceIds() []int64 {
+	if x != nil {
+		return x.SourceIds
+	}
+	return nil
+}
+
+type MergeGenresResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeGenresResponse) Reset() {
+	*x = MergeGenresResponse{}
+	mi := &file_game_game_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeGenresResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeGenresResponse) ProtoMessage() {}
+
+func (x *MergeGenresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeGenresResponse.ProtoReflect.Descriptor instead.
+func (*MergeGenresResponse) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{69}
+}
+
+// Цепочка жанров от корня до жанра, например RPG › Action RPG
+type GenrePath struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Genres        []*Genre               `protobuf:"bytes,1,rep,name=genres,proto3" json:"genres,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenrePath) Reset() {
+	*x = GenrePath{}
+	mi := &file_game_game_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenrePath) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenrePath) ProtoMessage() {}
+
+func (x *GenrePath) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenrePath.ProtoReflect.Descriptor instead.
+func (*GenrePath) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GenrePath) GetGenres() []*Genre {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+type SetGenreParentRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GenreId int64                  `protobuf:"varint,1,opt,name=genre_id,json=genreId,proto3" json:"genre_id,omitempty"`
+	// 0 - жанр становится корневым
+	ParentId      int64 `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGenreParentRequest) Reset() {
+	*x = SetGenreParentRequest{}
+	mi := &file_game_game_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGenreParentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGenreParentRequest) ProtoMessage() {}
+
+func (x *SetGenreParentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGenreParentRequest.ProtoReflect.Descriptor instead.
+func (*SetGenreParentRequest) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *SetGenreParentRequest) GetGenreId() int64 {
+	if x != nil {
+		return x.GenreId
+	}
+	return 0
+}
+
+func (x *SetGenreParentRequest) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+type SetGenreParentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGenreParentResponse) Reset() {
+	*x = SetGenreParentResponse{}
+	mi := &file_game_game_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGenreParentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGenreParentResponse) ProtoMessage() {}
+
+func (x *SetGenreParentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGenreParentResponse.ProtoReflect.Descriptor instead.
+func (*SetGenreParentResponse) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{72}
+}
+
+type GameListResponse_ShortGame struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ID            int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ReleaseDate   *date.Date             `protobuf:"bytes,4,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
+	CoverImageUrl string                 `protobuf:"bytes,5,opt,name=cover_image_url,json=coverImageUrl,proto3" json:"cover_image_url,omitempty"`
+	// Миниатюра обложки для сетки каталога
+	ThumbnailUrl  string `protobuf:"bytes,6,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameListResponse_ShortGame) Reset() {
+	*x = GameListResponse_ShortGame{}
+	mi := &file_game_game_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameListResponse_ShortGame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameListResponse_ShortGame) ProtoMessage() {}
+
+func (x *GameListResponse_ShortGame) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameListResponse_ShortGame.ProtoReflect.Descriptor instead.
+func (*GameListResponse_ShortGame) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *GameListResponse_ShortGame) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *GameListResponse_ShortGame) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *GameListResponse_ShortGame) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GameListResponse_ShortGame) GetReleaseDate() *date.Date {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return nil
+}
+
+func (x *GameListResponse_ShortGame) GetCoverImageUrl() string {
+	if x != nil {
+		return x.CoverImageUrl
+	}
+	return ""
+}
+
+func (x *GameListResponse_ShortGame) GetThumbnailUrl() string {
+	if x != nil {
+		return x.ThumbnailUrl
+	}
+	return ""
+}
+
+type SuggestGamesResponse_Suggestion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        int64                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestGamesResponse_Suggestion) Reset() {
+	*x = SuggestGamesResponse_Suggestion{}
+	mi := &file_game_game_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestGamesResponse_Suggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestGamesResponse_Suggestion) ProtoMessage() {}
+
+func (x *SuggestGamesResponse_Suggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestGamesResponse_Suggestion.ProtoReflect.Descriptor instead.
+func (*SuggestGamesResponse_Suggestion) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{20, 0}
+}
+
+func (x *SuggestGamesResponse_Suggestion) GetGameId() int64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *SuggestGamesResponse_Suggestion) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type GameFacetsResponse_FacetCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameFacetsResponse_FacetCount) Reset() {
+	*x = GameFacetsResponse_FacetCount{}
+	mi := &file_game_game_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameFacetsResponse_FacetCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameFacetsResponse_FacetCount) ProtoMessage() {}
+
+func (x *GameFacetsResponse_FacetCount) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameFacetsResponse_FacetCount.ProtoReflect.Descriptor instead.
+func (*GameFacetsResponse_FacetCount) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{22, 0}
+}
+
+func (x *GameFacetsResponse_FacetCount) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *GameFacetsResponse_FacetCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GameFacetsResponse_YearFacetCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameFacetsResponse_YearFacetCount) Reset() {
+	*x = GameFacetsResponse_YearFacetCount{}
+	mi := &file_game_game_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameFacetsResponse_YearFacetCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameFacetsResponse_YearFacetCount) ProtoMessage() {}
+
+func (x *GameFacetsResponse_YearFacetCount) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameFacetsResponse_YearFacetCount.ProtoReflect.Descriptor instead.
+func (*GameFacetsResponse_YearFacetCount) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{22, 1}
+}
+
+func (x *GameFacetsResponse_YearFacetCount) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *GameFacetsResponse_YearFacetCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ListPendingGamesResponse_PendingGame struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	GameId      int64                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	ReleaseDate *date.Date             `protobuf:"bytes,3,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
+	// Пусто, если игру перевели в PENDING в обход SubmitForReview
+	SubmittedBy   string                 `protobuf:"bytes,4,opt,name=submitted_by,json=submittedBy,proto3" json:"submitted_by,omitempty"`
+	SubmittedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingGamesResponse_PendingGame) Reset() {
+	*x = ListPendingGamesResponse_PendingGame{}
+	mi := &file_game_game_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingGamesResponse_PendingGame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingGamesResponse_PendingGame) ProtoMessage() {}
+
+func (x *ListPendingGamesResponse_PendingGame) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingGamesResponse_PendingGame.ProtoReflect.Descriptor instead.
+func (*ListPendingGamesResponse_PendingGame) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{30, 0}
+}
+
+func (x *ListPendingGamesResponse_PendingGame) GetGameId() int64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *ListPendingGamesResponse_PendingGame) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ListPendingGamesResponse_PendingGame) GetReleaseDate() *date.Date {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return nil
+}
+
+func (x *ListPendingGamesResponse_PendingGame) GetSubmittedBy() string {
+	if x != nil {
+		return x.SubmittedBy
+	}
+	return ""
+}
+
+func (x *ListPendingGamesResponse_PendingGame) GetSubmittedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return nil
+}
+
+type GetGameStatusHistoryResponse_StatusChange struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	OldStatus GameStatusType         `protobuf:"varint,1,opt,name=old_status,json=oldStatus,proto3,enum=game.GameStatusType" json:"old_status,omitempty"`
+	NewStatus GameStatusType         `protobuf:"varint,2,opt,name=new_status,json=newStatus,proto3,enum=game.GameStatusType" json:"new_status,omitempty"`
+	// id вызывающего, "scheduler" для публикаций по расписанию
+	Actor         string                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	RequestId     string                 `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	ChangedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGameStatusHistoryResponse_StatusChange) Reset() {
+	*x = GetGameStatusHistoryResponse_StatusChange{}
+	mi := &file_game_game_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGameStatusHistoryResponse_StatusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameStatusHistoryResponse_StatusChange) ProtoMessage() {}
+
+func (x *GetGameStatusHistoryResponse_StatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameStatusHistoryResponse_StatusChange.ProtoReflect.Descriptor instead.
+func (*GetGameStatusHistoryResponse_StatusChange) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{32, 0}
+}
+
+func (x *GetGameStatusHistoryResponse_StatusChange) GetOldStatus() GameStatusType {
+	if x != nil {
+		return x.OldStatus
+	}
+	return GameStatusType_DRAFT
+}
+
+func (x *GetGameStatusHistoryResponse_StatusChange) GetNewStatus() GameStatusType {
+	if x != nil {
+		return x.NewStatus
+	}
+	return GameStatusType_DRAFT
+}
+
+func (x *GetGameStatusHistoryResponse_StatusChange) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *GetGameStatusHistoryResponse_StatusChange) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *GetGameStatusHistoryResponse_StatusChange) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+type ListDeletedGamesResponse_DeletedGame struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        int64                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	ReleaseDate   *date.Date             `protobuf:"bytes,3,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
+	Status        GameStatusType         `protobuf:"varint,4,opt,name=status,proto3,enum=game.GameStatusType" json:"status,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedGamesResponse_DeletedGame) Reset() {
+	*x = ListDeletedGamesResponse_DeletedGame{}
+	mi := &file_game_game_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedGamesResponse_DeletedGame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedGamesResponse_DeletedGame) ProtoMessage() {}
+
+func (x *ListDeletedGamesResponse_DeletedGame) ProtoReflect() protoreflect.Message {
+	mi := &file_game_game_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedGamesResponse_DeletedGame.ProtoReflect.Descriptor instead.
+func (*ListDeletedGamesResponse_DeletedGame) Descriptor() ([]byte, []int) {
+	return file_game_game_proto_rawDescGZIP(), []int{36, 0}
+}
+
+func (x *ListDeletedGamesResponse_DeletedGame) GetGameId() int64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *ListDeletedGamesResponse_DeletedGame) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ListDeletedGamesResponse_DeletedGame) GetReleaseDate() *date.Date {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return nil
+}
+
+func (x *ListDeletedGamesResponse_DeletedGame) GetStatus() GameStatusType {
+	if x != nil {
+		return x.Status
+	}
+	return GameStatusType_DRAFT
+}
+
+func (x *ListDeletedGamesResponse_DeletedGame) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+var File_game_game_proto protoreflect.FileDescriptor
+
+const file_game_game_proto_rawDesc = "" +
+	"\n" +
+	"\x0fgame/game.proto\x12\x04game\x1a\x1cgoogle/api/annotations.proto\x1a\x15google/api/date.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc8\x01\n" +
+	"\vGameRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x16\n" +
+	"\x06genres\x18\x02 \x03(\tR\x06genres\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x124\n" +
+	"\frelease_date\x18\x04 \x01(\v2\x11.google.type.DateR\vreleaseDate\x12\x1f\n" +
+	"\vcover_image\x18\x05 \x01(\fR\n" +
+	"coverImage\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\"\xc0\x03\n" +
+	"\n" +
+	"DomainGame\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x16\n" +
+	"\x06genres\x18\x02 \x03(\tR\x06genres\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x124\n" +
+	"\frelease_date\x18\x04 \x01(\v2\x11.google.type.DateR\vreleaseDate\x12&\n" +
+	"\x0fcover_image_url\x18\x05 \x01(\tR\rcoverImageUrl\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\x12\x0e\n" +
+	"\x02ID\x18\a \x01(\x03R\x02ID\x12d\n" +
+	"\x18cover_image_variant_urls\x18\b \x03(\v2+.game.DomainGame.CoverImageVariantUrlsEntryR\x15coverImageVariantUrls\x120\n" +
+	"\vgenre_paths\x18\t \x03(\v2\x0f.game.GenrePathR\n" +
+	"genrePaths\x1aH\n" +
+	"\x1aCoverImageVariantUrlsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"7\n" +
+	"\x0eAddGameRequest\x12%\n" +
+	"\x04game\x18\x01 \x01(\v2\x11.game.GameRequestR\x04game\"*\n" +
+	"\x0fAddGameResponse\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\x03R\x06gameId\")\n" +
+	"\x0eGetGameRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\x03R\x06gameId\"7\n" +
+	"\x0fGetGameResponse\x12$\n" +
+	"\x04game\x18\x01 \x01(\v2\x10.game.DomainGameR\x04game\"\xd3\x04\n" +
+	"\x0fGameListRequest\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\x12\x16\n" +
+	"\x06genres\x18\x03 \x03(\tR\x06genres\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12\x14\n" +
+	"\x05query\x18\x05 \x01(\tR\x05query\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\x12\"\n" +
+	"\x04sort\x18\a \x01(\x0e2\x0e.game.GameSortR\x04sort\x122\n" +
+	"\fgenres_match\x18\b \x01(\x0e2\x0f.game.MatchModeR\vgenresMatch\x12.\n" +
+	"\n" +
+	"tags_match\x18\t \x01(\x0e2\x0f.game.MatchModeR\ttagsMatch\x12%\n" +
+	"\x0eexclude_genres\x18\n" +
+	" \x03(\tR\rexcludeGenres\x12!\n" +
+	"\fexclude_tags\x18\v \x03(\tR\vexcludeTags\x128\n" +
+	"\x0ereleased_after\x18\f \x01(\v2\x11.google.type.DateR\rreleasedAfter\x12:\n" +
+	"\x0freleased_before\x18\r \x01(\v2\x11.google.type.DateR\x0ereleasedBefore\x12\x16\n" +
+	"\x06decade\x18\x0e \x01(\x05R\x06decade\x12#\n" +
+	"\rupcoming_only\x18\x0f \x01(\bR\fupcomingOnly\x120\n" +
+	"\bstatuses\x18\x10 \x03(\x0e2\x14.game.GameStatusTypeR\bstatuses\"\xcb\x02\n" +
+	"\x10GameListResponse\x126\n" +
+	"\x05games\x18\x01 \x03(\v2 .game.GameListResponse.ShortGameR\x05games\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x1a\xd6\x01\n" +
+	"\tShortGame\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x03R\x02ID\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x124\n" +
+	"\frelease_date\x18\x04 \x01(\v2\x11.google.type.DateR\vreleaseDate\x12&\n" +
+	"\x0fcover_image_url\x18\x05 \x01(\tR\rcoverImageUrl\x12#\n" +
+	"\rthumbnail_url\x18\x06 \x01(\tR\fthumbnailUrl\",\n" +
+	"\x11DeleteGameRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\x03R\x06gameId\"-\n" +
+	"\x12DeleteGameResponse\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\x03R\x06gameId\"g\n" +
+	"\x17UpdateGameStatusRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\x03R\x06gameId\x123\n" +
+	"\n" +
+	"new_status\x18\x02 \x01(\x0e2\x14.game.GameStatusTypeR\tnewStatus\"\x1a\n" +
+	"\x18UpdateGameStatusResponse\"\xa6\x01\n" +
+	"\n" +
+	"GameUpdate\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x16\n" +
+	"\x06genres\x18\x02 \x03(\tR\x06genres\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x124\n" +
+	"\frelease_date\x18\x04 \x01(\v2\x11.google.type.DateR\vreleaseDate\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\"\x8f\x01\n" +
+	"\x11UpdateGameRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\x03R\x06gameId\x12$\n" +
+	"\x04game\x18\x02 \x01(\v2\x10.game.GameUpdateR\x04game\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"\x14\n" +
+	"\x12UpdateGameResponse\"O\n" +
+	"\x13SetGameCoverRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\x03R\x06gameId\x12\x1f\n" +
+	"\vcover_image\x18\x02 \x01(\fR\n" +
+	"coverImage\"\x16\n" +
+	"\x14SetGameCoverResponse\"1\n" +
+	"\x16RemoveGameCoverRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\x03R\x06gameId\"\x19\n" +
+	"\x17RemoveGameCoverResponse\"C\n" +
+	"\x13SuggestGamesRequest\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\"\x9c\x01\n" +
+	"\x14SuggestGamesResponse\x12G\n" +
+	"\vsuggestions\x18\x01 \x03(\v2%.game.SuggestGamesResponse.SuggestionR\vsuggestions\x1a;\n" +
+	"\n" +
+	"Suggestion\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\x03R\x06gameId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\"\x8e\x04\n" +
+	"\x11GameFacetsRequest\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x16\n" +
+	"\x06genres\x18\x03 \x03(\tR\x06genres\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12\x14\n" +
+	"\x05query\x18\x05 \x01(\tR\x05query\x122\n" +
+	"\fgenres_match\x18\b \x01(\x0e2\x0f.game.MatchModeR\vgenresMatch\x12.\n" +
+	"\n" +
+	"tags_match\x18\t \x01(\x0e2\x0f.game.MatchModeR\ttagsMatch\x12%\n" +
+	"\x0eexclude_genres\x18\n" +
+	" \x03(\tR\rexcludeGenres\x12!\n" +
+	"\fexclude_tags\x18\v \x03(\tR\vexcludeTags\x128\n" +
+	"\x0ereleased_after\x18\f \x01(\v2\x11.google.type.DateR\rreleasedAfter\x12:\n" +
+	"\x0freleased_before\x18\r \x01(\v2\x11.google.type.DateR\x0ereleasedBefore\x12\x16\n" +
+	"\x06decade\x18\x0e \x01(\x05R\x06decade\x12#\n" +
+	"\rupcoming_only\x18\x0f \x01(\bR\fupcomingOnly\x120\n" +
+	"\bstatuses\x18\x10 \x03(\x0e2\x14.game.GameStatusTypeR\bstatusesJ\x04\b\x02\x10\x03J\x04\b\x06\x10\aJ\x04\b\a\x10\b\"\xbf\x02\n" +
+	"\x12GameFacetsResponse\x127\n" +
+	"\x04tags\x18\x01 \x03(\v2#.game.GameFacetsResponse.FacetCountR\x04tags\x12;\n" +
+	"\x06genres\x18\x02 \x03(\v2#.game.GameFacetsResponse.FacetCountR\x06genres\x12=\n" +
+	"\x05years\x18\x03 \x03(\v2'.game.GameFacetsResponse.YearFacetCountR\x05years\x1a8\n" +
+	"\n" +
+	"FacetCount\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\x1a:\n" +
+	"\x0eYearFacetCount\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"1\n" +
+	"\x16SubmitForReviewRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\x03R\x06gameId\"\x19\n" +
+	"\x17SubmitForReviewResponse\"-\n" +
+	"\x12ApproveGameRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\x03R\x06gameId\"\x15\n" +
+	"\x13ApproveGameResponse\"D\n" +
+	"\x11RejectGameRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\x03R\x06gameId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x14\n" +
+	"\x12RejectGameResponse\"/\n" +
+	"\x17ListPendingGamesRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\rR\x05limit\"\xb3\x02\n" +
+	"\x18ListPendingGamesResponse\x12@\n" +
+	"\x05games\x18\x01 \x03(\v2*.game.ListPendingGamesResponse.PendingGameR\x05games\x1a\xd4\x01\n" +
+	"\vPendingGame\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\x03R\x06gameId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x124\n" +
+	"\frelease_date\x18\x03 \x01(\v2\x11.google.type.DateR\vreleaseDate\x12!\n" +
+	"\fsubmitted_by\x18\x04 \x01(\tR\vsubmittedBy\x12=\n" +
+	"\fsubmitted_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vsubmittedAt\"6\n" +
+	"\x1bGetGameStatusHistoryRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\x03R\x06gameId\"\xd4\x02\n" +
+	"\x1cGetGameStatusHistoryResponse\x12I\n" +
+	"\achanges\x18\x01 \x03(\v2/.game.GetGameStatusHistoryResponse.StatusChangeR\achanges\x1a\xe8\x01\n" +
+	"\fStatusChange\x123\n" +
+	"\n" +
+	"old_status\x18\x01 \x01(\x0e2\x14.game.GameStatusTypeR\toldStatus\x123\n" +
+	"\n" +
+	"new_status\x18\x02 \x01(\x0e2\x14.game.GameStatusTypeR\tnewStatus\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x04 \x01(\tR\trequestId\x129\n" +
+	"\n" +
+	"changed_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\"l\n" +
+	"\x16SchedulePublishRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\x03R\x06gameId\x129\n" +
+	"\n" +
+	"publish_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAt\"\x19\n" +
+	"\x17SchedulePublishResponse\"/\n" +
+	"\x17ListDeletedGamesRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\rR\x05limit\"\xba\x02\n" +
+	"\x18ListDeletedGamesResponse\x12@\n" +
+	"\x05games\x18\x01 \x03(\v2*.game.ListDeletedGamesResponse.DeletedGameR\x05games\x1a\xdb\x01\n" +
+	"\vDeletedGame\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\x03R\x06gameId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x124\n" +
+	"\frelease_date\x18\x03 \x01(\v2\x11.google.type.DateR\vreleaseDate\x12,\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x14.game.GameStatusTypeR\x06status\x129\n" +
+	"\n" +
+	"deleted_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\"-\n" +
+	"\x12RestoreGameRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\x03R\x06gameId\"\x15\n" +
+	"\x13RestoreGameResponse\"0\n" +
+	"\x11WatchGamesRequest\x12\x1b\n" +
+	"\tafter_seq\x18\x01 \x01(\x03R\bafterSeq\"\xaa\x01\n" +
+	"\tGameEvent\x12\x10\n" +
+	"\x03seq\x18\x01 \x01(\x03R\x03seq\x12\x17\n" +
+	"\agame_id\x18\x02 \x01(\x03R\x06gameId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x03 \x01(\tR\teventType\x12\x18\n" +
+	"\apayload\x18\x04 \x01(\tR\apayload\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xcd\x01\n" +
+	"\aWebhook\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\x03R\twebhookId\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1f\n" +
+	"\vevent_types\x18\x03 \x03(\tR\n" +
+	"eventTypes\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x04 \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x16\n" +
+	"\x06secret\x18\x06 \x01(\tR\x06secret\"a\n" +
+	"\x14CreateWebhookRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\x12\x1f\n" +
+	"\vevent_types\x18\x03 \x03(\tR\n" +
+	"eventTypes\"@\n" +
+	"\x15CreateWebhookResponse\x12'\n" +
+	"\awebhook\x18\x01 \x01(\v2\r.game.WebhookR\awebhook\"\x15\n" +
+	"\x13ListWebhooksRequest\"A\n" +
+	"\x14ListWebhooksResponse\x12)\n" +
+	"\bwebhooks\x18\x01 \x03(\v2\r.game.WebhookR\bwebhooks\"5\n" +
+	"\x14DeleteWebhookRequest\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\x03R\twebhookId\"\x17\n" +
+	"\x15DeleteWebhookResponse\"0\n" +
+	"\x03Tag\x12\x15\n" +
+	"\x06tag_id\x18\x01 \x01(\x03R\x05tagId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\x11\n" +
+	"\x0fListTagsRequest\"1\n" +
+	"\x10ListTagsResponse\x12\x1d\n" +
+	"\x04tags\x18\x01 \x03(\v2\t.game.TagR\x04tags\"&\n" +
+	"\x10CreateTagRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"0\n" +
+	"\x11CreateTagResponse\x12\x1b\n" +
+	"\x03tag\x18\x01 \x01(\v2\t.game.TagR\x03tag\"=\n" +
+	"\x10RenameTagRequest\x12\x15\n" +
+	"\x06tag_id\x18\x01 \x01(\x03R\x05tagId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\x13\n" +
+	"\x11RenameTagResponse\"?\n" +
+	"\x10DeleteTagRequest\x12\x15\n" +
+	"\x06tag_id\x18\x01 \x01(\x03R\x05tagId\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\"\x13\n" +
+	"\x11DeleteTagResponse\"6\n" +
+	"\x05Genre\x12\x19\n" +
+	"\bgenre_id\x18\x01 \x01(\x03R\agenreId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\x13\n" +
+	"\x11ListGenresRequest\"9\n" +
+	"\x12ListGenresResponse\x12#\n" +
+	"\x06genres\x18\x01 \x03(\v2\v.game.GenreR\x06genres\"(\n" +
+	"\x12CreateGenreRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"8\n" +
+	"\x13CreateGenreResponse\x12!\n" +
+	"\x05genre\x18\x01 \x01(\v2\v.game.GenreR\x05genre\"C\n" +
+	"\x12RenameGenreRequest\x12\x19\n" +
+	"\bgenre_id\x18\x01 \x01(\x03R\agenreId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\x15\n" +
+	"\x13RenameGenreResponse\"E\n" +
+	"\x12DeleteGenreRequest\x12\x19\n" +
+	"\bgenre_id\x18\x01 \x01(\x03R\agenreId\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\"\x15\n" +
+	"\x13DeleteGenreResponse\"N\n" +
+	"\x10MergeTagsRequest\x12\x1b\n" +
+	"\ttarget_id\x18\x01 \x01(\x03R\btargetId\x12\x1d\n" +
+	"\n" +
+	"source_ids\x18\x02 \x03(\x03R\tsourceIds\"\x13\n" +
+	"\x11MergeTagsResponse\"P\n" +
+	"\x12MergeGenresRequest\x12\x1b\n" +
+	"\ttarget_id\x18\x01 \x01(\x03R\btargetId\x12\x1d\n" +
+	"\n" +
+	"source_ids\x18\x02 \x03(\x03R\tsourceIds\"\x15\n" +
+	"\x13MergeGenresResponse\"0\n" +
+	"\tGenrePath\x12#\n" +
+	"\x06genres\x18\x01 \x03(\v2\v.game.GenreR\x06genres\"O\n" +
+	"\x15SetGenreParentRequest\x12\x19\n" +
+	"\bgenre_id\x18\x01 \x01(\x03R\agenreId\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\x03R\bparentId\"\x18\n" +
+	"\x16SetGenreParentResponse*)\n" +
+	"\tMatchMode\x12\r\n" +
+	"\tMATCH_ANY\x10\x00\x12\r\n" +
+	"\tMATCH_ALL\x10\x01*\x9d\x01\n" +
+	"\bGameSort\x12\x19\n" +
+	"\x15GAME_SORT_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fGAME_SORT_TITLE\x10\x01\x12\x14\n" +
+	"\x10GAME_SORT_NEWEST\x10\x02\x12\x14\n" +
+	"\x10GAME_SORT_OLDEST\x10\x03\x12\x1c\n" +
+	"\x18GAME_SORT_RECENTLY_ADDED\x10\x04\x12\x17\n" +
+	"\x13GAME_SORT_RELEVANCE\x10\x05*5\n" +
+	"\x0eGameStatusType\x12\t\n" +
+	"\x05DRAFT\x10\x00\x12\v\n" +
+	"\aPENDING\x10\x01\x12\v\n" +
+	"\aPUBLISH\x10\x022\x9d\x1a\n" +
+	"\vGameService\x12L\n" +
+	"\aAddGame\x12\x14.game.AddGameRequest\x1a\x15.game.AddGameResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/games\x12S\n" +
+	"\aGetGame\x12\x14.game.GetGameRequest\x1a\x15.game.GetGameResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/games/{game_id}\x12T\n" +
+	"\bGameList\x12\x15.game.GameListRequest\x1a\x16.game.GameListResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/games/list\x12\\\n" +
+	"\n" +
+	"DeleteGame\x12\x17.game.DeleteGameRequest\x1a\x18.game.DeleteGameResponse\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/v1/games/{game_id}\x12z\n" +
+	"\x10UpdateGameStatus\x12\x1d.game.UpdateGameStatusRequest\x1a\x1e.game.UpdateGameStatusResponse\"'\x82\xd3\xe4\x93\x02!:\x01*2\x1c/v1/games/update_game_status\x12b\n" +
+	"\n" +
+	"UpdateGame\x12\x17.game.UpdateGameRequest\x1a\x18.game.UpdateGameResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x04game2\x13/v1/games/{game_id}\x12k\n" +
+	"\fSetGameCover\x12\x19.game.SetGameCoverRequest\x1a\x1a.game.SetGameCoverResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\x1a\x19/v1/games/{game_id}/cover\x12q\n" +
+	"\x0fRemoveGameCover\x12\x1c.game.RemoveGameCoverRequest\x1a\x1d.game.RemoveGameCoverResponse\"!\x82\xd3\xe4\x93\x02\x1b*\x19/v1/games/{game_id}/cover\x12`\n" +
+	"\fSuggestGames\x12\x19.game.SuggestGamesRequest\x1a\x1a.game.SuggestGamesResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/games/suggest\x12\\\n" +
+	"\n" +
+	"GameFacets\x12\x17.game.GameFacetsRequest\x1a\x18.game.GameFacetsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/games/facets\x12u\n" +
+	"\x0fSubmitForReview\x12\x1c.game.SubmitForReviewRequest\x1a\x1d.game.SubmitForReviewResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/games/{game_id}/submit\x12j\n" +
+	"\vApproveGame\x12\x18.game.ApproveGameRequest\x1a\x19.game.ApproveGameResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/games/{game_id}/approve\x12f\n" +
+	"\n" +
+	"RejectGame\x12\x17.game.RejectGameRequest\x1a\x18.game.RejectGameResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/games/{game_id}/reject\x12o\n" +
+	"\x10ListPendingGames\x12\x1d.game.ListPendingGamesRequest\x1a\x1e.game.ListPendingGamesResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/moderation/games\x12\x89\x01\n" +
+	"\x14GetGameStatusHistory\x12!.game.GetGameStatusHistoryRequest\x1a\".game.GetGameStatusHistoryResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/games/{game_id}/status_history\x12\x7f\n" +
+	"\x0fSchedulePublish\x12\x1c.game.SchedulePublishRequest\x1a\x1d.game.SchedulePublishResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/games/{game_id}/schedule_publish\x12l\n" +
+	"\x10ListDeletedGames\x12\x1d.game.ListDeletedGamesRequest\x1a\x1e.game.ListDeletedGamesResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/games/deleted\x12j\n" +
+	"\vRestoreGame\x12\x18.game.RestoreGameRequest\x1a\x19.game.RestoreGameResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/games/{game_id}/restore\x12Q\n" +
+	"\n" +
+	"WatchGames\x12\x17.game.WatchGamesRequest\x1a\x0f.game.GameEvent\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/games/watch0\x01\x12a\n" +
+	"\rCreateWebhook\x12\x1a.game.CreateWebhookRequest\x1a\x1b.game.CreateWebhookResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/webhooks\x12[\n" +
+	"\fListWebhooks\x12\x19.game.ListWebhooksRequest\x1a\x1a.game.ListWebhooksResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/webhooks\x12k\n" +
+	"\rDeleteWebhook\x12\x1a.game.DeleteWebhookRequest\x1a\x1b.game.DeleteWebhookResponse\"!\x82\xd3\xe4\x93\x02\x1b*\x19/v1/webhooks/{webhook_id}\x12K\n" +
+	"\bListTags\x12\x15.game.ListTagsRequest\x1a\x16.game.ListTagsResponse\"\x10\x82\xd3\xe4\x93\x02\n" +
+	"\x12\b/v1/tags\x12Q\n" +
+	"\tCreateTag\x12\x16.game.CreateTagRequest\x1a\x17.game.CreateTagResponse\"\x13\x82\xd3\xe4\x93\x02\r:\x01*\"\b/v1/tags\x12Z\n" +
+	"\tRenameTag\x12\x16.game.RenameTagRequest\x1a\x17.game.RenameTagResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*2\x11/v1/tags/{tag_id}\x12W\n" +
+	"\tDeleteTag\x12\x16.game.DeleteTagRequest\x1a\x17.game.DeleteTagResponse\"\x19\x82\xd3\xe4\x93\x02\x13*\x11/v1/tags/{tag_id}\x12S\n" +
+	"\n" +
+	"ListGenres\x12\x17.game.ListGenresRequest\x1a\x18.game.ListGenresResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v1/genres\x12Y\n" +
+	"\vCreateGenre\x12\x18.game.CreateGenreRequest\x1a\x19.game.CreateGenreResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/v1/genres\x12d\n" +
+	"\vRenameGenre\x12\x18.game.RenameGenreRequest\x1a\x19.game.RenameGenreResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*2\x15/v1/genres/{genre_id}\x12a\n" +
+	"\vDeleteGenre\x12\x18.game.DeleteGenreRequest\x1a\x19.game.DeleteGenreResponse\"\x1d\x82\xd3\xe4\x93\x02\x17*\x15/v1/genres/{genre_id}\x12c\n" +
+	"\tMergeTags\x12\x16.game.MergeTagsRequest\x1a\x17.game.MergeTagsResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/tags/{target_id}/merge\x12k\n" +
+	"\vMergeGenres\x12\x18.game.MergeGenresRequest\x1a\x19.game.MergeGenresResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/genres/{target_id}/merge\x12t\n" +
+	"\x0eSetGenreParent\x12\x1b.game.SetGenreParentRequest\x1a\x1c.game.SetGenreParentResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\x1a\x1c/v1/genres/{genre_id}/parentB4Z2github.com/sariya23/api_game_service/gen/game;gameb\x06proto3"
+
+var (
+	file_game_game_proto_rawDescOnce sync.Once
+	file_game_game_proto_rawDescData []byte
+)
+
+func file_game_game_proto_rawDescGZIP() []byte {
+	file_game_game_proto_rawDescOnce.Do(func() {
+		file_game_game_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_game_game_proto_rawDesc), len(file_game_game_proto_rawDesc)))
+	})
+	return file_game_game_proto_rawDescData
+}
+
+var file_game_game_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_game_game_proto_msgTypes = make([]protoimpl.MessageInfo, 81)
+var file_game_game_proto_goTypes = []any{
+	(MatchMode)(0),                                    // 0: game.MatchMode
+	(GameSort)(0),                                     // 1: game.GameSort
+	(GameStatusType)(0),                               // 2: game.GameStatusType
+	(*GameRequest)(nil),                               // 3: game.GameRequest
+	(*DomainGame)(nil),                                // 4: game.DomainGame
+	(*AddGameRequest)(nil),                            // 5: game.AddGameRequest
+	(*AddGameResponse)(nil),                           // 6: game.AddGameResponse
+	(*GetGameRequest)(nil),                            // 7: game.GetGameRequest
+	(*GetGameResponse)(nil),                           // 8: game.GetGameResponse
+	(*GameListRequest)(nil),                           // 9: game.GameListRequest
+	(*GameListResponse)(nil),                          // 10: game.GameListResponse
+	(*DeleteGameRequest)(nil),                         // 11: game.DeleteGameRequest
+	(*DeleteGameResponse)(nil),                        // 12: game.DeleteGameResponse
+	(*UpdateGameStatusRequest)(nil),                   // 13: game.UpdateGameStatusRequest
+	(*UpdateGameStatusResponse)(nil),                  // 14: game.UpdateGameStatusResponse
+	(*GameUpdate)(nil),                                // 15: game.GameUpdate
+	(*UpdateGameRequest)(nil),                         // 16: game.UpdateGameRequest
+	(*UpdateGameResponse)(nil),                        // 17: game.UpdateGameResponse
+	(*SetGameCoverRequest)(nil),                       // 18: game.SetGameCoverRequest
+	(*SetGameCoverResponse)(nil),                      // 19: game.SetGameCoverResponse
+	(*RemoveGameCoverRequest)(nil),                    // 20: game.RemoveGameCoverRequest
+	(*RemoveGameCoverResponse)(nil),                   // 21: game.RemoveGameCoverResponse
+	(*SuggestGamesRequest)(nil),                       // 22: game.SuggestGamesRequest
+	(*SuggestGamesResponse)(nil),                      // 23: game.SuggestGamesResponse
+	(*GameFacetsRequest)(nil),                         // 24: game.GameFacetsRequest
+	(*GameFacetsResponse)(nil),                        // 25: game.GameFacetsResponse
+	(*SubmitForReviewRequest)(nil),                    // 26: game.SubmitForReviewRequest
+	(*SubmitForReviewResponse)(nil),                   // 27: game.SubmitForReviewResponse
+	(*ApproveGameRequest)(nil),                        // 28: game.ApproveGameRequest
+	(*ApproveGameResponse)(nil),                       // 29: game.ApproveGameResponse
+	(*RejectGameRequest)(nil),                         // 30: game.RejectGameRequest
+	(*RejectGameResponse)(nil),                        // 31: game.RejectGameResponse
+	(*ListPendingGamesRequest)(nil),                   // 32: game.ListPendingGamesRequest
+	(*ListPendingGamesResponse)(nil),                  // 33: game.ListPendingGamesResponse
+	(*GetGameStatusHistoryRequest)(nil),               // 34: game.GetGameStatusHistoryRequest
+	(*GetGameStatusHistoryResponse)(nil),              // 35: game.GetGameStatusHistoryResponse
+	(*SchedulePublishRequest)(nil),                    // 36: game.SchedulePublishRequest
+	(*SchedulePublishResponse)(nil),                   // 37: game.SchedulePublishResponse
+	(*ListDeletedGamesRequest)(nil),                   // 38: game.ListDeletedGamesRequest
+	(*ListDeletedGamesResponse)(nil),                  // 39: game.ListDeletedGamesResponse
+	(*RestoreGameRequest)(nil),                        // 40: game.RestoreGameRequest
+	(*RestoreGameResponse)(nil),                       // 41: game.RestoreGameResponse
+	(*WatchGamesRequest)(nil),                         // 42: game.WatchGamesRequest
+	(*GameEvent)(nil),                                 // 43: game.GameEvent
+	(*Webhook)(nil),                                   // 44: game.Webhook
+	(*CreateWebhookRequest)(nil),                      // 45: game.CreateWebhookRequest
+	(*CreateWebhookResponse)(nil),                     // 46: game.CreateWebhookResponse
+	(*ListWebhooksRequest)(nil),                       // 47: game.ListWebhooksRequest
+	(*ListWebhooksResponse)(nil),                      // 48: game.ListWebhooksResponse
+	(*DeleteWebhookRequest)(nil),                      // 49: game.DeleteWebhookRequest
+	(*DeleteWebhookResponse)(nil),                     // 50: game.DeleteWebhookResponse
+	(*Tag)(nil),                                       // 51: game.Tag
+	(*ListTagsRequest)(nil),                           // 52: game.ListTagsRequest
+	(*ListTagsResponse)(nil),                          // 53: game.ListTagsResponse
+	(*CreateTagRequest)(nil),                          // 54: game.CreateTagRequest
+	(*CreateTagResponse)(nil),                         // 55: game.CreateTagResponse
+	(*RenameTagRequest)(nil),                          // 56: game.RenameTagRequest
+	(*RenameTagResponse)(nil),                         // 57: game.RenameTagResponse
+	(*DeleteTagRequest)(nil),                          // 58: game.DeleteTagRequest
+	(*DeleteTagResponse)(nil),                         // 59: game.DeleteTagResponse
+	(*Genre)(nil),                                     // 60: game.Genre
+	(*ListGenresRequest)(nil),                         // 61: game.ListGenresRequest
+	(*ListGenresResponse)(nil),                        // 62: game.ListGenresResponse
+	(*CreateGenreRequest)(nil),                        // 63: game.CreateGenreRequest
+	(*CreateGenreResponse)(nil),                       // 64: game.CreateGenreResponse
+	(*RenameGenreRequest)(nil),                        // 65: game.RenameGenreRequest
+	(*RenameGenreResponse)(nil),                       // 66: game.RenameGenreResponse
+	(*DeleteGenreRequest)(nil),                        // 67: game.DeleteGenreRequest
+	(*DeleteGenreResponse)(nil),                       // 68: game.DeleteGenreResponse
+	(*MergeTagsRequest)(nil),                          // 69: game.MergeTagsRequest
+	(*MergeTagsResponse)(nil),                         // 70: game.MergeTagsResponse
+	(*MergeGenresRequest)(nil),                        // 71: game.MergeGenresRequest
+	(*MergeGenresResponse)(nil),                       // 72: game.MergeGenresResponse
+	(*GenrePath)(nil),                                 // 73: game.GenrePath
+	(*SetGenreParentRequest)(nil),                     // 74: game.SetGenreParentRequest
+	(*SetGenreParentResponse)(nil),                    // 75: game.SetGenreParentResponse
+	nil,                                               // 76: game.DomainGame.CoverImageVariantUrlsEntry
+	(*GameListResponse_ShortGame)(nil),                // 77: game.GameListResponse.ShortGame
+	(*SuggestGamesResponse_Suggestion)(nil),           // 78: game.SuggestGamesResponse.Suggestion
+	(*GameFacetsResponse_FacetCount)(nil),             // 79: game.GameFacetsResponse.FacetCount
+	(*GameFacetsResponse_YearFacetCount)(nil),         // 80: game.GameFacetsResponse.YearFacetCount
+	(*ListPendingGamesResponse_PendingGame)(nil),      // 81: game.ListPendingGamesResponse.PendingGame
+	(*GetGameStatusHistoryResponse_StatusChange)(nil), // 82: game.GetGameStatusHistoryResponse.StatusChange
+	(*ListDeletedGamesResponse_DeletedGame)(nil),      // 83: game.ListDeletedGamesResponse.DeletedGame
+	(*date.Date)(nil),                                 // 84: google.type.Date
+	(*fieldmaskpb.FieldMask)(nil),                     // 85: google.protobuf.FieldMask
+	(*timestamppb.Timestamp)(nil),                     // 86: google.protobuf.Timestamp
+}
+var file_game_game_proto_depIdxs = []int32{
+	84, // 0: game.GameRequest.release_date:type_name -> google.type.Date
+	84, // 1: game.DomainGame.release_date:type_name -> google.type.Date
+	76, // 2: game.DomainGame.cover_image_variant_urls:type_name -> game.DomainGame.CoverImageVariantUrlsEntry
+	73, // 3: game.DomainGame.genre_paths:type_name -> game.GenrePath
+	3,  // 4: game.AddGameRequest.game:type_name -> game.GameRequest
+	4,  // 5: game.GetGameResponse.game:type_name -> game.DomainGame
+	1,  // 6: game.GameListRequest.sort:type_name -> game.GameSort
+	0,  // 7: game.GameListRequest.genres_match:type_name -> game.MatchMode
+	0,  // 8: game.GameListRequest.tags_match:type_name -> game.MatchMode
+	84, // 9: game.GameListRequest.released_after:type_name -> google.type.Date
+	84, // 10: game.GameListRequest.released_before:type_name -> google.type.Date
+	2,  // 11: game.GameListRequest.statuses:type_name -> game.GameStatusType
+	77, // 12: game.GameListResponse.games:type_name -> game.GameListResponse.ShortGame
+	2,  // 13: game.UpdateGameStatusRequest.new_status:type_name -> game.GameStatusType
+	84, // 14: game.GameUpdate.release_date:type_name -> google.type.Date
+	15, // 15: game.UpdateGameRequest.game:type_name -> game.GameUpdate
+	85, // 16: game.UpdateGameRequest.update_mask:type_name -> google.protobuf.FieldMask
+	78, // 17: game.SuggestGamesResponse.suggestions:type_name -> game.SuggestGamesResponse.Suggestion
+	0,  // 18: game.GameFacetsRequest.genres_match:type_name -> game.MatchMode
+	0,  // 19: game.GameFacetsRequest.tags_match:type_name -> game.MatchMode
+	84, // 20: game.GameFacetsRequest.released_after:type_name -> google.type.Date
+	84, // 21: game.GameFacetsRequest.released_before:type_name -> google.type.Date
+	2,  // 22: game.GameFacetsRequest.statuses:type_name -> game.GameStatusType
+	79, // 23: game.GameFacetsResponse.tags:type_name -> game.GameFacetsResponse.FacetCount
+	79, // 24: game.GameFacetsResponse.genres:type_name -> game.GameFacetsResponse.FacetCount
+	80, // 25: game.GameFacetsResponse.years:type_name -> game.GameFacetsResponse.YearFacetCount
+	81, // 26: game.ListPendingGamesResponse.games:type_name -> game.ListPendingGamesResponse.PendingGame
+	82, // 27: game.GetGameStatusHistoryResponse.changes:type_name -> game.GetGameStatusHistoryResponse.StatusChange
+	86, // 28: game.SchedulePublishRequest.publish_at:type_name -> google.protobuf.Timestamp
+	83, // 29: game.ListDeletedGamesResponse.games:type_name -> game.ListDeletedGamesResponse.DeletedGame
+	86, // 30: game.GameEvent.created_at:type_name -> google.protobuf.Timestamp
+	86, // 31: game.Webhook.created_at:type_name -> google.protobuf.Timestamp
+	44, // 32: game.CreateWebhookResponse.webhook:type_name -> game.Webhook
+	44, // 33: game.ListWebhooksResponse.webhooks:type_name -> game.Webhook
+	51, // 34: game.ListTagsResponse.tags:type_name -> game.Tag
+	51, // 35: game.CreateTagResponse.tag:type_name -> game.Tag
+	60, // 36: game.ListGenresResponse.genres:type_name -> game.Genre
+	60, // 37: game.CreateGenreResponse.genre:type_name -> game.Genre
+	60, // 38: game.GenrePath.genres:type_name -> game.Genre
+	84, // 39: game.GameListResponse.ShortGame.release_date:type_name -> google.type.Date
+	84, // 40: game.ListPendingGamesResponse.PendingGame.release_date:type_name -> google.type.Date
+	86, // 41: game.ListPendingGamesResponse.PendingGame.submitted_at:type_name -> google.protobuf.Timestamp
+	2,  // 42: game.GetGameStatusHistoryResponse.StatusChange.old_status:type_name -> game.GameStatusType
+	2,  // 43: game.GetGameStatusHistoryResponse.StatusChange.new_status:type_name -> game.GameStatusType
+	86, // 44: game.GetGameStatusHistoryResponse.StatusChange.changed_at:type_name -> google.protobuf.Timestamp
+	84, // 45: game.ListDeletedGamesResponse.DeletedGame.release_date:type_name -> google.type.Date
+	2,  // 46: game.ListDeletedGamesResponse.DeletedGame.status:type_name -> game.GameStatusType
+	86, // 47: game.ListDeletedGamesResponse.DeletedGame.deleted_at:type_name -> google.protobuf.Timestamp
+	5,  // 48: game.GameService.AddGame:input_type -> game.AddGameRequest
+	7,  // 49: game.GameService.GetGame:input_type -> game.GetGameRequest
+	9,  // 50: game.GameService.GameList:input_type -> game.GameListRequest
+	11, // 51: game.GameService.DeleteGame:input_type -> game.DeleteGameRequest
+	13, // 52: game.GameService.UpdateGameStatus:input_type -> game.UpdateGameStatusRequest
+	16, // 53: game.GameService.UpdateGame:input_type -> game.UpdateGameRequest
+	18, // 54: game.GameService.SetGameCover:input_type -> game.SetGameCoverRequest
+	20, // 55: game.GameService.RemoveGameCover:input_type -> game.RemoveGameCoverRequest
+	22, // 56: game.GameService.SuggestGames:input_type -> game.SuggestGamesRequest
+	24, // 57: game.GameService.GameFacets:input_type -> game.GameFacetsRequest
+	26, // 58: game.GameService.SubmitForReview:input_type -> game.SubmitForReviewRequest
+	28, // 59: game.GameService.ApproveGame:input_type -> game.ApproveGameRequest
+	30, // 60: game.GameService.RejectGame:input_type -> game.RejectGameRequest
+	32, // 61: game.GameService.ListPendingGames:input_type -> game.ListPendingGamesRequest
+	34, // 62: game.GameService.GetGameStatusHistory:input_type -> game.GetGameStatusHistoryRequest
+	36, // 63: game.GameService.SchedulePublish:input_type -> game.SchedulePublishRequest
+	38, // 64: game.GameService.ListDeletedGames:input_type -> game.ListDeletedGamesRequest
+	40, // 65: game.GameService.RestoreGame:input_type -> game.RestoreGameRequest
+	42, // 66: game.GameService.WatchGames:input_type -> game.WatchGamesRequest
+	45, // 67: game.GameService.CreateWebhook:input_type -> game.CreateWebhookRequest
+	47, // 68: game.GameService.ListWebhooks:input_type -> game.ListWebhooksRequest
+	49, // 69: game.GameService.DeleteWebhook:input_type -> game.DeleteWebhookRequest
+	52, // 70: game.GameService.ListTags:input_type -> game.ListTagsRequest
+	54, // 71: game.GameService.CreateTag:input_type -> game.CreateTagRequest
+	56, // 72: game.GameService.RenameTag:input_type -> game.RenameTagRequest
+	58, // 73: game.GameService.DeleteTag:input_type -> game.DeleteTagRequest
+	61, // 74: game.GameService.ListGenres:input_type -> game.ListGenresRequest
+	63, // 75: game.GameService.CreateGenre:input_type -> game.CreateGenreRequest
+	65, // 76: game.GameService.RenameGenre:input_type -> game.RenameGenreRequest
+	67, // 77: game.GameService.DeleteGenre:input_type -> game.DeleteGenreRequest
+	69, // 78: game.GameService.MergeTags:input_type -> game.MergeTagsRequest
+	71, // 79: game.GameService.MergeGenres:input_type -> game.MergeGenresRequest
+	74, // 80: game.GameService.SetGenreParent:input_type -> game.SetGenreParentRequest
+	6,  // 81: game.GameService.AddGame:output_type -> game.AddGameResponse
+	8,  // 82: game.GameService.GetGame:output_type -> game.GetGameResponse
+	10, // 83: game.GameService.GameList:output_type -> game.GameListResponse
+	12, // 84: game.GameService.DeleteGame:output_type -> game.DeleteGameResponse
+	14, // 85: game.GameService.UpdateGameStatus:output_type -> game.UpdateGameStatusResponse
+	17, // 86: game.GameService.UpdateGame:output_type -> game.UpdateGameResponse
+	19, // 87: game.GameService.SetGameCover:output_type -> game.SetGameCoverResponse
+	21, // 88: game.GameService.RemoveGameCover:output_type -> game.RemoveGameCoverResponse
+	23, // 89: game.GameService.SuggestGames:output_type -> game.SuggestGamesResponse
+	25, // 90: game.GameService.GameFacets:output_type -> game.GameFacetsResponse
+	27, // 91: game.GameService.SubmitForReview:output_type -> game.SubmitForReviewResponse
+	29, // 92: game.GameService.ApproveGame:output_type -> game.ApproveGameResponse
+	31, // 93: game.GameService.RejectGame:output_type -> game.RejectGameResponse
+	33, // 94: game.GameService.ListPendingGames:output_type -> game.ListPendingGamesResponse
+	35, // 95: game.GameService.GetGameStatusHistory:output_type -> game.GetGameStatusHistoryResponse
+	37, // 96: game.GameService.SchedulePublish:output_type -> game.SchedulePublishResponse
+	39, // 97: game.GameService.ListDeletedGames:output_type -> game.ListDeletedGamesResponse
+	41, // 98: game.GameService.RestoreGame:output_type -> game.RestoreGameResponse
+	43, // 99: game.GameService.WatchGames:output_type -> game.GameEvent
+	46, // 100: game.GameService.CreateWebhook:output_type -> game.CreateWebhookResponse
+	48, // 101: game.GameService.ListWebhooks:output_type -> game.ListWebhooksResponse
+	50, // 102: game.GameService.DeleteWebhook:output_type -> game.DeleteWebhookResponse
+	53, // 103: game.GameService.ListTags:output_type -> game.ListTagsResponse
+	55, // 104: game.GameService.CreateTag:output_type -> game.CreateTagResponse
+	57, // 105: game.GameService.RenameTag:output_type -> game.RenameTagResponse
+	59, // 106: game.GameService.DeleteTag:output_type -> game.DeleteTagResponse
+	62, // 107: game.GameService.ListGenres:output_type -> game.ListGenresResponse
+	64, // 108: game.GameService.CreateGenre:output_type -> game.CreateGenreResponse
+	66, // 109: game.GameService.RenameGenre:output_type -> game.RenameGenreResponse
+	68, // 110: game.GameService.DeleteGenre:output_type -> game.DeleteGenreResponse
+	70, // 111: game.GameService.MergeTags:output_type -> game.MergeTagsResponse
+	72, // 112: game.GameService.MergeGenres:output_type -> game.MergeGenresResponse
+	75, // 113: game.GameService.SetGenreParent:output_type -> game.SetGenreParentResponse
+	81, // [81:114] is the sub-list for method output_type
+	48, // [48:81] is the sub-list for method input_type
+	48, // [48:48] is the sub-list for extension type_name
+	48, // [48:48] is the sub-list for extension extendee
+	0,  // [0:48] is the sub-list for field type_name
+}
+
+func init() { file_game_game_proto_init() }
+func file_game_game_proto_init() {
+	if File_game_game_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_game_game_proto_rawDesc), len(file_game_game_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   81,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_game_game_proto_goTypes,
+		DependencyIndexes: file_game_game_proto_depIdxs,
+		EnumInfos:         file_game_game_proto_enumTypes,
+		MessageInfos:      file_game_game_proto_msgTypes,
+	}.Build()
+	File_game_game_proto = out.File
+	file_game_game_proto_goTypes = nil
+	file_game_game_proto_depIdxs = nil
+}
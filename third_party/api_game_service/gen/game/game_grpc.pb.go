@@ -0,0 +1,1409 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: game/game.proto
+
+package game
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GameService_AddGame_FullMethodName              = "/game.GameService/AddGame"
+	GameService_GetGame_FullMethodName              = "/game.GameService/GetGame"
+	GameService_GameList_FullMethodName             = "/game.GameService/GameList"
+	GameService_DeleteGame_FullMethodName           = "/game.GameService/DeleteGame"
+	GameService_UpdateGameStatus_FullMethodName     = "/game.GameService/UpdateGameStatus"
+	GameService_UpdateGame_FullMethodName           = "/game.GameService/UpdateGame"
+	GameService_SetGameCover_FullMethodName         = "/game.GameService/SetGameCover"
+	GameService_RemoveGameCover_FullMethodName      = "/game.GameService/RemoveGameCover"
+	GameService_SuggestGames_FullMethodName         = "/game.GameService/SuggestGames"
+	GameService_GameFacets_FullMethodName           = "/game.GameService/GameFacets"
+	GameService_SubmitForReview_FullMethodName      = "/game.GameService/SubmitForReview"
+	GameService_ApproveGame_FullMethodName          = "/game.GameService/ApproveGame"
+	GameService_RejectGame_FullMethodName           = "/game.GameService/RejectGame"
+	GameService_ListPendingGames_FullMethodName     = "/game.GameService/ListPendingGames"
+	GameService_GetGameStatusHistory_FullMethodName = "/game.GameService/GetGameStatusHistory"
+	GameService_SchedulePublish_FullMethodName      = "/game.GameService/SchedulePublish"
+	GameService_ListDeletedGames_FullMethodName     = "/game.GameService/ListDeletedGames"
+	GameService_RestoreGame_FullMethodName          = "/game.GameService/RestoreGame"
+	GameService_WatchGames_FullMethodName           = "/game.GameService/WatchGames"
+	GameService_CreateWebhook_FullMethodName        = "/game.GameService/CreateWebhook"
+	GameService_ListWebhooks_FullMethodName         = "/game.GameService/ListWebhooks"
+	GameService_DeleteWebhook_FullMethodName        = "/game.GameService/DeleteWebhook"
+	GameService_ListTags_FullMethodName             = "/game.GameService/ListTags"
+	GameService_CreateTag_FullMethodName            = "/game.GameService/CreateTag"
+	GameService_RenameTag_FullMethodName            = "/game.GameService/RenameTag"
+	GameService_DeleteTag_FullMethodName            = "/game.GameService/DeleteTag"
+	GameService_ListGenres_FullMethodName           = "/game.GameService/ListGenres"
+	GameService_CreateGenre_FullMethodName          = "/game.GameService/CreateGenre"
+	GameService_RenameGenre_FullMethodName          = "/game.GameService/RenameGenre"
+	GameService_DeleteGenre_FullMethodName          = "/game.GameService/DeleteGenre"
+	GameService_MergeTags_FullMethodName            = "/game.GameService/MergeTags"
+	GameService_MergeGenres_FullMethodName          = "/game.GameService/MergeGenres"
+	GameService_SetGenreParent_FullMethodName       = "/game.GameService/SetGenreParent"
+)
+
+// GameServiceClient is the client API for GameService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GameServiceClient interface {
+	// Создать новую игру
+	AddGame(ctx context.Context, in *AddGameRequest, opts ...grpc.CallOption) (*AddGameResponse, error)
+	// Получить игру с подробной информацией
+	GetGame(ctx context.Context, in *GetGameRequest, opts ...grpc.CallOption) (*GetGameResponse, error)
+	// Получить список игр с укороченной информацией
+	GameList(ctx context.Context, in *GameListRequest, opts ...grpc.CallOption) (*GameListResponse, error)
+	// DeleteGame удалить игру
+	DeleteGame(ctx context.Context, in *DeleteGameRequest, opts ...grpc.CallOption) (*DeleteGameResponse, error)
+	// UpdateGameStatus обновить статус игры
+	UpdateGameStatus(ctx context.Context, in *UpdateGameStatusRequest, opts ...grpc.CallOption) (*UpdateGameStatusResponse, error)
+	// UpdateGame частично обновить игру, меняются только поля из update_mask
+	UpdateGame(ctx context.Context, in *UpdateGameRequest, opts ...grpc.CallOption) (*UpdateGameResponse, error)
+	// SetGameCover загрузить или заменить обложку игры
+	SetGameCover(ctx context.Context, in *SetGameCoverRequest, opts ...grpc.CallOption) (*SetGameCoverResponse, error)
+	// RemoveGameCover убрать обложку игры
+	RemoveGameCover(ctx context.Context, in *RemoveGameCoverRequest, opts ...grpc.CallOption) (*RemoveGameCoverResponse, error)
+	// SuggestGames подсказки названий для строки поиска, устойчивые к опечаткам
+	SuggestGames(ctx context.Context, in *SuggestGamesRequest, opts ...grpc.CallOption) (*SuggestGamesResponse, error)
+	// GameFacets счетчики тэгов, жанров и годов для фильтров GameList
+	GameFacets(ctx context.Context, in *GameFacetsRequest, opts ...grpc.CallOption) (*GameFacetsResponse, error)
+	// SubmitForReview отправить черновик на модерацию
+	SubmitForReview(ctx context.Context, in *SubmitForReviewRequest, opts ...grpc.CallOption) (*SubmitForReviewResponse, error)
+	// ApproveGame одобрить игру из очереди модерации, только для модераторов
+	ApproveGame(ctx context.Context, in *ApproveGameRequest, opts ...grpc.CallOption) (*ApproveGameResponse, error)
+	// RejectGame вернуть игру из очереди модерации в черновики с причиной, только для модераторов
+	RejectGame(ctx context.Context, in *RejectGameRequest, opts ...grpc.CallOption) (*RejectGameResponse, error)
+	// ListPendingGames очередь модерации, сначала давно ожидающие
+	ListPendingGames(ctx context.Context, in *ListPendingGamesRequest, opts ...grpc.CallOption) (*ListPendingGamesResponse, error)
+	// GetGameStatusHistory история смен статуса игры, только для модераторов
+	GetGameStatusHistory(ctx context.Context, in *GetGameStatusHistoryRequest, opts ...grpc.CallOption) (*GetGameStatusHistoryResponse, error)
+	// SchedulePublish запланировать публикацию игры из PENDING, только для модераторов
+	SchedulePublish(ctx context.Context, in *SchedulePublishRequest, opts ...grpc.CallOption) (*SchedulePublishResponse, error)
+	// ListDeletedGames корзина мягко удаленных игр, только для модераторов
+	ListDeletedGames(ctx context.Context, in *ListDeletedGamesRequest, opts ...grpc.CallOption) (*ListDeletedGamesResponse, error)
+	// RestoreGame вернуть игру из корзины, только для модераторов
+	RestoreGame(ctx context.Context, in *RestoreGameRequest, opts ...grpc.CallOption) (*RestoreGameResponse, error)
+	// WatchGames поток событий об изменении игр с номером больше after_seq.
+	// Доставка минимум один раз: повторы отбрасываются по seq
+	WatchGames(ctx context.Context, in *WatchGamesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GameEvent], error)
+	// CreateWebhook подписать партнера на события игр. Только для модераторов
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error)
+	// ListWebhooks список подписок без секретов. Только для модераторов
+	ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error)
+	// DeleteWebhook удалить подписку. Только для модераторов
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error)
+	// ListTags список тэгов
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error)
+	// CreateTag создать тэг. Только для модераторов
+	CreateTag(ctx context.Context, in *CreateTagRequest, opts ...grpc.CallOption) (*CreateTagResponse, error)
+	// RenameTag переименовать тэг. Только для модераторов
+	RenameTag(ctx context.Context, in *RenameTagRequest, opts ...grpc.CallOption) (*RenameTagResponse, error)
+	// DeleteTag удалить тэг. Только для модераторов
+	DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*DeleteTagResponse, error)
+	// ListGenres список жанров
+	ListGenres(ctx context.Context, in *ListGenresRequest, opts ...grpc.CallOption) (*ListGenresResponse, error)
+	// CreateGenre создать жанр. Только для модераторов
+	CreateGenre(ctx context.Context, in *CreateGenreRequest, opts ...grpc.CallOption) (*CreateGenreResponse, error)
+	// RenameGenre переименовать жанр. Только для модераторов
+	RenameGenre(ctx context.Context, in *RenameGenreRequest, opts ...grpc.CallOption) (*RenameGenreResponse, error)
+	// DeleteGenre удалить жанр. Только для модераторов
+	DeleteGenre(ctx context.Context, in *DeleteGenreRequest, opts ...grpc.CallOption) (*DeleteGenreResponse, error)
+	// MergeTags слить тэги source_ids в target_id. Только для модераторов
+	MergeTags(ctx context.Context, in *MergeTagsRequest, opts ...grpc.CallOption) (*MergeTagsResponse, error)
+	// MergeGenres слить жанры source_ids в target_id. Только для модераторов
+	MergeGenres(ctx context.Context, in *MergeGenresRequest, opts ...grpc.CallOption) (*MergeGenresResponse, error)
+	// SetGenreParent вложить жанр в parent_id, 0 - сделать корневым. Только для модераторов
+	SetGenreParent(ctx context.Context, in *SetGenreParentRequest, opts ...grpc.CallOption) (*SetGenreParentResponse, error)
+}
+
+type gameServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGameServiceClient(cc grpc.ClientConnInterface) GameServiceClient {
+	return &gameServiceClient{cc}
+}
+
+func (c *gameServiceClient) AddGame(ctx context.Context, in *AddGameRequest, opts ...grpc.CallOption) (*AddGameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddGameResponse)
+	err := c.cc.Invoke(ctx, GameService_AddGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) GetGame(ctx context.Context, in *GetGameRequest, opts ...grpc.CallOption) (*GetGameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGameResponse)
+	err := c.cc.Invoke(ctx, GameService_GetGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) GameList(ctx context.Context, in *GameListRequest, opts ...grpc.CallOption) (*GameListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GameListResponse)
+	err := c.cc.Invoke(ctx, GameService_GameList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) DeleteGame(ctx context.Context, in *DeleteGameRequest, opts ...grpc.CallOption) (*DeleteGameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteGameResponse)
+	err := c.cc.Invoke(ctx, GameService_DeleteGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) UpdateGameStatus(ctx context.Context, in *UpdateGameStatusRequest, opts ...grpc.CallOption) (*UpdateGameStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateGameStatusResponse)
+	err := c.cc.Invoke(ctx, GameService_UpdateGameStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) UpdateGame(ctx context.Context, in *UpdateGameRequest, opts ...grpc.CallOption) (*UpdateGameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateGameResponse)
+	err := c.cc.Invoke(ctx, GameService_UpdateGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) SetGameCover(ctx context.Context, in *SetGameCoverRequest, opts ...grpc.CallOption) (*SetGameCoverResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetGameCoverResponse)
+	err := c.cc.Invoke(ctx, GameService_SetGameCover_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) RemoveGameCover(ctx context.Context, in *RemoveGameCoverRequest, opts ...grpc.CallOption) (*RemoveGameCoverResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveGameCoverResponse)
+	err := c.cc.Invoke(ctx, GameService_RemoveGameCover_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) SuggestGames(ctx context.Context, in *SuggestGamesRequest, opts ...grpc.CallOption) (*SuggestGamesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestGamesResponse)
+	err := c.cc.Invoke(ctx, GameService_SuggestGames_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) GameFacets(ctx context.Context, in *GameFacetsRequest, opts ...grpc.CallOption) (*GameFacetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GameFacetsResponse)
+	err := c.cc.Invoke(ctx, GameService_GameFacets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) SubmitForReview(ctx context.Context, in *SubmitForReviewRequest, opts ...grpc.CallOption) (*SubmitForReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitForReviewResponse)
+	err := c.cc.Invoke(ctx, GameService_SubmitForReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) ApproveGame(ctx context.Context, in *ApproveGameRequest, opts ...grpc.CallOption) (*ApproveGameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveGameResponse)
+	err := c.cc.Invoke(ctx, GameService_ApproveGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) RejectGame(ctx context.Context, in *RejectGameRequest, opts ...grpc.CallOption) (*RejectGameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectGameResponse)
+	err := c.cc.Invoke(ctx, GameService_RejectGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) ListPendingGames(ctx context.Context, in *ListPendingGamesRequest, opts ...grpc.CallOption) (*ListPendingGamesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPendingGamesResponse)
+	err := c.cc.Invoke(ctx, GameService_ListPendingGames_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) GetGameStatusHistory(ctx context.Context, in *GetGameStatusHistoryRequest, opts ...grpc.CallOption) (*GetGameStatusHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGameStatusHistoryResponse)
+	err := c.cc.Invoke(ctx, GameService_GetGameStatusHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) SchedulePublish(ctx context.Context, in *SchedulePublishRequest, opts ...grpc.CallOption) (*SchedulePublishResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SchedulePublishResponse)
+	err := c.cc.Invoke(ctx, GameService_SchedulePublish_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) ListDeletedGames(ctx context.Context, in *ListDeletedGamesRequest, opts ...grpc.CallOption) (*ListDeletedGamesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeletedGamesResponse)
+	err := c.cc.Invoke(ctx, GameService_ListDeletedGames_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) RestoreGame(ctx context.Context, in *RestoreGameRequest, opts ...grpc.CallOption) (*RestoreGameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreGameResponse)
+	err := c.cc.Invoke(ctx, GameService_RestoreGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) WatchGames(ctx context.Context, in *WatchGamesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GameEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GameService_ServiceDesc.Streams[0], GameService_WatchGames_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchGamesRequest, GameEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GameService_WatchGamesClient = grpc.ServerStreamingClient[GameEvent]
+
+func (c *gameServiceClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateWebhookResponse)
+	err := c.cc.Invoke(ctx, GameService_CreateWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhooksResponse)
+	err := c.cc.Invoke(ctx, GameService_ListWebhooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWebhookResponse)
+	err := c.cc.Invoke(ctx, GameService_DeleteWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTagsResponse)
+	err := c.cc.Invoke(ctx, GameService_ListTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) CreateTag(ctx context.Context, in *CreateTagRequest, opts ...grpc.CallOption) (*CreateTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTagResponse)
+	err := c.cc.Invoke(ctx, GameService_CreateTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) RenameTag(ctx context.Context, in *RenameTagRequest, opts ...grpc.CallOption) (*RenameTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenameTagResponse)
+	err := c.cc.Invoke(ctx, GameService_RenameTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*DeleteTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTagResponse)
+	err := c.cc.Invoke(ctx, GameService_DeleteTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) ListGenres(ctx context.Context, in *ListGenresRequest, opts ...grpc.CallOption) (*ListGenresResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGenresResponse)
+	err := c.cc.Invoke(ctx, GameService_ListGenres_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) CreateGenre(ctx context.Context, in *CreateGenreRequest, opts ...grpc.CallOption) (*CreateGenreResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateGenreResponse)
+	err := c.cc.Invoke(ctx, GameService_CreateGenre_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) RenameGenre(ctx context.Context, in *RenameGenreRequest, opts ...grpc.CallOption) (*RenameGenreResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenameGenreResponse)
+	err := c.cc.Invoke(ctx, GameService_RenameGenre_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) DeleteGenre(ctx context.Context, in *DeleteGenreRequest, opts ...grpc.CallOption) (*DeleteGenreResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteGenreResponse)
+	err := c.cc.Invoke(ctx, GameService_DeleteGenre_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) MergeTags(ctx context.Context, in *MergeTagsRequest, opts ...grpc.CallOption) (*MergeTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeTagsResponse)
+	err := c.cc.Invoke(ctx, GameService_MergeTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) MergeGenres(ctx context.Context, in *MergeGenresRequest, opts ...grpc.CallOption) (*MergeGenresResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeGenresResponse)
+	err := c.cc.Invoke(ctx, GameService_MergeGenres_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) SetGenreParent(ctx context.Context, in *SetGenreParentRequest, opts ...grpc.CallOption) (*SetGenreParentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetGenreParentResponse)
+	err := c.cc.Invoke(ctx, GameService_SetGenreParent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GameServiceServer is the server API for GameService service.
+// All implementations must embed UnimplementedGameServiceServer
+// for forward compatibility.
+type GameServiceServer interface {
+	// Создать новую игру
+	AddGame(context.Context, *AddGameRequest) (*AddGameResponse, error)
+	// Получить игру с подробной информацией
+	GetGame(context.Context, *GetGameRequest) (*GetGameResponse, error)
+	// Получить список игр с укороченной информацией
+	GameList(context.Context, *GameListRequest) (*GameListResponse, error)
+	// DeleteGame удалить игру
+	DeleteGame(context.Context, *DeleteGameRequest) (*DeleteGameResponse, error)
+	// UpdateGameStatus обновить статус игры
+	UpdateGameStatus(context.Context, *UpdateGameStatusRequest) (*UpdateGameStatusResponse, error)
+	// UpdateGame частично обновить игру, меняются только поля из update_mask
+	UpdateGame(context.Context, *UpdateGameRequest) (*UpdateGameResponse, error)
+	// SetGameCover загрузить или заменить обложку игры
+	SetGameCover(context.Context, *SetGameCoverRequest) (*SetGameCoverResponse, error)
+	// RemoveGameCover убрать обложку игры
+	RemoveGameCover(context.Context, *RemoveGameCoverRequest) (*RemoveGameCoverResponse, error)
+	// SuggestGames подсказки названий для строки поиска, устойчивые к опечаткам
+	SuggestGames(context.Context, *SuggestGamesRequest) (*SuggestGamesResponse, error)
+	// GameFacets счетчики тэгов, жанров и годов для фильтров GameList
+	GameFacets(context.Context, *GameFacetsRequest) (*GameFacetsResponse, error)
+	// SubmitForReview отправить черновик на модерацию
+	SubmitForReview(context.Context, *SubmitForReviewRequest) (*SubmitForReviewResponse, error)
+	// ApproveGame одобрить игру из очереди модерации, только для модераторов
+	ApproveGame(context.Context, *ApproveGameRequest) (*ApproveGameResponse, error)
+	// RejectGame вернуть игру из очереди модерации в черновики с причиной, только для модераторов
+	RejectGame(context.Context, *RejectGameRequest) (*RejectGameResponse, error)
+	// ListPendingGames очередь модерации, сначала давно ожидающие
+	ListPendingGames(context.Context, *ListPendingGamesRequest) (*ListPendingGamesResponse, error)
+	// GetGameStatusHistory история смен статуса игры, только для модераторов
+	GetGameStatusHistory(context.Context, *GetGameStatusHistoryRequest) (*GetGameStatusHistoryResponse, error)
+	// SchedulePublish запланировать публикацию игры из PENDING, только для модераторов
+	SchedulePublish(context.Context, *SchedulePublishRequest) (*SchedulePublishResponse, error)
+	// ListDeletedGames корзина мягко удаленных игр, только для модераторов
+	ListDeletedGames(context.Context, *ListDeletedGamesRequest) (*ListDeletedGamesResponse, error)
+	// RestoreGame вернуть игру из корзины, только для модераторов
+	RestoreGame(context.Context, *RestoreGameRequest) (*RestoreGameResponse, error)
+	// WatchGames поток событий об изменении игр с номером больше after_seq.
+	// Доставка минимум один раз: повторы отбрасываются по seq
+	WatchGames(*WatchGamesRequest, grpc.ServerStreamingServer[GameEvent]) error
+	// CreateWebhook подписать партнера на события игр. Только для модераторов
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error)
+	// ListWebhooks список подписок без секретов. Только для модераторов
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error)
+	// DeleteWebhook удалить подписку. Только для модераторов
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error)
+	// ListTags список тэгов
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
+	// CreateTag создать тэг. Только для модераторов
+	CreateTag(context.Context, *CreateTagRequest) (*CreateTagResponse, error)
+	// RenameTag переименовать тэг. Только для модераторов
+	RenameTag(context.Context, *RenameTagRequest) (*RenameTagResponse, error)
+	// DeleteTag удалить тэг. Только для модераторов
+	DeleteTag(context.Context, *DeleteTagRequest) (*DeleteTagResponse, error)
+	// ListGenres список жанров
+	ListGenres(context.Context, *ListGenresRequest) (*ListGenresResponse, error)
+	// CreateGenre создать жанр. Только для модераторов
+	CreateGenre(context.Context, *CreateGenreRequest) (*CreateGenreResponse, error)
+	// RenameGenre переименовать жанр. Только для модераторов
+	RenameGenre(context.Context, *RenameGenreRequest) (*RenameGenreResponse, error)
+	// DeleteGenre удалить жанр. Только для модераторов
+	DeleteGenre(context.Context, *DeleteGenreRequest) (*DeleteGenreResponse, error)
+	// MergeTags слить тэги source_ids в target_id. Только для модераторов
+	MergeTags(context.Context, *MergeTagsRequest) (*MergeTagsResponse, error)
+	// MergeGenres слить жанры source_ids в target_id. Только для модераторов
+	MergeGenres(context.Context, *MergeGenresRequest) (*MergeGenresResponse, error)
+	// SetGenreParent вложить жанр в parent_id, 0 - сделать корневым. Только для модераторов
+	SetGenreParent(context.Context, *SetGenreParentRequest) (*SetGenreParentResponse, error)
+	mustEmbedUnimplementedGameServiceServer()
+}
+
+// UnimplementedGameServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGameServiceServer struct{}
+
+func (UnimplementedGameServiceServer) AddGame(context.Context, *AddGameRequest) (*AddGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddGame not implemented")
+}
+func (UnimplementedGameServiceServer) GetGame(context.Context, *GetGameRequest) (*GetGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGame not implemented")
+}
+func (UnimplementedGameServiceServer) GameList(context.Context, *GameListRequest) (*GameListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GameList not implemented")
+}
+func (UnimplementedGameServiceServer) DeleteGame(context.Context, *DeleteGameRequest) (*DeleteGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGame not implemented")
+}
+func (UnimplementedGameServiceServer) UpdateGameStatus(context.Context, *UpdateGameStatusRequest) (*UpdateGameStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGameStatus not implemented")
+}
+func (UnimplementedGameServiceServer) UpdateGame(context.Context, *UpdateGameRequest) (*UpdateGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGame not implemented")
+}
+func (UnimplementedGameServiceServer) SetGameCover(context.Context, *SetGameCoverRequest) (*SetGameCoverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGameCover not implemented")
+}
+func (UnimplementedGameServiceServer) RemoveGameCover(context.Context, *RemoveGameCoverRequest) (*RemoveGameCoverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveGameCover not implemented")
+}
+func (UnimplementedGameServiceServer) SuggestGames(context.Context, *SuggestGamesRequest) (*SuggestGamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestGames not implemented")
+}
+func (UnimplementedGameServiceServer) GameFacets(context.Context, *GameFacetsRequest) (*GameFacetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GameFacets not implemented")
+}
+func (UnimplementedGameServiceServer) SubmitForReview(context.Context, *SubmitForReviewRequest) (*SubmitForReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitForReview not implemented")
+}
+func (UnimplementedGameServiceServer) ApproveGame(context.Context, *ApproveGameRequest) (*ApproveGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveGame not implemented")
+}
+func (UnimplementedGameServiceServer) RejectGame(context.Context, *RejectGameRequest) (*RejectGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectGame not implemented")
+}
+func (UnimplementedGameServiceServer) ListPendingGames(context.Context, *ListPendingGamesRequest) (*ListPendingGamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingGames not implemented")
+}
+func (UnimplementedGameServiceServer) GetGameStatusHistory(context.Context, *GetGameStatusHistoryRequest) (*GetGameStatusHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGameStatusHistory not implemented")
+}
+func (UnimplementedGameServiceServer) SchedulePublish(context.Context, *SchedulePublishRequest) (*SchedulePublishResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SchedulePublish not implemented")
+}
+func (UnimplementedGameServiceServer) ListDeletedGames(context.Context, *ListDeletedGamesRequest) (*ListDeletedGamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedGames not implemented")
+}
+func (UnimplementedGameServiceServer) RestoreGame(context.Context, *RestoreGameRequest) (*RestoreGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreGame not implemented")
+}
+func (UnimplementedGameServiceServer) WatchGames(*WatchGamesRequest, grpc.ServerStreamingServer[GameEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchGames not implemented")
+}
+func (UnimplementedGameServiceServer) CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedGameServiceServer) ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedGameServiceServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedGameServiceServer) ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedGameServiceServer) CreateTag(context.Context, *CreateTagRequest) (*CreateTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTag not implemented")
+}
+func (UnimplementedGameServiceServer) RenameTag(context.Context, *RenameTagRequest) (*RenameTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameTag not implemented")
+}
+func (UnimplementedGameServiceServer) DeleteTag(context.Context, *DeleteTagRequest) (*DeleteTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTag not implemented")
+}
+func (UnimplementedGameServiceServer) ListGenres(context.Context, *ListGenresRequest) (*ListGenresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGenres not implemented")
+}
+func (UnimplementedGameServiceServer) CreateGenre(context.Context, *CreateGenreRequest) (*CreateGenreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGenre not implemented")
+}
+func (UnimplementedGameServiceServer) RenameGenre(context.Context, *RenameGenreRequest) (*RenameGenreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameGenre not implemented")
+}
+func (UnimplementedGameServiceServer) DeleteGenre(context.Context, *DeleteGenreRequest) (*DeleteGenreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGenre not implemented")
+}
+func (UnimplementedGameServiceServer) MergeTags(context.Context, *MergeTagsRequest) (*MergeTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeTags not implemented")
+}
+func (UnimplementedGameServiceServer) MergeGenres(context.Context, *MergeGenresRequest) (*MergeGenresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeGenres not implemented")
+}
+func (UnimplementedGameServiceServer) SetGenreParent(context.Context, *SetGenreParentRequest) (*SetGenreParentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGenreParent not implemented")
+}
+func (UnimplementedGameServiceServer) mustEmbedUnimplementedGameServiceServer() {}
+func (UnimplementedGameServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeGameServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GameServiceServer will
+// result in compilation errors.
+type UnsafeGameServiceServer interface {
+	mustEmbedUnimplementedGameServiceServer()
+}
+
+func RegisterGameServiceServer(s grpc.ServiceRegistrar, srv GameServiceServer) {
+	// If the following call pancis, it indicates UnimplementedGameServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GameService_ServiceDesc, srv)
+}
+
+func _GameService_AddGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).AddGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_AddGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).AddGame(ctx, req.(*AddGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_GetGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).GetGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_GetGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).GetGame(ctx, req.(*GetGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_GameList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GameListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).GameList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_GameList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).GameList(ctx, req.(*GameListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_DeleteGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).DeleteGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_DeleteGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).DeleteGame(ctx, req.(*DeleteGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_UpdateGameStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGameStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).UpdateGameStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_UpdateGameStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).UpdateGameStatus(ctx, req.(*UpdateGameStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_UpdateGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).UpdateGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_UpdateGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).UpdateGame(ctx, req.(*UpdateGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_SetGameCover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGameCoverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).SetGameCover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_SetGameCover_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).SetGameCover(ctx, req.(*SetGameCoverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_RemoveGameCover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveGameCoverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).RemoveGameCover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_RemoveGameCover_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).RemoveGameCover(ctx, req.(*RemoveGameCoverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_SuggestGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestGamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).SuggestGames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_SuggestGames_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).SuggestGames(ctx, req.(*SuggestGamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_GameFacets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GameFacetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).GameFacets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_GameFacets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).GameFacets(ctx, req.(*GameFacetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_SubmitForReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitForReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).SubmitForReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_SubmitForReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).SubmitForReview(ctx, req.(*SubmitForReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_ApproveGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).ApproveGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_ApproveGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).ApproveGame(ctx, req.(*ApproveGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_RejectGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).RejectGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_RejectGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).RejectGame(ctx, req.(*RejectGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_ListPendingGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingGamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).ListPendingGames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_ListPendingGames_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).ListPendingGames(ctx, req.(*ListPendingGamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_GetGameStatusHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGameStatusHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).GetGameStatusHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_GetGameStatusHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).GetGameStatusHistory(ctx, req.(*GetGameStatusHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_SchedulePublish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulePublishRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).SchedulePublish(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_SchedulePublish_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).SchedulePublish(ctx, req.(*SchedulePublishRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_ListDeletedGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedGamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).ListDeletedGames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_ListDeletedGames_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).ListDeletedGames(ctx, req.(*ListDeletedGamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_RestoreGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).RestoreGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_RestoreGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).RestoreGame(ctx, req.(*RestoreGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_WatchGames_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchGamesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GameServiceServer).WatchGames(m, &grpc.GenericServerStream[WatchGamesRequest, GameEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GameService_WatchGamesServer = grpc.ServerStreamingServer[GameEvent]
+
+func _GameService_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_CreateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).ListWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_ListWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_DeleteWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_ListTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).ListTags(ctx, req.(*ListTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_CreateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).CreateTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_CreateTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).CreateTag(ctx, req.(*CreateTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_RenameTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).RenameTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_RenameTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).RenameTag(ctx, req.(*RenameTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_DeleteTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).DeleteTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_DeleteTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).DeleteTag(ctx, req.(*DeleteTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_ListGenres_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGenresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).ListGenres(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_ListGenres_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).ListGenres(ctx, req.(*ListGenresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_CreateGenre_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGenreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).CreateGenre(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_CreateGenre_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).CreateGenre(ctx, req.(*CreateGenreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_RenameGenre_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameGenreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).RenameGenre(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_RenameGenre_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).RenameGenre(ctx, req.(*RenameGenreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_DeleteGenre_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGenreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).DeleteGenre(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_DeleteGenre_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).DeleteGenre(ctx, req.(*DeleteGenreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_MergeTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).MergeTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_MergeTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).MergeTags(ctx, req.(*MergeTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_MergeGenres_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeGenresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).MergeGenres(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_MergeGenres_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).MergeGenres(ctx, req.(*MergeGenresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_SetGenreParent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGenreParentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).SetGenreParent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_SetGenreParent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).SetGenreParent(ctx, req.(*SetGenreParentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GameService_ServiceDesc is the grpc.ServiceDesc for GameService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GameService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "game.GameService",
+	HandlerType: (*GameServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddGame",
+			Handler:    _GameService_AddGame_Handler,
+		},
+		{
+			MethodName: "GetGame",
+			Handler:    _GameService_GetGame_Handler,
+		},
+		{
+			MethodName: "GameList",
+			Handler:    _GameService_GameList_Handler,
+		},
+		{
+			MethodName: "DeleteGame",
+			Handler:    _GameService_DeleteGame_Handler,
+		},
+		{
+			MethodName: "UpdateGameStatus",
+			Handler:    _GameService_UpdateGameStatus_Handler,
+		},
+		{
+			MethodName: "UpdateGame",
+			Handler:    _GameService_UpdateGame_Handler,
+		},
+		{
+			MethodName: "SetGameCover",
+			Handler:    _GameService_SetGameCover_Handler,
+		},
+		{
+			MethodName: "RemoveGameCover",
+			Handler:    _GameService_RemoveGameCover_Handler,
+		},
+		{
+			MethodName: "SuggestGames",
+			Handler:    _GameService_SuggestGames_Handler,
+		},
+		{
+			MethodName: "GameFacets",
+			Handler:    _GameService_GameFacets_Handler,
+		},
+		{
+			MethodName: "SubmitForReview",
+			Handler:    _GameService_SubmitForReview_Handler,
+		},
+		{
+			MethodName: "ApproveGame",
+			Handler:    _GameService_ApproveGame_Handler,
+		},
+		{
+			MethodName: "RejectGame",
+			Handler:    _GameService_RejectGame_Handler,
+		},
+		{
+			MethodName: "ListPendingGames",
+			Handler:    _GameService_ListPendingGames_Handler,
+		},
+		{
+			MethodName: "GetGameStatusHistory",
+			Handler:    _GameService_GetGameStatusHistory_Handler,
+		},
+		{
+			MethodName: "SchedulePublish",
+			Handler:    _GameService_SchedulePublish_Handler,
+		},
+		{
+			MethodName: "ListDeletedGames",
+			Handler:    _GameService_ListDeletedGames_Handler,
+		},
+		{
+			MethodName: "RestoreGame",
+			Handler:    _GameService_RestoreGame_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _GameService_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _GameService_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _GameService_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _GameService_ListTags_Handler,
+		},
+		{
+			MethodName: "CreateTag",
+			Handler:    _GameService_CreateTag_Handler,
+		},
+		{
+			MethodName: "RenameTag",
+			Handler:    _GameService_RenameTag_Handler,
+		},
+		{
+			MethodName: "DeleteTag",
+			Handler:    _GameService_DeleteTag_Handler,
+		},
+		{
+			MethodName: "ListGenres",
+			Handler:    _GameService_ListGenres_Handler,
+		},
+		{
+			MethodName: "CreateGenre",
+			Handler:    _GameService_CreateGenre_Handler,
+		},
+		{
+			MethodName: "RenameGenre",
+			Handler:    _GameService_RenameGenre_Handler,
+		},
+		{
+			MethodName: "DeleteGenre",
+			Handler:    _GameService_DeleteGenre_Handler,
+		},
+		{
+			MethodName: "MergeTags",
+			Handler:    _GameService_MergeTags_Handler,
+		},
+		{
+			MethodName: "MergeGenres",
+			Handler:    _GameService_MergeGenres_Handler,
+		},
+		{
+			MethodName: "SetGenreParent",
+			Handler:    _GameService_SetGenreParent_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchGames",
+			Handler:       _GameService_WatchGames_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "game/game.proto",
+}